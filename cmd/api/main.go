@@ -10,6 +10,8 @@ import (
 	"sync/atomic"
 	"syscall"
 	"time"
+	// Trips are shown in the time zone of their departure city, whatever zones the host knows
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/config"
@@ -73,12 +75,17 @@ func main() {
 	handler.Store(http.Handler(fullRouter))
 	log.Println("Application fully initialized")
 
+	// Create the upcoming occurrences of recurring trips in the background
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	go container.TripSeriesJob.Run(jobCtx)
+
 	// Wait for interrupt signal for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopJobs()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 
 	if err := server.Shutdown(ctx); err != nil {
@@ -1,24 +1,68 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	DatabaseURL     string
-	JWTSecret       string
-	JWTExpiresIn    string
-	ResendAPIKey    string
-	ResendFromEmail string
-	Port            int
-	AppEnv          string
-	RedisURL        string
-	CacheEnabled    bool
-	CacheKeyPrefix  string
+	DatabaseURL         string
+	JWTKeysDir          string
+	JWTSigningKeyID     string
+	JWTIssuer           string
+	JWTAudience         string
+	JWTClockSkew        time.Duration
+	JWTExpiresIn        string
+	JWTRefreshExpiresIn string
+	ResendAPIKey        string
+	ResendFromEmail     string
+	AppURL              string
+	LoginMaxAttempts    int
+	LoginLockoutMinutes int
+	// PasswordHistorySize is how many recent passwords, including the current one, cannot be reused
+	PasswordHistorySize int
+	// Argon2id cost for new password hashes; older hashes are upgraded on login
+	Argon2Time        int
+	Argon2MemoryKiB   int
+	Argon2Parallelism int
+	TotpIssuer        string
+	// TotpEncryptionKey is the AES-256 key TOTP secrets are encrypted with in the database
+	TotpEncryptionKey []byte
+	// TwoFactorRequiredRoles lists roles that must enroll in two-factor authentication to log in
+	TwoFactorRequiredRoles []string
+	// OidcProviders are the OpenID Connect identity providers users can sign in with
+	OidcProviders []OidcProviderConfig
+	// TripSeriesHorizonDays is how far ahead occurrences of recurring trips are created
+	TripSeriesHorizonDays int
+	// TripSeriesJobInterval is how often the background job creates new occurrences
+	TripSeriesJobInterval time.Duration
+	// Trip pricing, in cents of PricingCurrency: the cost of a kilometre when a car's fuel
+	// consumption is unknown, otherwise the fuel price per litre plus wear per kilometre
+	PricingCurrency          string
+	PricingCostPerKm         int
+	PricingFuelPricePerLitre int
+	PricingWearPerKm         int
+	Port                     int
+	AppEnv                   string
+	RedisURL                 string
+	CacheEnabled             bool
+	CacheKeyPrefix           string
+}
+
+// OidcProviderConfig describes an OpenID Connect provider, configured through OIDC_<NAME>_* variables
+type OidcProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
 }
 
 var cfg *Config
@@ -28,23 +72,86 @@ func Load() (*Config, error) {
 
 	port, _ := strconv.Atoi(getEnv("PORT", "3000"))
 	cacheEnabled, _ := strconv.ParseBool(getEnv("CACHE_ENABLED", "false"))
+	loginMaxAttempts, _ := strconv.Atoi(getEnv("LOGIN_MAX_ATTEMPTS", "5"))
+	loginLockoutMinutes, _ := strconv.Atoi(getEnv("LOGIN_LOCKOUT_MINUTES", "15"))
+	passwordHistorySize, _ := strconv.Atoi(getEnv("PASSWORD_HISTORY_SIZE", "5"))
+	argon2Time, _ := strconv.Atoi(getEnv("ARGON2_TIME", "2"))
+	argon2MemoryKiB, _ := strconv.Atoi(getEnv("ARGON2_MEMORY_KIB", "19456"))
+	argon2Parallelism, _ := strconv.Atoi(getEnv("ARGON2_PARALLELISM", "1"))
+	tripSeriesHorizonDays, _ := strconv.Atoi(getEnv("TRIP_SERIES_HORIZON_DAYS", "28"))
+	pricingCostPerKm, _ := strconv.Atoi(getEnv("PRICING_COST_PER_KM", "20"))
+	pricingFuelPricePerLitre, _ := strconv.Atoi(getEnv("PRICING_FUEL_PRICE_PER_LITRE", "185"))
+	pricingWearPerKm, _ := strconv.Atoi(getEnv("PRICING_WEAR_PER_KM", "8"))
 
 	databaseURL := getEnv("DATABASE_URL", "")
 	if databaseURL == "" {
 		return nil, fmt.Errorf("required environment variable DATABASE_URL is not set")
 	}
 
+	jwtKeysDir := getEnv("JWT_KEYS_DIR", "")
+	if jwtKeysDir == "" {
+		return nil, fmt.Errorf("required environment variable JWT_KEYS_DIR is not set")
+	}
+	jwtSigningKeyID := getEnv("JWT_SIGNING_KEY_ID", "")
+	if jwtSigningKeyID == "" {
+		return nil, fmt.Errorf("required environment variable JWT_SIGNING_KEY_ID is not set")
+	}
+	jwtClockSkew, err := time.ParseDuration(getEnv("JWT_CLOCK_SKEW", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT_CLOCK_SKEW: %w", err)
+	}
+
+	totpEncryptionKey, err := base64.StdEncoding.DecodeString(getEnv("TOTP_ENCRYPTION_KEY", ""))
+	if err != nil || len(totpEncryptionKey) != 32 {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY must be set to 32 bytes encoded in base64")
+	}
+
+	tripSeriesJobInterval, err := time.ParseDuration(getEnv("TRIP_SERIES_JOB_INTERVAL", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRIP_SERIES_JOB_INTERVAL: %w", err)
+	}
+	if tripSeriesJobInterval <= 0 {
+		return nil, fmt.Errorf("invalid TRIP_SERIES_JOB_INTERVAL: must be positive")
+	}
+
+	oidcProviders, err := loadOidcProviders(splitList(getEnv("OIDC_PROVIDERS", "")))
+	if err != nil {
+		return nil, err
+	}
+
 	cfg = &Config{
-		DatabaseURL:     databaseURL,
-		JWTSecret:       getEnv("JWT_SECRET", ""),
-		JWTExpiresIn:    getEnv("JWT_EXPIRES_IN", "24h"),
-		ResendAPIKey:    getEnv("RESEND_API_KEY", ""),
-		ResendFromEmail: getEnv("RESEND_FROM_EMAIL", ""),
-		Port:            port,
-		AppEnv:          getEnv("APP_ENV", "development"),
-		RedisURL:        getEnv("REDIS_URL", ""),
-		CacheEnabled:    cacheEnabled,
-		CacheKeyPrefix:  getEnv("CACHE_KEY_PREFIX", "covoitapi:"),
+		DatabaseURL:              databaseURL,
+		JWTKeysDir:               jwtKeysDir,
+		JWTSigningKeyID:          jwtSigningKeyID,
+		JWTIssuer:                getEnv("JWT_ISSUER", "covoitapi"),
+		JWTAudience:              getEnv("JWT_AUDIENCE", "covoitapi"),
+		JWTClockSkew:             jwtClockSkew,
+		JWTExpiresIn:             getEnv("JWT_EXPIRES_IN", "15m"),
+		JWTRefreshExpiresIn:      getEnv("JWT_REFRESH_EXPIRES_IN", "30d"),
+		ResendAPIKey:             getEnv("RESEND_API_KEY", ""),
+		ResendFromEmail:          getEnv("RESEND_FROM_EMAIL", ""),
+		AppURL:                   getEnv("APP_URL", "http://localhost:3000"),
+		LoginMaxAttempts:         loginMaxAttempts,
+		LoginLockoutMinutes:      loginLockoutMinutes,
+		PasswordHistorySize:      passwordHistorySize,
+		Argon2Time:               argon2Time,
+		Argon2MemoryKiB:          argon2MemoryKiB,
+		Argon2Parallelism:        argon2Parallelism,
+		TotpIssuer:               getEnv("TOTP_ISSUER", "CovoitAPI"),
+		TotpEncryptionKey:        totpEncryptionKey,
+		TwoFactorRequiredRoles:   splitList(getEnv("TWO_FACTOR_REQUIRED_ROLES", "")),
+		OidcProviders:            oidcProviders,
+		TripSeriesHorizonDays:    tripSeriesHorizonDays,
+		TripSeriesJobInterval:    tripSeriesJobInterval,
+		PricingCurrency:          getEnv("PRICING_CURRENCY", "EUR"),
+		PricingCostPerKm:         pricingCostPerKm,
+		PricingFuelPricePerLitre: pricingFuelPricePerLitre,
+		PricingWearPerKm:         pricingWearPerKm,
+		Port:                     port,
+		AppEnv:                   getEnv("APP_ENV", "development"),
+		RedisURL:                 getEnv("REDIS_URL", ""),
+		CacheEnabled:             cacheEnabled,
+		CacheKeyPrefix:           getEnv("CACHE_KEY_PREFIX", "covoitapi:"),
 	}
 
 	return cfg, nil
@@ -57,6 +164,39 @@ func Get() *Config {
 	return cfg
 }
 
+// loadOidcProviders reads the settings of each named provider from OIDC_<NAME>_* variables
+func loadOidcProviders(names []string) ([]OidcProviderConfig, error) {
+	providers := make([]OidcProviderConfig, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(name)
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		provider := OidcProviderConfig{
+			Name:         name,
+			Issuer:       getEnv(prefix+"ISSUER", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+		}
+		if provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return nil, fmt.Errorf("OIDC provider %q needs %sISSUER, %sCLIENT_ID and %sREDIRECT_URL", name, prefix, prefix, prefix)
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// splitList parses a comma-separated env value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -0,0 +1,43 @@
+package dtos
+
+import "time"
+
+// CreateApiKeyInput contains the data for issuing an API key
+type CreateApiKeyInput struct {
+	Name   string   `json:"name" validate:"required,min=1,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read write"`
+	// ExpiresInDays limits the key's lifetime; keys without it never expire
+	ExpiresInDays *int `json:"expiresInDays" validate:"omitempty,min=1,max=730"`
+}
+
+// CreateServiceAccountInput contains the data for creating a service account
+type CreateServiceAccountInput struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	Role string `json:"role" validate:"required,min=1,max=50"`
+}
+
+// ApiKeyResponse describes an API key without its secret
+type ApiKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// CreatedApiKeyResponse carries a new key in plain text. It is only ever shown once.
+type CreatedApiKeyResponse struct {
+	ApiKeyResponse
+	Key string `json:"key"`
+}
+
+// ServiceAccountResponse describes a service account
+type ServiceAccountResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
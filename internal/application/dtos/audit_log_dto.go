@@ -0,0 +1,28 @@
+package dtos
+
+import "time"
+
+// AuditLogQuery contains the optional filters for querying the audit log
+type AuditLogQuery struct {
+	Action   *string    `form:"action"`
+	ActorID  *string    `form:"actorId"`
+	TargetID *string    `form:"targetId"`
+	Email    *string    `form:"email"`
+	Success  *bool      `form:"success"`
+	From     *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// AuditLogResponse describes an audit log entry
+type AuditLogResponse struct {
+	ID        string    `json:"id"`
+	Action    string    `json:"action"`
+	Success   bool      `json:"success"`
+	ActorID   *string   `json:"actorId"`
+	TargetID  *string   `json:"targetId"`
+	Email     *string   `json:"email"`
+	ErrorCode *string   `json:"errorCode"`
+	IPAddress string    `json:"ipAddress"`
+	RequestID string    `json:"requestId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -1,5 +1,7 @@
 package dtos
 
+import "time"
+
 // RegisterInput contains the data needed for user registration
 type RegisterInput struct {
 	Email           string `json:"email" validate:"required,email"`
@@ -13,8 +15,115 @@ type LoginInput struct {
 	Password string `json:"password" validate:"required,min=1"`
 }
 
+// RefreshTokenInput contains the refresh token to exchange for a new token pair
+type RefreshTokenInput struct {
+	RefreshToken string `json:"refreshToken" validate:"required,min=1"`
+}
+
+// LogoutInput optionally carries the refresh token to revoke alongside the access token
+type LogoutInput struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ForgotPasswordInput contains the email address to send a reset link to
+type ForgotPasswordInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordInput contains the reset token and the new password
+type ResetPasswordInput struct {
+	Token           string `json:"token" validate:"required,min=1"`
+	Password        string `json:"password" validate:"required,min=8,password"`
+	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=Password"`
+}
+
+// ChangePasswordInput contains the current password and the new one
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"currentPassword" validate:"required,min=1"`
+	NewPassword     string `json:"newPassword" validate:"required,min=8,password"`
+	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=NewPassword"`
+}
+
+// VerifyEmailInput contains the token from an email verification link
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required,min=1"`
+}
+
+// MagicLinkInput contains the email address to send a sign-in link to
+type MagicLinkInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// MagicLinkLoginInput contains the email and token from a sign-in link
+type MagicLinkLoginInput struct {
+	Email string `json:"email" validate:"required,email"`
+	Token string `json:"token" validate:"required,min=1"`
+}
+
+// TwoFactorLoginInput completes a login that answered with mfaRequired
+type TwoFactorLoginInput struct {
+	MfaToken string `json:"mfaToken" validate:"required,min=1"`
+	// Code is either a current TOTP code or one of the account's recovery codes
+	Code string `json:"code" validate:"required,min=6,max=24"`
+}
+
+// ConfirmTotpInput contains the first code from a newly enrolled authenticator app
+type ConfirmTotpInput struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// OidcCallbackInput contains the parameters the identity provider redirected back with
+type OidcCallbackInput struct {
+	Code  string `json:"code" validate:"required,min=1"`
+	State string `json:"state" validate:"required,min=1"`
+}
+
+// OidcAuthorizationResponse points the client at the identity provider's login page
+type OidcAuthorizationResponse struct {
+	AuthorizationURL string    `json:"authorizationUrl"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+}
+
+// TotpEnrollmentResponse carries the shared secret to load into an authenticator app
+type TotpEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauthUri"`
+}
+
+// RecoveryCodesResponse lists single-use recovery codes. They are only ever shown once.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// LoginResponse is returned by the login steps. Either the token pair is set, or
+// MfaRequired is set and the login must be completed with MfaToken and a code.
+type LoginResponse struct {
+	*AuthResponse
+	MfaRequired       bool       `json:"mfaRequired,omitempty"`
+	MfaToken          string     `json:"mfaToken,omitempty"`
+	MfaTokenExpiresAt *time.Time `json:"mfaTokenExpiresAt,omitempty"`
+	// TotpEnrollment is set when the account's role requires two-factor but none is set up yet
+	TotpEnrollment *TotpEnrollmentResponse `json:"totpEnrollment,omitempty"`
+	// RecoveryCodes is set when the login completed a required enrollment
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+}
+
 // AuthResponse is returned after successful authentication
 type AuthResponse struct {
-	UserID string `json:"userId"`
-	Token  string `json:"token"`
+	UserID                string    `json:"userId"`
+	Token                 string    `json:"token"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+// SessionResponse describes a signed-in device. Current marks the session of the caller's token.
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"`
 }
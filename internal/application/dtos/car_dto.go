@@ -5,6 +5,8 @@ type CreateCarInput struct {
 	Model        string `json:"model" validate:"required,min=1"`
 	BrandID      string `json:"brandId" validate:"required,min=1"`
 	LicensePlate string `json:"licensePlate" validate:"required,min=1"`
+	// FuelConsumption is in litres per 100 km
+	FuelConsumption *float64 `json:"fuelConsumption" validate:"omitempty,gt=0,lte=30"`
 }
 
 // UpdateCarInput contains the data for a full car update (PUT)
@@ -12,11 +14,14 @@ type UpdateCarInput struct {
 	Model        string `json:"model" validate:"required,min=1"`
 	BrandID      string `json:"brandId" validate:"required,min=1"`
 	LicensePlate string `json:"licensePlate" validate:"required,min=1"`
+	// FuelConsumption is in litres per 100 km
+	FuelConsumption *float64 `json:"fuelConsumption" validate:"omitempty,gt=0,lte=30"`
 }
 
 // PatchCarInput contains the data for a partial car update (PATCH)
 type PatchCarInput struct {
-	Model        *string `json:"model,omitempty" validate:"omitempty,min=1"`
-	BrandID      *string `json:"brandId,omitempty" validate:"omitempty,min=1"`
-	LicensePlate *string `json:"licensePlate,omitempty" validate:"omitempty,min=1"`
+	Model           *string  `json:"model,omitempty" validate:"omitempty,min=1"`
+	BrandID         *string  `json:"brandId,omitempty" validate:"omitempty,min=1"`
+	LicensePlate    *string  `json:"licensePlate,omitempty" validate:"omitempty,min=1"`
+	FuelConsumption *float64 `json:"fuelConsumption,omitempty" validate:"omitempty,gt=0,lte=30"`
 }
@@ -4,4 +4,18 @@ package dtos
 type CreateCityInput struct {
 	CityName string `json:"cityName" validate:"required,min=1"`
 	Zipcode  string `json:"zipcode" validate:"required"`
+	// TimeZone is an IANA time zone name; entities.DefaultTimeZone when omitted
+	TimeZone string `json:"timeZone" validate:"omitempty,timezone"`
+	// Latitude and Longitude place the city for searches by distance, and go together
+	Latitude  *float64 `json:"latitude" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64 `json:"longitude" validate:"required_with=Latitude,omitempty,longitude"`
+}
+
+// UpdateCityInput contains the data for updating a city
+type UpdateCityInput struct {
+	// TimeZone is an IANA time zone name, for trips created from now on
+	TimeZone *string `json:"timeZone,omitempty" validate:"omitempty,timezone"`
+	// Latitude and Longitude locate a city created without them, and go together
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
 }
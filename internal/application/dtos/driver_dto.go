@@ -1,6 +1,20 @@
 package dtos
 
+import (
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
 // CreateDriverInput contains the data for driver registration
 type CreateDriverInput struct {
 	DriverLicense string `json:"driverLicense" validate:"required,min=1"`
 }
+
+// CreateDriverResponse is the new driver profile along with an access token carrying the
+// DRIVER role, since tokens issued before the role change are no longer accepted
+type CreateDriverResponse struct {
+	*entities.Driver
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
@@ -3,4 +3,7 @@ package dtos
 // CreateInscriptionInput contains the data for booking a trip
 type CreateInscriptionInput struct {
 	TripID string `json:"tripId" validate:"required,min=1"`
+	// FromCity and ToCity pick the stops to ride between; the whole trip when omitted
+	FromCity *string `json:"fromCity" validate:"omitempty,min=1"`
+	ToCity   *string `json:"toCity" validate:"omitempty,min=1"`
 }
@@ -1,13 +1,59 @@
 package dtos
 
+import "time"
+
 // CreateTripInput contains the data for creating a trip
 type CreateTripInput struct {
+	Kms int `json:"kms" validate:"required,gt=0"`
+	// Date is the departure time, on the departure city's clock ("2006-01-02T15:04") or in RFC 3339
+	Date          string `json:"date" validate:"required,min=1"`
+	DepartureCity string `json:"departureCity" validate:"required,min=1"`
+	ArrivalCity   string `json:"arrivalCity" validate:"required,min=1"`
+	Seats         int    `json:"seats" validate:"required,gt=0"`
+	CarID         string `json:"carId" validate:"required,min=1"`
+	// Stops are the cities between departure and arrival, in route order
+	Stops []TripStopInput `json:"stops" validate:"omitempty,max=10,dive"`
+	// ArrivalTime replaces the arrival time estimated from the distance
+	ArrivalTime *time.Time `json:"arrivalTime"`
+	// PricePerSeat is in cents; the suggested price when omitted
+	PricePerSeat *int `json:"pricePerSeat" validate:"omitempty,gte=0"`
+}
+
+// UpdateTripInput contains the data for a full trip update (PUT). The stops between departure and
+// arrival are kept and move with the departure time.
+type UpdateTripInput struct {
 	Kms           int    `json:"kms" validate:"required,gt=0"`
 	Date          string `json:"date" validate:"required,min=1"`
 	DepartureCity string `json:"departureCity" validate:"required,min=1"`
 	ArrivalCity   string `json:"arrivalCity" validate:"required,min=1"`
 	Seats         int    `json:"seats" validate:"required,gt=0"`
 	CarID         string `json:"carId" validate:"required,min=1"`
+	// ArrivalTime replaces the arrival time estimated from the distance
+	ArrivalTime  *time.Time `json:"arrivalTime"`
+	PricePerSeat *int       `json:"pricePerSeat" validate:"omitempty,gte=0"`
+}
+
+// PatchTripInput contains the data for a partial trip update (PATCH)
+type PatchTripInput struct {
+	Kms           *int       `json:"kms,omitempty" validate:"omitempty,gt=0"`
+	Date          *string    `json:"date,omitempty" validate:"omitempty,min=1"`
+	DepartureCity *string    `json:"departureCity,omitempty" validate:"omitempty,min=1"`
+	ArrivalCity   *string    `json:"arrivalCity,omitempty" validate:"omitempty,min=1"`
+	Seats         *int       `json:"seats,omitempty" validate:"omitempty,gt=0"`
+	CarID         *string    `json:"carId,omitempty" validate:"omitempty,min=1"`
+	ArrivalTime   *time.Time `json:"arrivalTime,omitempty"`
+	PricePerSeat  *int       `json:"pricePerSeat,omitempty" validate:"omitempty,gte=0"`
+}
+
+// TripStopInput is a city along the route with the time the driver expects to pass by
+type TripStopInput struct {
+	City string    `json:"city" validate:"required,min=1"`
+	Time time.Time `json:"time" validate:"required"`
+}
+
+// CancelTripInput contains the reason given to booked passengers
+type CancelTripInput struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
 }
 
 // FindTripQuery contains the search query parameters
@@ -15,4 +61,27 @@ type FindTripQuery struct {
 	DepartureCity *string `form:"departureCity"`
 	ArrivalCity   *string `form:"arrivalCity"`
 	Date          *string `form:"date"`
+	// DepartureAfter and DepartureBefore bound the departure time of day ("15:04") on the local clock
+	DepartureAfter  *string `form:"departureAfter"`
+	DepartureBefore *string `form:"departureBefore"`
+	// MinPrice and MaxPrice bound the price per seat, in cents
+	MinPrice *int    `form:"minPrice" validate:"omitempty,gte=0"`
+	MaxPrice *int    `form:"maxPrice" validate:"omitempty,gte=0"`
+	Sort     *string `form:"sort" validate:"omitempty,oneof=departure price -price distance"`
+	// A radius in km searches around a point, or around the city when no point is given, instead of
+	// matching the city name
+	DepartureLat    *float64 `form:"departureLat" validate:"omitempty,latitude"`
+	DepartureLng    *float64 `form:"departureLng" validate:"omitempty,longitude"`
+	DepartureRadius *float64 `form:"departureRadius" validate:"omitempty,gt=0"`
+	ArrivalLat      *float64 `form:"arrivalLat" validate:"omitempty,latitude"`
+	ArrivalLng      *float64 `form:"arrivalLng" validate:"omitempty,longitude"`
+	ArrivalRadius   *float64 `form:"arrivalRadius" validate:"omitempty,gt=0"`
+}
+
+// PriceQuoteQuery describes a trip to price
+type PriceQuoteQuery struct {
+	Kms   int `form:"kms" validate:"required,gt=0"`
+	Seats int `form:"seats" validate:"required,gt=0"`
+	// CarID prices the trip from the car's fuel consumption
+	CarID *string `form:"carId"`
 }
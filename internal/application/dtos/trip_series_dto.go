@@ -0,0 +1,38 @@
+package dtos
+
+// CreateTripSeriesInput contains the data for creating a recurring trip
+type CreateTripSeriesInput struct {
+	Kms           int    `json:"kms" validate:"required,gt=0"`
+	Seats         int    `json:"seats" validate:"required,gt=0"`
+	CarID         string `json:"carId" validate:"required,min=1"`
+	DepartureCity string `json:"departureCity" validate:"required,min=1"`
+	ArrivalCity   string `json:"arrivalCity" validate:"required,min=1"`
+	// DepartureTime is the time of day on the departure city's clock ("15:04")
+	DepartureTime string `json:"departureTime" validate:"required"`
+	// Weekdays are RRULE BYDAY codes
+	Weekdays []string `json:"weekdays" validate:"required,min=1,max=7,dive,oneof=MO TU WE TH FR SA SU"`
+	// Interval is the number of weeks between repetitions, every week when omitted
+	Interval   int      `json:"interval" validate:"omitempty,gte=1,lte=52"`
+	StartDate  string   `json:"startDate" validate:"required"`
+	EndDate    *string  `json:"endDate"`
+	Exceptions []string `json:"exceptions" validate:"omitempty,max=366"`
+	// PricePerSeat is in cents; the suggested price when omitted
+	PricePerSeat *int `json:"pricePerSeat" validate:"omitempty,gte=0"`
+}
+
+// UpdateTripSeriesInput contains the fields of a series to change; omitted fields are kept
+type UpdateTripSeriesInput struct {
+	Seats         *int     `json:"seats" validate:"omitempty,gt=0"`
+	DepartureTime *string  `json:"departureTime"`
+	Weekdays      []string `json:"weekdays" validate:"omitempty,max=7,dive,oneof=MO TU WE TH FR SA SU"`
+	Interval      *int     `json:"interval" validate:"omitempty,gte=1,lte=52"`
+	EndDate       *string  `json:"endDate"`
+	Exceptions    []string `json:"exceptions" validate:"omitempty,max=366"`
+}
+
+// UpdateTripOccurrenceInput contains the changes to a single occurrence of a series
+type UpdateTripOccurrenceInput struct {
+	// Date is the new departure time, formatted as in CreateTripInput
+	Date  *string `json:"date" validate:"omitempty,min=1"`
+	Seats *int    `json:"seats" validate:"omitempty,gt=0"`
+}
@@ -6,3 +6,19 @@ type UpdateProfileInput struct {
 	LastName  string `json:"lastName" validate:"required,min=1"`
 	Phone     string `json:"phone" validate:"required,min=10"`
 }
+
+// UpdateUserRoleInput contains the role an administrator assigns to a user
+type UpdateUserRoleInput struct {
+	Role string `json:"role" validate:"required,min=1,max=50"`
+}
+
+// SuspendUserInput contains the length of and reason for a suspension
+type SuspendUserInput struct {
+	Days   int    `json:"days" validate:"required,min=1,max=365"`
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
+
+// BanUserInput contains the reason for a permanent ban
+type BanUserInput struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
@@ -0,0 +1,42 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+func toApiKeyResponse(k *entities.ApiKey) dtos.ApiKeyResponse {
+	return dtos.ApiKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func toServiceAccountResponse(a *entities.ServiceAccount) dtos.ServiceAccountResponse {
+	return dtos.ServiceAccountResponse{ID: a.ID, Name: a.Name, Role: a.Role, CreatedAt: a.CreatedAt}
+}
+
+// checkOwnerExists checks that a service account owner exists; users are authenticated already
+func checkOwnerExists(ctx context.Context, serviceAccountRepository repositories.ServiceAccountRepository, owner entities.ApiKeyOwner) error {
+	if owner.ServiceAccountID == "" {
+		return nil
+	}
+	account, err := serviceAccountRepository.FindByID(ctx, owner.ServiceAccountID)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return domainerrors.NewServiceAccountNotFoundError(owner.ServiceAccountID)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package apikey
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// apiKeyIDLength is how many random characters follow ApiKeyPrefix to identify a key
+const apiKeyIDLength = 8
+
+type CreateApiKeyUseCase struct {
+	apiKeyRepository         repositories.ApiKeyRepository
+	serviceAccountRepository repositories.ServiceAccountRepository
+	tokenService             services.TokenService
+}
+
+func NewCreateApiKeyUseCase(
+	apiKeyRepository repositories.ApiKeyRepository,
+	serviceAccountRepository repositories.ServiceAccountRepository,
+	tokenService services.TokenService,
+) *CreateApiKeyUseCase {
+	return &CreateApiKeyUseCase{
+		apiKeyRepository:         apiKeyRepository,
+		serviceAccountRepository: serviceAccountRepository,
+		tokenService:             tokenService,
+	}
+}
+
+// Execute issues a key for owner. Keys look like cvk_<id>_<secret>; the cvk_<id> part is
+// stored in clear so a key can be recognized, the whole key only as a hash.
+func (uc *CreateApiKeyUseCase) Execute(ctx context.Context, owner entities.ApiKeyOwner, input dtos.CreateApiKeyInput) (*dtos.CreatedApiKeyResponse, error) {
+	if err := checkOwnerExists(ctx, uc.serviceAccountRepository, owner); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := uc.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+	prefix := entities.ApiKeyPrefix + id[:apiKeyIDLength]
+	key := prefix + "_" + secret
+
+	scopes := slices.Clone(input.Scopes)
+	slices.Sort(scopes)
+	scopes = slices.Compact(scopes)
+
+	data := entities.CreateApiKeyData{
+		Name:    input.Name,
+		Prefix:  prefix,
+		KeyHash: uc.tokenService.Hash(key),
+		Scopes:  scopes,
+	}
+	if owner.UserID != "" {
+		data.UserID = &owner.UserID
+	} else {
+		data.ServiceAccountID = &owner.ServiceAccountID
+	}
+	if input.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *input.ExpiresInDays)
+		data.ExpiresAt = &expiresAt
+	}
+
+	created, err := uc.apiKeyRepository.Create(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.CreatedApiKeyResponse{ApiKeyResponse: toApiKeyResponse(created), Key: key}, nil
+}
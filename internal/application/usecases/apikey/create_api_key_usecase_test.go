@@ -0,0 +1,105 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKeyID     = "AbCdEfGhIjKl"
+	testKeySecret = "secret-part"
+	testKey       = "cvk_AbCdEfGh_secret-part"
+)
+
+func expectKeyGenerated(tokenSvc *mocks.MockTokenService) {
+	tokenSvc.EXPECT().Generate().Return(testKeyID, nil).Once()
+	tokenSvc.EXPECT().Generate().Return(testKeySecret, nil).Once()
+	tokenSvc.EXPECT().Hash(testKey).Return("key-hash")
+}
+
+func TestCreateApiKey_ForUser(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	expectKeyGenerated(tokenSvc)
+	apiKeyRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateApiKeyData) bool {
+		return data.Name == "backup" && data.Prefix == "cvk_AbCdEfGh" && data.KeyHash == "key-hash" &&
+			*data.UserID == "user-1" && data.ServiceAccountID == nil &&
+			assert.ObjectsAreEqual([]string{"read", "write"}, data.Scopes) &&
+			data.ExpiresAt != nil && time.Until(*data.ExpiresAt) > 29*24*time.Hour
+	})).Return(&entities.ApiKey{ID: "key-1", Name: "backup", Prefix: "cvk_AbCdEfGh", Scopes: []string{"read", "write"}}, nil)
+
+	days := 30
+	uc := NewCreateApiKeyUseCase(apiKeyRepo, accountRepo, tokenSvc)
+	result, err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, dtos.CreateApiKeyInput{
+		Name:          "backup",
+		Scopes:        []string{"write", "read", "write"},
+		ExpiresInDays: &days,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, testKey, result.Key)
+	assert.Equal(t, "key-1", result.ID)
+	assert.Equal(t, "cvk_AbCdEfGh", result.Prefix)
+}
+
+func TestCreateApiKey_ForServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	accountRepo.EXPECT().FindByID(ctx, "sa-1").Return(&entities.ServiceAccount{ID: "sa-1"}, nil)
+	expectKeyGenerated(tokenSvc)
+	apiKeyRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateApiKeyData) bool {
+		return *data.ServiceAccountID == "sa-1" && data.UserID == nil && data.ExpiresAt == nil
+	})).Return(&entities.ApiKey{ID: "key-1"}, nil)
+
+	uc := NewCreateApiKeyUseCase(apiKeyRepo, accountRepo, tokenSvc)
+	result, err := uc.Execute(ctx, entities.ApiKeyOwner{ServiceAccountID: "sa-1"}, dtos.CreateApiKeyInput{
+		Name:   "nightly",
+		Scopes: []string{"read"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, testKey, result.Key)
+}
+
+func TestCreateApiKey_UnknownServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+
+	accountRepo.EXPECT().FindByID(ctx, "sa-1").Return(nil, nil)
+
+	uc := NewCreateApiKeyUseCase(mocks.NewMockApiKeyRepository(t), accountRepo, mocks.NewMockTokenService(t))
+	_, err := uc.Execute(ctx, entities.ApiKeyOwner{ServiceAccountID: "sa-1"}, dtos.CreateApiKeyInput{Name: "x", Scopes: []string{"read"}})
+
+	var notFound *domainerrors.ServiceAccountNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestCreateApiKey_RepoError(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	expectKeyGenerated(tokenSvc)
+	apiKeyRepo.EXPECT().Create(ctx, mock.Anything).Return(nil, errors.New("db error"))
+
+	uc := NewCreateApiKeyUseCase(apiKeyRepo, mocks.NewMockServiceAccountRepository(t), tokenSvc)
+	_, err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, dtos.CreateApiKeyInput{Name: "x", Scopes: []string{"read"}})
+
+	assert.EqualError(t, err, "db error")
+}
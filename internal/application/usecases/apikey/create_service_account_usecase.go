@@ -0,0 +1,46 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type CreateServiceAccountUseCase struct {
+	serviceAccountRepository repositories.ServiceAccountRepository
+	roleRepository           repositories.RoleRepository
+}
+
+func NewCreateServiceAccountUseCase(
+	serviceAccountRepository repositories.ServiceAccountRepository,
+	roleRepository repositories.RoleRepository,
+) *CreateServiceAccountUseCase {
+	return &CreateServiceAccountUseCase{
+		serviceAccountRepository: serviceAccountRepository,
+		roleRepository:           roleRepository,
+	}
+}
+
+// Execute creates a service account acting with one of the roles defined in the database
+func (uc *CreateServiceAccountUseCase) Execute(ctx context.Context, input dtos.CreateServiceAccountInput) (*dtos.ServiceAccountResponse, error) {
+	role, err := uc.roleRepository.FindByName(ctx, input.Role)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, domainerrors.NewRoleNotFoundError(input.Role)
+	}
+
+	account, err := uc.serviceAccountRepository.Create(ctx, entities.CreateServiceAccountData{
+		Name: input.Name,
+		Role: role.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := toServiceAccountResponse(account)
+	return &result, nil
+}
@@ -0,0 +1,48 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ListApiKeysUseCase struct {
+	apiKeyRepository         repositories.ApiKeyRepository
+	serviceAccountRepository repositories.ServiceAccountRepository
+}
+
+func NewListApiKeysUseCase(
+	apiKeyRepository repositories.ApiKeyRepository,
+	serviceAccountRepository repositories.ServiceAccountRepository,
+) *ListApiKeysUseCase {
+	return &ListApiKeysUseCase{
+		apiKeyRepository:         apiKeyRepository,
+		serviceAccountRepository: serviceAccountRepository,
+	}
+}
+
+// Execute lists the owner's keys, newest first, including revoked and expired ones
+func (uc *ListApiKeysUseCase) Execute(ctx context.Context, owner entities.ApiKeyOwner) ([]dtos.ApiKeyResponse, error) {
+	if err := checkOwnerExists(ctx, uc.serviceAccountRepository, owner); err != nil {
+		return nil, err
+	}
+
+	var keys []entities.ApiKey
+	var err error
+	if owner.UserID != "" {
+		keys, err = uc.apiKeyRepository.FindByUserID(ctx, owner.UserID)
+	} else {
+		keys, err = uc.apiKeyRepository.FindByServiceAccountID(ctx, owner.ServiceAccountID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.ApiKeyResponse, len(keys))
+	for i := range keys {
+		result[i] = toApiKeyResponse(&keys[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListApiKeys_ForUser(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+
+	apiKeyRepo.EXPECT().FindByUserID(ctx, "user-1").Return([]entities.ApiKey{
+		{ID: "key-1", Name: "backup", Prefix: "cvk_AbCdEfGh", KeyHash: "key-hash", Scopes: []string{"read"}},
+	}, nil)
+
+	uc := NewListApiKeysUseCase(apiKeyRepo, mocks.NewMockServiceAccountRepository(t))
+	result, err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"})
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "key-1", result[0].ID)
+	assert.Equal(t, "cvk_AbCdEfGh", result[0].Prefix)
+}
+
+func TestListApiKeys_ForServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+
+	accountRepo.EXPECT().FindByID(ctx, "sa-1").Return(&entities.ServiceAccount{ID: "sa-1"}, nil)
+	apiKeyRepo.EXPECT().FindByServiceAccountID(ctx, "sa-1").Return([]entities.ApiKey{}, nil)
+
+	uc := NewListApiKeysUseCase(apiKeyRepo, accountRepo)
+	result, err := uc.Execute(ctx, entities.ApiKeyOwner{ServiceAccountID: "sa-1"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestListApiKeys_UnknownServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+
+	accountRepo.EXPECT().FindByID(ctx, "sa-1").Return(nil, nil)
+
+	uc := NewListApiKeysUseCase(mocks.NewMockApiKeyRepository(t), accountRepo)
+	_, err := uc.Execute(ctx, entities.ApiKeyOwner{ServiceAccountID: "sa-1"})
+
+	var notFound *domainerrors.ServiceAccountNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
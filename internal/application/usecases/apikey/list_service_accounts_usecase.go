@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ListServiceAccountsUseCase struct {
+	serviceAccountRepository repositories.ServiceAccountRepository
+}
+
+func NewListServiceAccountsUseCase(serviceAccountRepository repositories.ServiceAccountRepository) *ListServiceAccountsUseCase {
+	return &ListServiceAccountsUseCase{
+		serviceAccountRepository: serviceAccountRepository,
+	}
+}
+
+func (uc *ListServiceAccountsUseCase) Execute(ctx context.Context) ([]dtos.ServiceAccountResponse, error) {
+	accounts, err := uc.serviceAccountRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dtos.ServiceAccountResponse, len(accounts))
+	for i := range accounts {
+		result[i] = toServiceAccountResponse(&accounts[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type RevokeApiKeyUseCase struct {
+	apiKeyRepository repositories.ApiKeyRepository
+}
+
+func NewRevokeApiKeyUseCase(apiKeyRepository repositories.ApiKeyRepository) *RevokeApiKeyUseCase {
+	return &RevokeApiKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+// Execute revokes one of the owner's keys. Revoking an already revoked key succeeds.
+func (uc *RevokeApiKeyUseCase) Execute(ctx context.Context, owner entities.ApiKeyOwner, id string) error {
+	key, err := uc.apiKeyRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	// Someone else's key is reported as missing so key IDs cannot be probed
+	if key == nil || !owner.Owns(key) {
+		return domainerrors.NewApiKeyNotFoundError(id)
+	}
+
+	_, err = uc.apiKeyRepository.Revoke(ctx, id)
+	return err
+}
@@ -0,0 +1,81 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func userKey(userID string) *entities.ApiKey {
+	return &entities.ApiKey{ID: "key-1", UserID: &userID}
+}
+
+func TestRevokeApiKey_Success(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+
+	apiKeyRepo.EXPECT().FindByID(ctx, "key-1").Return(userKey("user-1"), nil)
+	apiKeyRepo.EXPECT().Revoke(ctx, "key-1").Return(true, nil)
+
+	uc := NewRevokeApiKeyUseCase(apiKeyRepo)
+	err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, "key-1")
+
+	assert.NoError(t, err)
+}
+
+func TestRevokeApiKey_AlreadyRevoked(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+
+	apiKeyRepo.EXPECT().FindByID(ctx, "key-1").Return(userKey("user-1"), nil)
+	apiKeyRepo.EXPECT().Revoke(ctx, "key-1").Return(false, nil)
+
+	uc := NewRevokeApiKeyUseCase(apiKeyRepo)
+	err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, "key-1")
+
+	assert.NoError(t, err)
+}
+
+func TestRevokeApiKey_NotFound(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+
+	apiKeyRepo.EXPECT().FindByID(ctx, "key-1").Return(nil, nil)
+
+	uc := NewRevokeApiKeyUseCase(apiKeyRepo)
+	err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, "key-1")
+
+	var notFound *domainerrors.ApiKeyNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRevokeApiKey_OtherOwner(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+
+	apiKeyRepo.EXPECT().FindByID(ctx, "key-1").Return(userKey("user-2"), nil)
+
+	uc := NewRevokeApiKeyUseCase(apiKeyRepo)
+	err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, "key-1")
+
+	var notFound *domainerrors.ApiKeyNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRevokeApiKey_UserCannotRevokeServiceAccountKey(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	accountID := "sa-1"
+
+	apiKeyRepo.EXPECT().FindByID(ctx, "key-1").Return(&entities.ApiKey{ID: "key-1", ServiceAccountID: &accountID}, nil)
+
+	uc := NewRevokeApiKeyUseCase(apiKeyRepo)
+	err := uc.Execute(ctx, entities.ApiKeyOwner{UserID: "user-1"}, "key-1")
+
+	var notFound *domainerrors.ApiKeyNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
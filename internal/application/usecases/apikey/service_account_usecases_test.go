@@ -0,0 +1,72 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServiceAccount_Success(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+
+	roleRepo.EXPECT().FindByName(ctx, "ADMIN").Return(&entities.Role{Name: "ADMIN"}, nil)
+	accountRepo.EXPECT().Create(ctx, entities.CreateServiceAccountData{Name: "billing-export", Role: "ADMIN"}).
+		Return(&entities.ServiceAccount{ID: "sa-1", Name: "billing-export", Role: "ADMIN"}, nil)
+
+	uc := NewCreateServiceAccountUseCase(accountRepo, roleRepo)
+	result, err := uc.Execute(ctx, dtos.CreateServiceAccountInput{Name: "billing-export", Role: "ADMIN"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sa-1", result.ID)
+	assert.Equal(t, "ADMIN", result.Role)
+}
+
+func TestCreateServiceAccount_RepoError(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+
+	roleRepo.EXPECT().FindByName(ctx, "USER").Return(&entities.Role{Name: "USER"}, nil)
+	accountRepo.EXPECT().Create(ctx, entities.CreateServiceAccountData{Name: "x", Role: "USER"}).Return(nil, errors.New("db error"))
+
+	uc := NewCreateServiceAccountUseCase(accountRepo, roleRepo)
+	_, err := uc.Execute(ctx, dtos.CreateServiceAccountInput{Name: "x", Role: "USER"})
+
+	assert.EqualError(t, err, "db error")
+}
+
+func TestCreateServiceAccount_UnknownRole(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+
+	roleRepo.EXPECT().FindByName(ctx, "ROOT").Return(nil, nil)
+
+	uc := NewCreateServiceAccountUseCase(accountRepo, roleRepo)
+	_, err := uc.Execute(ctx, dtos.CreateServiceAccountInput{Name: "x", Role: "ROOT"})
+
+	var domainErr *domainerrors.RoleNotFoundError
+	assert.ErrorAs(t, err, &domainErr)
+}
+
+func TestListServiceAccounts_Success(t *testing.T) {
+	ctx := context.Background()
+	accountRepo := mocks.NewMockServiceAccountRepository(t)
+
+	accountRepo.EXPECT().FindAll(ctx).Return([]entities.ServiceAccount{{ID: "sa-1", Name: "a"}, {ID: "sa-2", Name: "b"}}, nil)
+
+	uc := NewListServiceAccountsUseCase(accountRepo)
+	result, err := uc.Execute(ctx)
+
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+}
@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ListAuditLogsUseCase struct {
+	auditLogRepository repositories.AuditLogRepository
+}
+
+func NewListAuditLogsUseCase(auditLogRepository repositories.AuditLogRepository) *ListAuditLogsUseCase {
+	return &ListAuditLogsUseCase{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// Execute returns the matching audit log entries, newest first
+func (uc *ListAuditLogsUseCase) Execute(ctx context.Context, query dtos.AuditLogQuery, params entities.PaginationParams) (*entities.PaginatedResult[dtos.AuditLogResponse], error) {
+	filters := entities.AuditLogFilters{
+		Action:   query.Action,
+		ActorID:  query.ActorID,
+		TargetID: query.TargetID,
+		Email:    query.Email,
+		Success:  query.Success,
+		From:     query.From,
+		To:       query.To,
+	}
+	logs, total, err := uc.auditLogRepository.FindByFilters(ctx, filters, params.Skip(), params.Take())
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dtos.AuditLogResponse, len(logs))
+	for i, l := range logs {
+		data[i] = dtos.AuditLogResponse{
+			ID:        l.ID,
+			Action:    l.Action,
+			Success:   l.Success,
+			ActorID:   l.ActorID,
+			TargetID:  l.TargetID,
+			Email:     l.Email,
+			ErrorCode: l.ErrorCode,
+			IPAddress: l.IPAddress,
+			RequestID: l.RequestID,
+			CreatedAt: l.CreatedAt,
+		}
+	}
+	return &entities.PaginatedResult[dtos.AuditLogResponse]{
+		Data: data,
+		Meta: entities.BuildPaginationMeta(params, total),
+	}, nil
+}
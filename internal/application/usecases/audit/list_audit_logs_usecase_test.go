@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAuditLogs_Success(t *testing.T) {
+	ctx := context.Background()
+	auditRepo := mocks.NewMockAuditLogRepository(t)
+
+	targetID := "user-2"
+	action := entities.AuditActionBan
+	logs := []entities.AuditLog{
+		{ID: "log-1", Action: entities.AuditActionBan, Success: true, TargetID: &targetID, IPAddress: "198.51.100.1", RequestID: "req-1"},
+	}
+
+	params := entities.PaginationParams{Page: 2, Limit: 10}
+	auditRepo.EXPECT().FindByFilters(ctx, entities.AuditLogFilters{Action: &action, TargetID: &targetID}, 10, 10).Return(logs, 11, nil)
+
+	uc := NewListAuditLogsUseCase(auditRepo)
+	result, err := uc.Execute(ctx, dtos.AuditLogQuery{Action: &action, TargetID: &targetID}, params)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "log-1", result.Data[0].ID)
+	assert.Equal(t, "req-1", result.Data[0].RequestID)
+	assert.Equal(t, 11, result.Meta.Total)
+	assert.Equal(t, 2, result.Meta.TotalPages)
+}
+
+func TestListAuditLogs_RepoError(t *testing.T) {
+	ctx := context.Background()
+	auditRepo := mocks.NewMockAuditLogRepository(t)
+
+	repoErr := errors.New("database error")
+	auditRepo.EXPECT().FindByFilters(ctx, entities.AuditLogFilters{}, 0, 20).Return(nil, 0, repoErr)
+
+	uc := NewListAuditLogsUseCase(auditRepo)
+	result, err := uc.Execute(ctx, dtos.AuditLogQuery{}, entities.DefaultPagination())
+
+	assert.Nil(t, result)
+	assert.Equal(t, repoErr, err)
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type ChangePasswordUseCase struct {
+	authRepository            repositories.AuthRepository
+	userRepository            repositories.UserRepository
+	passwordHistoryRepository repositories.PasswordHistoryRepository
+	tokenVersionRepository    repositories.TokenVersionRepository
+	passwordService           services.PasswordService
+	historySize               int
+	issuer                    *tokenIssuer
+}
+
+// NewChangePasswordUseCase creates the use case. historySize is how many recent passwords,
+// including the current one, cannot be reused; the current one is always refused.
+func NewChangePasswordUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	passwordHistoryRepository repositories.PasswordHistoryRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+	passwordService services.PasswordService,
+	jwtService services.JwtService,
+	tokenService services.TokenService,
+	historySize int,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		authRepository:            authRepository,
+		userRepository:            userRepository,
+		passwordHistoryRepository: passwordHistoryRepository,
+		tokenVersionRepository:    tokenVersionRepository,
+		passwordService:           passwordService,
+		historySize:               max(historySize, 1),
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
+	}
+}
+
+// Execute replaces the user's password after checking the current one. Every access and
+// refresh token issued before the change stops working and every session is signed out; the
+// caller gets a new token pair in a new session.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID string, client entities.SessionClient, input dtos.ChangePasswordInput) (*dtos.AuthResponse, error) {
+	auth, err := findAuthForUser(ctx, uc.userRepository, uc.authRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := uc.passwordService.Verify(input.CurrentPassword, auth.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, domainerrors.NewInvalidCurrentPasswordError()
+	}
+
+	reused, err := uc.isRecentPassword(ctx, auth, input.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, domainerrors.NewPasswordReusedError()
+	}
+
+	hashedPassword, err := uc.passwordService.Hash(input.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	// The current password joins the history; with it, historySize passwords are remembered
+	if err := uc.passwordHistoryRepository.Add(ctx, auth.RefID, auth.Password, uc.historySize-1); err != nil {
+		return nil, err
+	}
+	if err := uc.authRepository.UpdatePassword(ctx, auth.RefID, hashedPassword); err != nil {
+		return nil, err
+	}
+
+	// UpdatePassword bumped the token version, which rejects existing access tokens
+	if err := uc.tokenVersionRepository.Invalidate(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.issuer.refreshTokenRepository.RevokeAllForAuth(ctx, auth.RefID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.issuer.sessionRepository.RevokeAllForAuth(ctx, auth.RefID); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.authRepository.FindByRefID(ctx, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+	return uc.issuer.issue(ctx, userID, updated, client)
+}
+
+// isRecentPassword reports whether password matches the current one or one in the history
+func (uc *ChangePasswordUseCase) isRecentPassword(ctx context.Context, auth *entities.Auth, password string) (bool, error) {
+	hashes := []string{auth.Password}
+	previous, err := uc.passwordHistoryRepository.FindRecent(ctx, auth.RefID, uc.historySize-1)
+	if err != nil {
+		return false, err
+	}
+	hashes = append(hashes, previous...)
+
+	for _, hash := range hashes {
+		match, err := uc.passwordService.Verify(password, hash)
+		if err != nil {
+			return false, err
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type changePasswordDeps struct {
+	authRepo      *mocks.MockAuthRepository
+	userRepo      *mocks.MockUserRepository
+	refreshRepo   *mocks.MockRefreshTokenRepository
+	sessionRepo   *mocks.MockSessionRepository
+	historyRepo   *mocks.MockPasswordHistoryRepository
+	tokenVersions *mocks.MockTokenVersionRepository
+	passwordSvc   *mocks.MockPasswordService
+	jwtSvc        *mocks.MockJwtService
+	tokenSvc      *mocks.MockTokenService
+	uc            *ChangePasswordUseCase
+}
+
+func setupChangePassword(t *testing.T) changePasswordDeps {
+	d := changePasswordDeps{
+		authRepo:      mocks.NewMockAuthRepository(t),
+		userRepo:      mocks.NewMockUserRepository(t),
+		refreshRepo:   mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo:   mocks.NewMockSessionRepository(t),
+		historyRepo:   mocks.NewMockPasswordHistoryRepository(t),
+		tokenVersions: mocks.NewMockTokenVersionRepository(t),
+		passwordSvc:   mocks.NewMockPasswordService(t),
+		jwtSvc:        mocks.NewMockJwtService(t),
+		tokenSvc:      mocks.NewMockTokenService(t),
+	}
+	d.uc = NewChangePasswordUseCase(d.authRepo, d.userRepo, d.refreshRepo, d.sessionRepo, d.historyRepo, d.tokenVersions,
+		d.passwordSvc, d.jwtSvc, d.tokenSvc, 3)
+	return d
+}
+
+func validChangePasswordInput() dtos.ChangePasswordInput {
+	return dtos.ChangePasswordInput{CurrentPassword: "OldPassword1", NewPassword: "NewPassword1", ConfirmPassword: "NewPassword1"}
+}
+
+// expectAccountFound sets up the lookup of user-1 and its auth record
+func (d changePasswordDeps) expectAccountFound(ctx context.Context) {
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Role: "USER", Password: "old-hash"}, nil).Once()
+}
+
+func TestChangePassword_Success(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	d.expectAccountFound(ctx)
+	d.passwordSvc.EXPECT().Verify("OldPassword1", "old-hash").Return(true, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "old-hash").Return(false, nil)
+	d.historyRepo.EXPECT().FindRecent(ctx, int64(100), 2).Return([]string{"older-hash"}, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "older-hash").Return(false, nil)
+	d.passwordSvc.EXPECT().Hash("NewPassword1").Return("new-hash", nil)
+	d.historyRepo.EXPECT().Add(ctx, int64(100), "old-hash", 2).Return(nil)
+	d.authRepo.EXPECT().UpdatePassword(ctx, int64(100), "new-hash").Return(nil)
+	d.tokenVersions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return(nil)
+	d.sessionRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return([]string{"session-0"}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Role: "USER", Password: "new-hash", TokenVersion: 1}, nil).Once()
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", TokenVersion: 1, SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, d.jwtSvc, d.tokenSvc, d.refreshRepo, d.sessionRepo, 100)
+
+	result, err := d.uc.Execute(ctx, "user-1", testSessionClient, validChangePasswordInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.Equal(t, "refresh-token", result.RefreshToken)
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	d.expectAccountFound(ctx)
+	d.passwordSvc.EXPECT().Verify("OldPassword1", "old-hash").Return(false, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", testSessionClient, validChangePasswordInput())
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.InvalidCurrentPasswordError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestChangePassword_SameAsCurrent(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	d.expectAccountFound(ctx)
+	d.passwordSvc.EXPECT().Verify("OldPassword1", "old-hash").Return(true, nil)
+	d.historyRepo.EXPECT().FindRecent(ctx, int64(100), 2).Return(nil, nil)
+
+	input := validChangePasswordInput()
+	input.NewPassword = "OldPassword1"
+	input.ConfirmPassword = "OldPassword1"
+	result, err := d.uc.Execute(ctx, "user-1", testSessionClient, input)
+
+	assert.Nil(t, result)
+	var reusedErr *domainerrors.PasswordReusedError
+	assert.True(t, errors.As(err, &reusedErr))
+}
+
+func TestChangePassword_ReusesRecentPassword(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	d.expectAccountFound(ctx)
+	d.passwordSvc.EXPECT().Verify("OldPassword1", "old-hash").Return(true, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "old-hash").Return(false, nil)
+	d.historyRepo.EXPECT().FindRecent(ctx, int64(100), 2).Return([]string{"older-hash", "oldest-hash"}, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "older-hash").Return(false, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "oldest-hash").Return(true, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", testSessionClient, validChangePasswordInput())
+
+	assert.Nil(t, result)
+	var reusedErr *domainerrors.PasswordReusedError
+	assert.True(t, errors.As(err, &reusedErr))
+}
+
+func TestChangePassword_UserNotFound(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	d.userRepo.EXPECT().FindByID(ctx, "ghost").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, "ghost", testSessionClient, validChangePasswordInput())
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestChangePassword_UpdateError(t *testing.T) {
+	d := setupChangePassword(t)
+	ctx := context.Background()
+
+	dbErr := errors.New("connection refused")
+	d.expectAccountFound(ctx)
+	d.passwordSvc.EXPECT().Verify("OldPassword1", "old-hash").Return(true, nil)
+	d.passwordSvc.EXPECT().Verify("NewPassword1", "old-hash").Return(false, nil)
+	d.historyRepo.EXPECT().FindRecent(ctx, int64(100), 2).Return(nil, nil)
+	d.passwordSvc.EXPECT().Hash("NewPassword1").Return("new-hash", nil)
+	d.historyRepo.EXPECT().Add(ctx, int64(100), "old-hash", 2).Return(nil)
+	d.authRepo.EXPECT().UpdatePassword(ctx, int64(100), "new-hash").Return(dbErr)
+
+	result, err := d.uc.Execute(ctx, "user-1", testSessionClient, validChangePasswordInput())
+
+	assert.Nil(t, result)
+	assert.Equal(t, dbErr, err)
+}
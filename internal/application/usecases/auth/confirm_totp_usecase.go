@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type ConfirmTotpUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	recoveryCodeRepository repositories.RecoveryCodeRepository
+	totpService            services.TotpService
+	tokenService           services.TokenService
+}
+
+func NewConfirmTotpUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+	totpService services.TotpService,
+	tokenService services.TokenService,
+) *ConfirmTotpUseCase {
+	return &ConfirmTotpUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		totpService:            totpService,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute enables two-factor once the user proves their authenticator app holds the
+// pending secret, and returns a fresh set of recovery codes
+func (uc *ConfirmTotpUseCase) Execute(ctx context.Context, userID string, input dtos.ConfirmTotpInput) (*dtos.RecoveryCodesResponse, error) {
+	auth, err := findAuthForUser(ctx, uc.userRepository, uc.authRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+	if auth.IsTwoFactorEnabled() {
+		return nil, domainerrors.NewTwoFactorAlreadyEnabledError()
+	}
+	if !auth.HasPendingTotpEnrollment() {
+		return nil, domainerrors.NewTwoFactorNotEnrolledError()
+	}
+
+	valid, err := verifyTotpCode(ctx, uc.authRepository, uc.totpService, auth, input.Code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, domainerrors.NewInvalidTwoFactorCodeError()
+	}
+
+	if err := uc.authRepository.EnableTotp(ctx, auth.RefID); err != nil {
+		return nil, err
+	}
+
+	codes, err := generateRecoveryCodes(ctx, uc.recoveryCodeRepository, uc.totpService, uc.tokenService, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
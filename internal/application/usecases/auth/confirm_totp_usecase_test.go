@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingTotpAuth() *entities.Auth {
+	secret := "SECRET"
+	return &entities.Auth{RefID: 100, Email: "user@example.com", TotpSecret: &secret}
+}
+
+func TestConfirmTotp_Success(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	recoveryRepo := mocks.NewMockRecoveryCodeRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(pendingTotpAuth(), nil)
+	totpSvc.EXPECT().Validate("SECRET", "123456", mock.AnythingOfType("time.Time")).Return(int64(42), true)
+	authRepo.EXPECT().UseTotpStep(ctx, int64(100), int64(42)).Return(true, nil)
+	authRepo.EXPECT().EnableTotp(ctx, int64(100)).Return(nil)
+	totpSvc.EXPECT().GenerateRecoveryCode().Return("ABCD-EFGH-JKLM-NPQR", nil).Times(recoveryCodeCount)
+	tokenSvc.EXPECT().Hash("ABCDEFGHJKLMNPQR").Return("code-hash").Times(recoveryCodeCount)
+	recoveryRepo.EXPECT().ReplaceAll(ctx, int64(100), mock.Anything).Return(nil)
+
+	uc := NewConfirmTotpUseCase(authRepo, userRepo, recoveryRepo, totpSvc, tokenSvc)
+	result, err := uc.Execute(ctx, "user-1", dtos.ConfirmTotpInput{Code: "123456"})
+
+	require.NoError(t, err)
+	assert.Len(t, result.RecoveryCodes, recoveryCodeCount)
+}
+
+func TestConfirmTotp_InvalidCode(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	recoveryRepo := mocks.NewMockRecoveryCodeRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(pendingTotpAuth(), nil)
+	totpSvc.EXPECT().Validate("SECRET", "000000", mock.AnythingOfType("time.Time")).Return(int64(0), false)
+
+	uc := NewConfirmTotpUseCase(authRepo, userRepo, recoveryRepo, totpSvc, tokenSvc)
+	result, err := uc.Execute(ctx, "user-1", dtos.ConfirmTotpInput{Code: "000000"})
+
+	assert.Nil(t, result)
+	var codeErr *domainerrors.InvalidTwoFactorCodeError
+	assert.True(t, errors.As(err, &codeErr))
+}
+
+func TestConfirmTotp_NotEnrolled(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	recoveryRepo := mocks.NewMockRecoveryCodeRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100}, nil)
+
+	uc := NewConfirmTotpUseCase(authRepo, userRepo, recoveryRepo, totpSvc, tokenSvc)
+	result, err := uc.Execute(ctx, "user-1", dtos.ConfirmTotpInput{Code: "123456"})
+
+	assert.Nil(t, result)
+	var notEnrolledErr *domainerrors.TwoFactorNotEnrolledError
+	assert.True(t, errors.As(err, &notEnrolledErr))
+}
+
+func TestConfirmTotp_AlreadyEnabled(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	recoveryRepo := mocks.NewMockRecoveryCodeRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+
+	uc := NewConfirmTotpUseCase(authRepo, userRepo, recoveryRepo, totpSvc, tokenSvc)
+	result, err := uc.Execute(ctx, "user-1", dtos.ConfirmTotpInput{Code: "123456"})
+
+	assert.Nil(t, result)
+	var enabledErr *domainerrors.TwoFactorAlreadyEnabledError
+	assert.True(t, errors.As(err, &enabledErr))
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type EnrollTotpUseCase struct {
+	authRepository repositories.AuthRepository
+	userRepository repositories.UserRepository
+	totpService    services.TotpService
+}
+
+func NewEnrollTotpUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	totpService services.TotpService,
+) *EnrollTotpUseCase {
+	return &EnrollTotpUseCase{
+		authRepository: authRepository,
+		userRepository: userRepository,
+		totpService:    totpService,
+	}
+}
+
+// Execute issues a new TOTP secret for the authenticated user. Two-factor stays off
+// until the secret is confirmed with ConfirmTotpUseCase.
+func (uc *EnrollTotpUseCase) Execute(ctx context.Context, userID string) (*dtos.TotpEnrollmentResponse, error) {
+	auth, err := findAuthForUser(ctx, uc.userRepository, uc.authRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+	if auth.IsTwoFactorEnabled() {
+		return nil, domainerrors.NewTwoFactorAlreadyEnabledError()
+	}
+
+	return startTotpEnrollment(ctx, uc.authRepository, uc.totpService, auth)
+}
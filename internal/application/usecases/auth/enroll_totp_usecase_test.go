@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollTotp_Success(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	totpSvc.EXPECT().GenerateSecret().Return("SECRET", nil)
+	authRepo.EXPECT().StartTotpEnrollment(ctx, int64(100), "SECRET").Return(nil)
+	totpSvc.EXPECT().ProvisioningURI("SECRET", "user@example.com").Return("otpauth://totp/x")
+
+	uc := NewEnrollTotpUseCase(authRepo, userRepo, totpSvc)
+	result, err := uc.Execute(ctx, "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "SECRET", result.Secret)
+	assert.Equal(t, "otpauth://totp/x", result.OtpauthURI)
+}
+
+func TestEnrollTotp_AlreadyEnabled(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+
+	uc := NewEnrollTotpUseCase(authRepo, userRepo, totpSvc)
+	result, err := uc.Execute(ctx, "user-1")
+
+	assert.Nil(t, result)
+	var enabledErr *domainerrors.TwoFactorAlreadyEnabledError
+	assert.True(t, errors.As(err, &enabledErr))
+}
+
+func TestEnrollTotp_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(nil, nil)
+
+	uc := NewEnrollTotpUseCase(authRepo, userRepo, totpSvc)
+	result, err := uc.Execute(ctx, "user-1")
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
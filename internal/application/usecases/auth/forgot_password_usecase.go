@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// passwordResetTokenTTL is how long an emailed reset link stays valid
+const passwordResetTokenTTL = time.Hour
+
+type ForgotPasswordUseCase struct {
+	authRepository         repositories.AuthRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	emailService           services.EmailService
+	tokenService           services.TokenService
+}
+
+func NewForgotPasswordUseCase(
+	authRepository repositories.AuthRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	emailService services.EmailService,
+	tokenService services.TokenService,
+) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		authRepository:         authRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		emailService:           emailService,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute emails a password reset link when the address belongs to an active
+// account. It succeeds silently otherwise so callers cannot probe for accounts.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, input dtos.ForgotPasswordInput) error {
+	auth, err := uc.authRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		return err
+	}
+	if auth == nil || auth.AnonymizedAt != nil {
+		return nil
+	}
+
+	token, err := createOneTimeToken(ctx, uc.oneTimeTokenRepository, uc.tokenService,
+		auth.RefID, entities.OneTimeTokenPasswordReset, passwordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	// Send reset email (fire and forget, a failure must not reveal the account exists)
+	_ = uc.emailService.SendPasswordResetEmail(auth.Email, token)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type forgotPasswordDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	oneTimeRepo *mocks.MockOneTimeTokenRepository
+	emailSvc    *mocks.MockEmailService
+	tokenSvc    *mocks.MockTokenService
+	uc          *ForgotPasswordUseCase
+}
+
+func setupForgotPassword(t *testing.T) forgotPasswordDeps {
+	d := forgotPasswordDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		oneTimeRepo: mocks.NewMockOneTimeTokenRepository(t),
+		emailSvc:    mocks.NewMockEmailService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewForgotPasswordUseCase(d.authRepo, d.oneTimeRepo, d.emailSvc, d.tokenSvc)
+	return d
+}
+
+func TestForgotPassword_SendsResetEmail(t *testing.T) {
+	d := setupForgotPassword(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.tokenSvc.EXPECT().Generate().Return("reset-token", nil)
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 &&
+			data.Purpose == entities.OneTimeTokenPasswordReset &&
+			data.TokenHash == "reset-hash" &&
+			data.ExpiresAt.After(time.Now())
+	})).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	d.emailSvc.EXPECT().SendPasswordResetEmail("user@example.com", "reset-token").Return(nil)
+
+	err := d.uc.Execute(ctx, dtos.ForgotPasswordInput{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPassword_UnknownEmail(t *testing.T) {
+	d := setupForgotPassword(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, dtos.ForgotPasswordInput{Email: "nobody@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPassword_AnonymizedAccount(t *testing.T) {
+	d := setupForgotPassword(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "gone@example.com").Return(&entities.Auth{RefID: 100, AnonymizedAt: &now}, nil)
+
+	err := d.uc.Execute(ctx, dtos.ForgotPasswordInput{Email: "gone@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPassword_EmailFailureIsSilent(t *testing.T) {
+	d := setupForgotPassword(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.tokenSvc.EXPECT().Generate().Return("reset-token", nil)
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	d.emailSvc.EXPECT().SendPasswordResetEmail("user@example.com", "reset-token").Return(errors.New("smtp down"))
+
+	err := d.uc.Execute(ctx, dtos.ForgotPasswordInput{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPassword_RepositoryError(t *testing.T) {
+	d := setupForgotPassword(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(nil, errors.New("db error"))
+
+	err := d.uc.Execute(ctx, dtos.ForgotPasswordInput{Email: "user@example.com"})
+
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,18 @@
+package auth
+
+import "github.com/lgxju/gogretago/internal/domain/services"
+
+type GetJwksUseCase struct {
+	jwtService services.JwtService
+}
+
+func NewGetJwksUseCase(jwtService services.JwtService) *GetJwksUseCase {
+	return &GetJwksUseCase{
+		jwtService: jwtService,
+	}
+}
+
+// Execute returns the public keys other services use to verify our access tokens
+func (uc *GetJwksUseCase) Execute() services.JSONWebKeySet {
+	return uc.jwtService.PublicKeys()
+}
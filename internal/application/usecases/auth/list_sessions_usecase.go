@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ListSessionsUseCase struct {
+	userRepository    repositories.UserRepository
+	sessionRepository repositories.SessionRepository
+}
+
+func NewListSessionsUseCase(
+	userRepository repositories.UserRepository,
+	sessionRepository repositories.SessionRepository,
+) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		userRepository:    userRepository,
+		sessionRepository: sessionRepository,
+	}
+}
+
+// Execute lists the user's active sessions, flagging the one currentSessionID belongs to
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID, currentSessionID string) ([]dtos.SessionResponse, error) {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+
+	sessions, err := uc.sessionRepository.FindActiveByAuthRefID(ctx, user.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtos.SessionResponse, len(sessions))
+	for i, s := range sessions {
+		result[i] = dtos.SessionResponse{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == currentSessionID,
+		}
+	}
+	return result, nil
+}
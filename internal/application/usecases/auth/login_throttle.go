@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// loginThrottle applies the lockout policy to failed login steps, whether the
+// password or the second factor was wrong
+type loginThrottle struct {
+	authRepository repositories.AuthRepository
+	policy         entities.LoginLockoutPolicy
+}
+
+// checkLocked refuses the login while the account is locked
+func (t *loginThrottle) checkLocked(auth *entities.Auth, now time.Time) error {
+	if auth.IsLocked(now) {
+		return domainerrors.NewAccountLockedError(auth.LockedUntil.Sub(now))
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt against the account and applies the lockout policy.
+// It returns the error to report: ACCOUNT_LOCKED once the account locks, invalid otherwise.
+func (t *loginThrottle) recordFailure(ctx context.Context, authRefID int64, now time.Time, invalid error) error {
+	attempts, err := t.authRepository.IncrementFailedLogins(ctx, authRefID)
+	if err != nil {
+		return err
+	}
+
+	delay := t.policy.Delay(attempts)
+	if delay > 0 {
+		if err := t.authRepository.LockUntil(ctx, authRefID, now.Add(delay)); err != nil {
+			return err
+		}
+	}
+	if t.policy.Locks(attempts) {
+		return domainerrors.NewAccountLockedError(delay)
+	}
+	return invalid
+}
+
+// reset clears earlier failures after a successful login
+func (t *loginThrottle) reset(ctx context.Context, auth *entities.Auth) error {
+	if auth.FailedLoginAttempts == 0 && auth.LockedUntil == nil {
+		return nil
+	}
+	return t.authRepository.ResetFailedLogins(ctx, auth.RefID)
+}
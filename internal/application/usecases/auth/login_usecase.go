@@ -2,35 +2,64 @@ package auth
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 	"github.com/lgxju/gogretago/internal/domain/services"
 )
 
 type LoginUseCase struct {
-	authRepository  repositories.AuthRepository
-	userRepository  repositories.UserRepository
-	passwordService services.PasswordService
-	jwtService      services.JwtService
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	passwordService        services.PasswordService
+	totpService            services.TotpService
+	twoFactorPolicy        entities.TwoFactorPolicy
+	throttle               *loginThrottle
+	issuer                 *tokenIssuer
 }
 
 func NewLoginUseCase(
 	authRepository repositories.AuthRepository,
 	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
 	passwordService services.PasswordService,
 	jwtService services.JwtService,
+	tokenService services.TokenService,
+	totpService services.TotpService,
+	lockoutPolicy entities.LoginLockoutPolicy,
+	twoFactorPolicy entities.TwoFactorPolicy,
 ) *LoginUseCase {
 	return &LoginUseCase{
-		authRepository:  authRepository,
-		userRepository:  userRepository,
-		passwordService: passwordService,
-		jwtService:      jwtService,
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		passwordService:        passwordService,
+		totpService:            totpService,
+		twoFactorPolicy:        twoFactorPolicy,
+		throttle: &loginThrottle{
+			authRepository: authRepository,
+			policy:         lockoutPolicy,
+		},
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
 	}
 }
 
-func (uc *LoginUseCase) Execute(ctx context.Context, input dtos.LoginInput) (*dtos.AuthResponse, error) {
+// Execute checks the password. Accounts with two-factor enabled, or whose role requires it,
+// get an MFA challenge to complete with TwoFactorLoginUseCase instead of a token pair.
+func (uc *LoginUseCase) Execute(ctx context.Context, client entities.SessionClient, input dtos.LoginInput) (*dtos.LoginResponse, error) {
 	auth, err := uc.authRepository.FindByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, err
@@ -39,13 +68,24 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input dtos.LoginInput) (*dt
 		return nil, domainerrors.NewInvalidCredentialsError()
 	}
 
+	// Refuse before checking the password so a locked account gives no oracle
+	now := time.Now()
+	if err := uc.throttle.checkLocked(auth, now); err != nil {
+		return nil, err
+	}
+
 	valid, err := uc.passwordService.Verify(input.Password, auth.Password)
 	if err != nil {
 		return nil, err
 	}
 	if !valid {
-		return nil, domainerrors.NewInvalidCredentialsError()
+		return nil, uc.throttle.recordFailure(ctx, auth.RefID, now, domainerrors.NewInvalidCredentialsError())
 	}
+	// Only reveal a suspension or ban to whoever knows the password
+	if err := authorization.EnsureAccountActive(auth.AccountStatus, now); err != nil {
+		return nil, err
+	}
+	uc.upgradePasswordHash(ctx, auth, input.Password)
 
 	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
 	if err != nil {
@@ -55,13 +95,36 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input dtos.LoginInput) (*dt
 		return nil, domainerrors.NewInvalidCredentialsError()
 	}
 
-	token, err := uc.jwtService.Sign(services.JwtPayload{UserID: user.ID, Role: auth.Role})
+	if auth.IsTwoFactorEnabled() || uc.twoFactorPolicy.Requires(auth.Role) {
+		// Failures are only cleared once the second step succeeds
+		return startMfaChallenge(ctx, uc.authRepository, uc.oneTimeTokenRepository, uc.totpService, uc.issuer.tokenService, auth)
+	}
+
+	if err := uc.throttle.reset(ctx, auth); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.issuer.issue(ctx, user.ID, auth, client)
 	if err != nil {
 		return nil, err
 	}
+	return &dtos.LoginResponse{AuthResponse: result}, nil
+}
 
-	return &dtos.AuthResponse{
-		UserID: user.ID,
-		Token:  token,
-	}, nil
+// upgradePasswordHash re-hashes the password with the current Argon2 parameters when the
+// stored hash was made with older ones. Only the plaintext at login makes this possible.
+// The old hash still verifies, so a failure is logged and the login goes on.
+func (uc *LoginUseCase) upgradePasswordHash(ctx context.Context, auth *entities.Auth, password string) {
+	if !uc.passwordService.NeedsRehash(auth.Password) {
+		return
+	}
+	newHash, err := uc.passwordService.Hash(password)
+	if err != nil {
+		log.Printf("Failed to rehash the password of account %d: %v", auth.RefID, err)
+		return
+	}
+	// A concurrent password change wins; the old hash is then left as is
+	if _, err := uc.authRepository.ReplacePasswordHash(ctx, auth.RefID, auth.Password, newHash); err != nil {
+		log.Printf("Failed to store the rehashed password of account %d: %v", auth.RefID, err)
+	}
 }
@@ -22,6 +22,11 @@ func TestLogin_Success(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	auth := &entities.Auth{
 		ID:       "auth-1",
@@ -43,11 +48,13 @@ func TestLogin_Success(t *testing.T) {
 
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
 	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
 	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "secret123",
 	})
@@ -55,6 +62,46 @@ func TestLogin_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "user-1", result.UserID)
 	assert.Equal(t, "jwt-token", result.Token)
+	assert.Equal(t, "refresh-token", result.RefreshToken)
+	assert.True(t, result.RefreshTokenExpiresAt.After(result.ExpiresAt))
+}
+
+func TestLogin_RefreshTokenPersistError(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "hashed-password", Role: "USER"}
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}, Email: "user@example.com"}
+
+	dbErr := errors.New("insert failed")
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	sessionRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.Session{ID: "session-1", AuthRefID: 100}, nil)
+	tokenSvc.EXPECT().Generate().Return("refresh-token", nil)
+	tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	refreshRepo.EXPECT().Create(ctx, mock.Anything).Return(nil, dbErr)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, dbErr, err)
 }
 
 func TestLogin_EmailNotFound(t *testing.T) {
@@ -63,11 +110,16 @@ func TestLogin_EmailNotFound(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	authRepo.EXPECT().FindByEmail(ctx, "unknown@example.com").Return(nil, nil)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "unknown@example.com",
 		Password: "secret123",
 	})
@@ -84,6 +136,11 @@ func TestLogin_WrongPassword(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	auth := &entities.Auth{
 		ID:       "auth-1",
@@ -95,9 +152,10 @@ func TestLogin_WrongPassword(t *testing.T) {
 
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
 	passwordSvc.EXPECT().Verify("wrong-password", "hashed-password").Return(false, nil)
+	authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(1, nil)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "wrong-password",
 	})
@@ -108,12 +166,91 @@ func TestLogin_WrongPassword(t *testing.T) {
 	assert.True(t, errors.As(err, &credErr))
 }
 
+func TestLogin_SuspendedAccount(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	until := time.Now().Add(24 * time.Hour)
+	auth := &entities.Auth{
+		ID:            "auth-1",
+		RefID:         100,
+		Email:         "user@example.com",
+		Password:      "hashed-password",
+		Role:          "USER",
+		AccountStatus: entities.AccountStatus{SuspendedUntil: &until},
+	}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	assert.Nil(t, result)
+	var suspendedErr *domainerrors.AccountSuspendedError
+	require.True(t, errors.As(err, &suspendedErr))
+	assert.Equal(t, until, suspendedErr.Until)
+}
+
+func TestLogin_SuspendedAccountWrongPassword(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	bannedAt := time.Now().Add(-time.Hour)
+	auth := &entities.Auth{
+		ID:            "auth-1",
+		RefID:         100,
+		Email:         "user@example.com",
+		Password:      "hashed-password",
+		Role:          "USER",
+		AccountStatus: entities.AccountStatus{BannedAt: &bannedAt},
+	}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("wrong-password", "hashed-password").Return(false, nil)
+	authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(1, nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	_, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "wrong-password",
+	})
+
+	// The ban is not revealed without the password
+	var credErr *domainerrors.InvalidCredentialsError
+	assert.True(t, errors.As(err, &credErr))
+}
+
 func TestLogin_UserProfileMissing(t *testing.T) {
 	ctx := context.Background()
 	authRepo := mocks.NewMockAuthRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	auth := &entities.Auth{
 		ID:       "auth-1",
@@ -125,10 +262,11 @@ func TestLogin_UserProfileMissing(t *testing.T) {
 
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
 	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
 	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(nil, nil)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "secret123",
 	})
@@ -145,12 +283,17 @@ func TestLogin_AuthRepoError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	repoErr := errors.New("database connection failed")
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(nil, repoErr)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "secret123",
 	})
@@ -166,6 +309,11 @@ func TestLogin_PasswordServiceError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	auth := &entities.Auth{
 		ID:       "auth-1",
@@ -179,8 +327,8 @@ func TestLogin_PasswordServiceError(t *testing.T) {
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
 	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(false, svcErr)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "secret123",
 	})
@@ -196,6 +344,11 @@ func TestLogin_JwtSignError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
 
 	auth := &entities.Auth{
 		ID:       "auth-1",
@@ -216,11 +369,14 @@ func TestLogin_JwtSignError(t *testing.T) {
 	jwtErr := errors.New("signing key not found")
 	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
 	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
 	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("", jwtErr)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("", jwtErr)
+	jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	sessionRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.Session{ID: "session-1", AuthRefID: 100}, nil)
 
-	uc := NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.LoginInput{
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
 		Email:    "user@example.com",
 		Password: "secret123",
 	})
@@ -232,3 +388,319 @@ func TestLogin_JwtSignError(t *testing.T) {
 
 // Ensure mock is used (silence unused import warnings if needed)
 var _ mock.TestingT = (*testing.T)(nil)
+
+func TestLogin_VerifiedEmailInToken(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+	auth := &entities.Auth{
+		ID:              "auth-1",
+		RefID:           100,
+		Email:           "user@example.com",
+		Password:        "hashed-password",
+		Role:            "USER",
+		EmailVerifiedAt: &verifiedAt,
+	}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", EmailVerified: true, SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestLogin_AccountLocked(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	auth := &entities.Auth{
+		ID:                  "auth-1",
+		RefID:               100,
+		Email:               "user@example.com",
+		Password:            "hashed-password",
+		Role:                "USER",
+		FailedLoginAttempts: 5,
+		LockedUntil:         &lockedUntil,
+	}
+
+	// The password is never checked while the account is locked
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	assert.Nil(t, result)
+	var lockedErr *domainerrors.AccountLockedError
+	require.True(t, errors.As(err, &lockedErr))
+	assert.InDelta(t, 600, lockedErr.RetryAfterSeconds(), 1)
+}
+
+func TestLogin_BackoffBeforeLock(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "hashed-password", Role: "USER", FailedLoginAttempts: 2}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("wrong-password", "hashed-password").Return(false, nil)
+	authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(3, nil)
+	authRepo.EXPECT().LockUntil(ctx, int64(100), mock.AnythingOfType("time.Time")).Return(nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "wrong-password",
+	})
+
+	// Short backoff delays still report invalid credentials
+	assert.Nil(t, result)
+	var credErr *domainerrors.InvalidCredentialsError
+	assert.True(t, errors.As(err, &credErr))
+}
+
+func TestLogin_LocksAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "hashed-password", Role: "USER", FailedLoginAttempts: 4}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("wrong-password", "hashed-password").Return(false, nil)
+	authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(5, nil)
+	authRepo.EXPECT().LockUntil(ctx, int64(100), mock.MatchedBy(func(until time.Time) bool {
+		return until.After(time.Now().Add(14 * time.Minute))
+	})).Return(nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "wrong-password",
+	})
+
+	assert.Nil(t, result)
+	var lockedErr *domainerrors.AccountLockedError
+	require.True(t, errors.As(err, &lockedErr))
+	assert.Equal(t, 15*time.Minute, lockedErr.RetryAfter)
+}
+
+func TestLogin_SuccessResetsFailedAttempts(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	// An expired lock no longer blocks the login
+	lockedUntil := time.Now().Add(-time.Minute)
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "hashed-password", Role: "USER", FailedLoginAttempts: 3, LockedUntil: &lockedUntil}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
+	authRepo.EXPECT().ResetFailedLogins(ctx, int64(100)).Return(nil)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestLogin_TwoFactorEnabledReturnsChallenge(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	secret := "SECRET"
+	enabledAt := time.Now().Add(-time.Hour)
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "hashed-password", Role: "USER", TotpSecret: &secret, TotpEnabledAt: &enabledAt}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	tokenSvc.EXPECT().Generate().Return("mfa-token", nil)
+	tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 && data.Purpose == entities.OneTimeTokenMfaChallenge && data.TokenHash == "mfa-hash"
+	})).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	// No token pair is signed until the second step
+	require.NoError(t, err)
+	assert.Nil(t, result.AuthResponse)
+	assert.True(t, result.MfaRequired)
+	assert.Equal(t, "mfa-token", result.MfaToken)
+	require.NotNil(t, result.MfaTokenExpiresAt)
+	assert.Nil(t, result.TotpEnrollment)
+}
+
+func TestLogin_RequiredTwoFactorStartsEnrollment(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "admin@example.com", Password: "hashed-password", Role: "ADMIN"}
+
+	authRepo.EXPECT().FindByEmail(ctx, "admin@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "hashed-password").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed-password").Return(false)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	totpSvc.EXPECT().GenerateSecret().Return("NEWSECRET", nil)
+	authRepo.EXPECT().StartTotpEnrollment(ctx, int64(100), "NEWSECRET").Return(nil)
+	totpSvc.EXPECT().ProvisioningURI("NEWSECRET", "admin@example.com").Return("otpauth://totp/x")
+	tokenSvc.EXPECT().Generate().Return("mfa-token", nil)
+	tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	oneTimeRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+
+	policy := entities.TwoFactorPolicy{RequiredRoles: []string{"ADMIN"}}
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), policy)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{
+		Email:    "admin@example.com",
+		Password: "secret123",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.MfaRequired)
+	require.NotNil(t, result.TotpEnrollment)
+	assert.Equal(t, "NEWSECRET", result.TotpEnrollment.Secret)
+	assert.Equal(t, "otpauth://totp/x", result.TotpEnrollment.OtpauthURI)
+}
+
+func TestLogin_UpgradesOutdatedPasswordHash(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "weak-hash", Role: "USER"}
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "weak-hash").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("weak-hash").Return(true)
+	passwordSvc.EXPECT().Hash("secret123").Return("strong-hash", nil)
+	authRepo.EXPECT().ReplacePasswordHash(ctx, int64(100), "weak-hash", "strong-hash").Return(true, nil)
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{Email: "user@example.com", Password: "secret123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestLogin_PasswordHashUpgradeErrorDoesNotFailLogin(t *testing.T) {
+	ctx := context.Background()
+	authRepo := mocks.NewMockAuthRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Password: "weak-hash", Role: "USER"}
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}
+
+	authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(auth, nil)
+	passwordSvc.EXPECT().Verify("secret123", "weak-hash").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("weak-hash").Return(true)
+	passwordSvc.EXPECT().Hash("secret123").Return("strong-hash", nil)
+	authRepo.EXPECT().ReplacePasswordHash(ctx, int64(100), "weak-hash", "strong-hash").Return(false, errors.New("connection refused"))
+	userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
+
+	uc := NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, mocks.NewMockOneTimeTokenRepository(t),
+		passwordSvc, jwtSvc, tokenSvc, mocks.NewMockTotpService(t), entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	result, err := uc.Execute(ctx, testSessionClient, dtos.LoginInput{Email: "user@example.com", Password: "secret123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
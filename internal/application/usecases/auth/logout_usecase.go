@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type LogoutUseCase struct {
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	sessionRepository      repositories.SessionRepository
+	revokedTokenRepository repositories.RevokedTokenRepository
+	tokenService           services.TokenService
+}
+
+func NewLogoutUseCase(
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	revokedTokenRepository repositories.RevokedTokenRepository,
+	tokenService services.TokenService,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		sessionRepository:      sessionRepository,
+		revokedTokenRepository: revokedTokenRepository,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute revokes the caller's access token until it expires and, when a
+// refresh token is supplied, the session and refresh token family it belongs to.
+func (uc *LogoutUseCase) Execute(ctx context.Context, userID, tokenID string, expiresAt time.Time, input dtos.LogoutInput) error {
+	if tokenID != "" {
+		if err := uc.revokedTokenRepository.Revoke(ctx, tokenID, expiresAt); err != nil {
+			return err
+		}
+	}
+
+	if input.RefreshToken == "" {
+		return nil
+	}
+
+	existing, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenService.Hash(input.RefreshToken))
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+
+	// Never let one user revoke another user's refresh tokens
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.AuthRefID != existing.AuthRefID {
+		return nil
+	}
+
+	if err := uc.refreshTokenRepository.RevokeFamily(ctx, existing.FamilyID); err != nil {
+		return err
+	}
+	_, err = uc.sessionRepository.Revoke(ctx, existing.FamilyID)
+	return err
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type logoutDeps struct {
+	userRepo    *mocks.MockUserRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	sessionRepo *mocks.MockSessionRepository
+	revokedRepo *mocks.MockRevokedTokenRepository
+	tokenSvc    *mocks.MockTokenService
+	uc          *LogoutUseCase
+}
+
+func setupLogout(t *testing.T) logoutDeps {
+	d := logoutDeps{
+		userRepo:    mocks.NewMockUserRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		revokedRepo: mocks.NewMockRevokedTokenRepository(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewLogoutUseCase(d.userRepo, d.refreshRepo, d.sessionRepo, d.revokedRepo, d.tokenSvc)
+	return d
+}
+
+func TestLogout_RevokesAccessTokenOnly(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(ctx, "jti-1", expiresAt).Return(nil)
+
+	err := d.uc.Execute(ctx, "user-1", "jti-1", expiresAt, dtos.LogoutInput{})
+
+	assert.NoError(t, err)
+}
+
+func TestLogout_RevokesRefreshTokenFamily(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(ctx, "jti-1", expiresAt).Return(nil)
+	d.tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "refresh-hash").Return(&entities.RefreshToken{ID: "rt-1", AuthRefID: 100, FamilyID: "family-1"}, nil)
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+	d.sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(true, nil)
+
+	err := d.uc.Execute(ctx, "user-1", "jti-1", expiresAt, dtos.LogoutInput{RefreshToken: "refresh-token"})
+
+	assert.NoError(t, err)
+}
+
+func TestLogout_IgnoresRefreshTokenOfAnotherUser(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(ctx, "jti-1", expiresAt).Return(nil)
+	d.tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "refresh-hash").Return(&entities.RefreshToken{ID: "rt-1", AuthRefID: 200, FamilyID: "family-1"}, nil)
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+
+	err := d.uc.Execute(ctx, "user-1", "jti-1", expiresAt, dtos.LogoutInput{RefreshToken: "refresh-token"})
+
+	assert.NoError(t, err)
+}
+
+func TestLogout_UnknownRefreshToken(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(ctx, "jti-1", expiresAt).Return(nil)
+	d.tokenSvc.EXPECT().Hash("unknown").Return("unknown-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "unknown-hash").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, "user-1", "jti-1", expiresAt, dtos.LogoutInput{RefreshToken: "unknown"})
+
+	assert.NoError(t, err)
+}
+
+func TestLogout_LegacyTokenWithoutID(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+
+	err := d.uc.Execute(ctx, "user-1", "", time.Time{}, dtos.LogoutInput{})
+
+	assert.NoError(t, err)
+}
+
+func TestLogout_RevokeError(t *testing.T) {
+	d := setupLogout(t)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(ctx, "jti-1", expiresAt).Return(errors.New("store down"))
+
+	err := d.uc.Execute(ctx, "user-1", "jti-1", expiresAt, dtos.LogoutInput{RefreshToken: "refresh-token"})
+
+	assert.EqualError(t, err, "store down")
+}
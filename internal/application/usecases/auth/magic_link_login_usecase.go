@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type MagicLinkLoginUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	totpService            services.TotpService
+	twoFactorPolicy        entities.TwoFactorPolicy
+	issuer                 *tokenIssuer
+}
+
+func NewMagicLinkLoginUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	jwtService services.JwtService,
+	tokenService services.TokenService,
+	totpService services.TotpService,
+	twoFactorPolicy entities.TwoFactorPolicy,
+) *MagicLinkLoginUseCase {
+	return &MagicLinkLoginUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		totpService:            totpService,
+		twoFactorPolicy:        twoFactorPolicy,
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
+	}
+}
+
+// Execute redeems a sign-in link in place of the password. The link only works for the address
+// it was sent to, and every other outstanding link of the account is invalidated. Following it
+// proves the address, so an unverified email is marked verified. Accounts with two-factor get an
+// MFA challenge exactly as with a password login.
+func (uc *MagicLinkLoginUseCase) Execute(ctx context.Context, client entities.SessionClient, input dtos.MagicLinkLoginInput) (*dtos.LoginResponse, error) {
+	token, err := uc.oneTimeTokenRepository.FindByTokenHash(ctx, entities.OneTimeTokenMagicLink, uc.issuer.tokenService.Hash(input.Token))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.IsUsed() {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	now := time.Now()
+	if token.IsExpired(now) {
+		return nil, domainerrors.NewTokenExpiredError()
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, token.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil || auth.AnonymizedAt != nil || !strings.EqualFold(auth.Email, input.Email) {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if err := authorization.EnsureAccountActive(auth.AccountStatus, now); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+
+	consumed, err := uc.oneTimeTokenRepository.Consume(ctx, token.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if err := uc.oneTimeTokenRepository.InvalidateAll(ctx, auth.RefID, entities.OneTimeTokenMagicLink); err != nil {
+		return nil, err
+	}
+
+	if !auth.IsEmailVerified() {
+		if err := uc.authRepository.MarkEmailVerified(ctx, auth.RefID); err != nil {
+			return nil, err
+		}
+		auth.EmailVerifiedAt = &now
+	}
+
+	if auth.IsTwoFactorEnabled() || uc.twoFactorPolicy.Requires(auth.Role) {
+		return startMfaChallenge(ctx, uc.authRepository, uc.oneTimeTokenRepository, uc.totpService, uc.issuer.tokenService, auth)
+	}
+
+	result, err := uc.issuer.issue(ctx, user.ID, auth, client)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.LoginResponse{AuthResponse: result}, nil
+}
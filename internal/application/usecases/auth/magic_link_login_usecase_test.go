@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type magicLinkLoginDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	userRepo    *mocks.MockUserRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	sessionRepo *mocks.MockSessionRepository
+	oneTimeRepo *mocks.MockOneTimeTokenRepository
+	jwtSvc      *mocks.MockJwtService
+	tokenSvc    *mocks.MockTokenService
+	totpSvc     *mocks.MockTotpService
+	uc          *MagicLinkLoginUseCase
+}
+
+func setupMagicLinkLogin(t *testing.T) magicLinkLoginDeps {
+	d := magicLinkLoginDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		userRepo:    mocks.NewMockUserRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		oneTimeRepo: mocks.NewMockOneTimeTokenRepository(t),
+		jwtSvc:      mocks.NewMockJwtService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+		totpSvc:     mocks.NewMockTotpService(t),
+	}
+	d.uc = NewMagicLinkLoginUseCase(d.authRepo, d.userRepo, d.refreshRepo, d.sessionRepo, d.oneTimeRepo, d.jwtSvc, d.tokenSvc, d.totpSvc, entities.TwoFactorPolicy{})
+	return d
+}
+
+// expectMagicLink sets up a valid, unused sign-in link for account 100
+func (d magicLinkLoginDeps) expectMagicLink(ctx context.Context) {
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMagicLink, "magic-hash").
+		Return(&entities.OneTimeToken{ID: "ott-1", AuthRefID: 100, ExpiresAt: time.Now().Add(10 * time.Minute)}, nil)
+}
+
+func TestMagicLinkLogin_IssuesTokens(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+
+	d.expectMagicLink(ctx)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com", Role: "USER", EmailVerifiedAt: &verifiedAt}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenMagicLink).Return(nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", EmailVerified: true, SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, d.jwtSvc, d.tokenSvc, d.refreshRepo, d.sessionRepo, 100)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "User@Example.com", Token: "magic-token"})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.AuthResponse)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.Equal(t, "refresh-token", result.RefreshToken)
+	assert.False(t, result.MfaRequired)
+}
+
+func TestMagicLinkLogin_VerifiesEmail(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+
+	d.expectMagicLink(ctx)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com", Role: "USER"}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenMagicLink).Return(nil)
+	d.authRepo.EXPECT().MarkEmailVerified(ctx, int64(100)).Return(nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", EmailVerified: true, SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, d.jwtSvc, d.tokenSvc, d.refreshRepo, d.sessionRepo, 100)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "magic-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestMagicLinkLogin_TwoFactorEnabledReturnsChallenge(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+	secret := "SECRET"
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+	enabledAt := time.Now().Add(-time.Hour)
+
+	d.expectMagicLink(ctx)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com", Role: "USER", EmailVerifiedAt: &verifiedAt, TotpSecret: &secret, TotpEnabledAt: &enabledAt}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenMagicLink).Return(nil)
+	d.tokenSvc.EXPECT().Generate().Return("mfa-token", nil)
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 && data.Purpose == entities.OneTimeTokenMfaChallenge && data.TokenHash == "mfa-hash"
+	})).Return(&entities.OneTimeToken{ID: "ott-2"}, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "magic-token"})
+
+	// The link only replaces the password, the second factor is still required
+	require.NoError(t, err)
+	assert.Nil(t, result.AuthResponse)
+	assert.True(t, result.MfaRequired)
+	assert.Equal(t, "mfa-token", result.MfaToken)
+}
+
+func TestMagicLinkLogin_EmailMismatch(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+
+	d.expectMagicLink(ctx)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com", Role: "USER"}, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "attacker@example.com", Token: "magic-token"})
+
+	assert.Nil(t, result)
+	var domainErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &domainErr))
+}
+
+func TestMagicLinkLogin_UnknownToken(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("bad-token").Return("bad-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMagicLink, "bad-hash").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "bad-token"})
+
+	assert.Nil(t, result)
+	var domainErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &domainErr))
+}
+
+func TestMagicLinkLogin_UsedToken(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+	usedAt := time.Now().Add(-time.Minute)
+
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMagicLink, "magic-hash").
+		Return(&entities.OneTimeToken{ID: "ott-1", AuthRefID: 100, ExpiresAt: time.Now().Add(10 * time.Minute), UsedAt: &usedAt}, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "magic-token"})
+
+	assert.Nil(t, result)
+	var domainErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &domainErr))
+}
+
+func TestMagicLinkLogin_ExpiredToken(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMagicLink, "magic-hash").
+		Return(&entities.OneTimeToken{ID: "ott-1", AuthRefID: 100, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "magic-token"})
+
+	assert.Nil(t, result)
+	var domainErr *domainerrors.TokenExpiredError
+	assert.True(t, errors.As(err, &domainErr))
+}
+
+func TestMagicLinkLogin_ConcurrentRedeem(t *testing.T) {
+	d := setupMagicLinkLogin(t)
+	ctx := context.Background()
+
+	d.expectMagicLink(ctx)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com", Role: "USER"}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(false, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.MagicLinkLoginInput{Email: "user@example.com", Token: "magic-token"})
+
+	assert.Nil(t, result)
+	var domainErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &domainErr))
+}
@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type OidcCallbackUseCase struct {
+	oidcService                services.OidcService
+	oidcLoginStateRepository   repositories.OidcLoginStateRepository
+	externalIdentityRepository repositories.ExternalIdentityRepository
+	authRepository             repositories.AuthRepository
+	userRepository             repositories.UserRepository
+	oneTimeTokenRepository     repositories.OneTimeTokenRepository
+	passwordService            services.PasswordService
+	totpService                services.TotpService
+	twoFactorPolicy            entities.TwoFactorPolicy
+	issuer                     *tokenIssuer
+}
+
+func NewOidcCallbackUseCase(
+	oidcService services.OidcService,
+	oidcLoginStateRepository repositories.OidcLoginStateRepository,
+	externalIdentityRepository repositories.ExternalIdentityRepository,
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	passwordService services.PasswordService,
+	jwtService services.JwtService,
+	tokenService services.TokenService,
+	totpService services.TotpService,
+	twoFactorPolicy entities.TwoFactorPolicy,
+) *OidcCallbackUseCase {
+	return &OidcCallbackUseCase{
+		oidcService:                oidcService,
+		oidcLoginStateRepository:   oidcLoginStateRepository,
+		externalIdentityRepository: externalIdentityRepository,
+		authRepository:             authRepository,
+		userRepository:             userRepository,
+		oneTimeTokenRepository:     oneTimeTokenRepository,
+		passwordService:            passwordService,
+		totpService:                totpService,
+		twoFactorPolicy:            twoFactorPolicy,
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
+	}
+}
+
+// Execute completes a login started by StartOidcLoginUseCase. The provider identity is
+// linked to the account with the same verified email, or a new account is created.
+// Two-factor still applies, exactly as for a password login.
+func (uc *OidcCallbackUseCase) Execute(ctx context.Context, provider string, client entities.SessionClient, input dtos.OidcCallbackInput) (*dtos.LoginResponse, error) {
+	state, err := uc.oidcLoginStateRepository.Consume(ctx, uc.issuer.tokenService.Hash(input.State))
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.Provider != provider {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if state.IsExpired(time.Now()) {
+		return nil, domainerrors.NewTokenExpiredError()
+	}
+
+	claims, err := uc.oidcService.Exchange(ctx, provider, input.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return nil, domainerrors.NewOidcLoginFailedError()
+	}
+
+	auth, err := uc.resolveAccount(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if auth.AnonymizedAt != nil {
+		return nil, domainerrors.NewOidcLoginFailedError()
+	}
+	if err := authorization.EnsureAccountActive(auth.AccountStatus, time.Now()); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewOidcLoginFailedError()
+	}
+
+	if auth.IsTwoFactorEnabled() || uc.twoFactorPolicy.Requires(auth.Role) {
+		return startMfaChallenge(ctx, uc.authRepository, uc.oneTimeTokenRepository, uc.totpService, uc.issuer.tokenService, auth)
+	}
+
+	result, err := uc.issuer.issue(ctx, user.ID, auth, client)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.LoginResponse{AuthResponse: result}, nil
+}
+
+// resolveAccount returns the account linked to the provider identity, linking or creating one on first login
+func (uc *OidcCallbackUseCase) resolveAccount(ctx context.Context, claims *services.OidcClaims) (*entities.Auth, error) {
+	identity, err := uc.externalIdentityRepository.FindBySubject(ctx, claims.Issuer, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		auth, err := uc.authRepository.FindByRefID(ctx, identity.AuthRefID)
+		if err != nil {
+			return nil, err
+		}
+		if auth == nil {
+			return nil, domainerrors.NewOidcLoginFailedError()
+		}
+		return auth, nil
+	}
+
+	// Without a verified address anyone could claim someone else's account
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, domainerrors.NewOidcEmailNotVerifiedError()
+	}
+
+	auth, err := uc.authRepository.FindByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil && !auth.IsEmailVerified() {
+		// Someone may have registered the address before its owner; linking would
+		// hand the owner an account whose password the registrant still knows
+		return nil, domainerrors.NewOidcLoginFailedError()
+	}
+	if auth == nil {
+		if auth, err = uc.createAccount(ctx, claims); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = uc.externalIdentityRepository.Create(ctx, entities.CreateExternalIdentityData{
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		AuthRefID: auth.RefID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// createAccount registers the provider user. The password is random and never shown,
+// so the account can only be used through the provider until the user resets it.
+func (uc *OidcCallbackUseCase) createAccount(ctx context.Context, claims *services.OidcClaims) (*entities.Auth, error) {
+	secret, err := uc.issuer.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := uc.passwordService.Hash(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, _, err := uc.authRepository.CreateWithUser(ctx,
+		entities.CreateAuthData{Email: claims.Email, Password: hashedPassword},
+		entities.CreateUserData{FirstName: optionalString(claims.GivenName), LastName: optionalString(claims.FamilyName)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provider already confirmed the address
+	if err := uc.authRepository.MarkEmailVerified(ctx, auth.RefID); err != nil {
+		return nil, err
+	}
+	verifiedAt := time.Now()
+	auth.EmailVerifiedAt = &verifiedAt
+	return auth, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
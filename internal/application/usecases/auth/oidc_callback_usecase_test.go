@@ -0,0 +1,282 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://idp.example.com"
+
+type oidcCallbackDeps struct {
+	oidcSvc      *mocks.MockOidcService
+	stateRepo    *mocks.MockOidcLoginStateRepository
+	identityRepo *mocks.MockExternalIdentityRepository
+	authRepo     *mocks.MockAuthRepository
+	userRepo     *mocks.MockUserRepository
+	refreshRepo  *mocks.MockRefreshTokenRepository
+	sessionRepo  *mocks.MockSessionRepository
+	oneTimeRepo  *mocks.MockOneTimeTokenRepository
+	passwordSvc  *mocks.MockPasswordService
+	jwtSvc       *mocks.MockJwtService
+	tokenSvc     *mocks.MockTokenService
+	totpSvc      *mocks.MockTotpService
+	uc           *OidcCallbackUseCase
+}
+
+func setupOidcCallback(t *testing.T, policy entities.TwoFactorPolicy) oidcCallbackDeps {
+	d := oidcCallbackDeps{
+		oidcSvc:      mocks.NewMockOidcService(t),
+		stateRepo:    mocks.NewMockOidcLoginStateRepository(t),
+		identityRepo: mocks.NewMockExternalIdentityRepository(t),
+		authRepo:     mocks.NewMockAuthRepository(t),
+		userRepo:     mocks.NewMockUserRepository(t),
+		refreshRepo:  mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo:  mocks.NewMockSessionRepository(t),
+		oneTimeRepo:  mocks.NewMockOneTimeTokenRepository(t),
+		passwordSvc:  mocks.NewMockPasswordService(t),
+		jwtSvc:       mocks.NewMockJwtService(t),
+		tokenSvc:     mocks.NewMockTokenService(t),
+		totpSvc:      mocks.NewMockTotpService(t),
+	}
+	d.uc = NewOidcCallbackUseCase(d.oidcSvc, d.stateRepo, d.identityRepo, d.authRepo, d.userRepo,
+		d.refreshRepo, d.sessionRepo, d.oneTimeRepo, d.passwordSvc, d.jwtSvc, d.tokenSvc, d.totpSvc, policy)
+	return d
+}
+
+func oidcCallbackInput() dtos.OidcCallbackInput {
+	return dtos.OidcCallbackInput{Code: "auth-code", State: "state"}
+}
+
+func pendingOidcLogin() *entities.OidcLoginState {
+	return &entities.OidcLoginState{
+		ID:           "state-1",
+		StateHash:    "state-hash",
+		Provider:     "google",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    time.Now().Add(5 * time.Minute),
+	}
+}
+
+func verifiedOidcClaims() *services.OidcClaims {
+	return &services.OidcClaims{
+		Issuer:        testIssuer,
+		Subject:       "subject-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		GivenName:     "Jane",
+		FamilyName:    "Doe",
+	}
+}
+
+// expectExchange sets up a valid state redemption and code exchange returning claims
+func (d oidcCallbackDeps) expectExchange(ctx context.Context, claims *services.OidcClaims) {
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(ctx, "state-hash").Return(pendingOidcLogin(), nil)
+	d.oidcSvc.EXPECT().Exchange(ctx, "google", "auth-code", "verifier", "nonce").Return(claims, nil)
+}
+
+func (d oidcCallbackDeps) expectSignedIn(ctx context.Context, auth *entities.Auth, emailVerified bool) {
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, auth.RefID).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: auth.RefID}}, nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: auth.Role, EmailVerified: emailVerified, SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, d.jwtSvc, d.tokenSvc, d.refreshRepo, d.sessionRepo, auth.RefID)
+}
+
+func TestOidcCallback_LinkedIdentity(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	auth := &entities.Auth{RefID: 100, Email: "jane@example.com", Role: "USER"}
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(&entities.ExternalIdentity{AuthRefID: 100}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.expectSignedIn(ctx, auth, false)
+
+	result, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.False(t, result.MfaRequired)
+}
+
+func TestOidcCallback_LinksExistingAccountByEmail(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	verifiedAt := time.Now()
+	auth := &entities.Auth{RefID: 100, Email: "jane@example.com", Role: "USER", EmailVerifiedAt: &verifiedAt}
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(nil, nil)
+	d.authRepo.EXPECT().FindByEmail(ctx, "jane@example.com").Return(auth, nil)
+	d.identityRepo.EXPECT().Create(ctx, entities.CreateExternalIdentityData{Issuer: testIssuer, Subject: "subject-1", AuthRefID: 100}).
+		Return(&entities.ExternalIdentity{ID: "ext-1"}, nil)
+	d.expectSignedIn(ctx, auth, true)
+
+	result, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestOidcCallback_CreatesAccount(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	auth := &entities.Auth{RefID: 100, Email: "jane@example.com", Role: "USER"}
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(nil, nil)
+	d.authRepo.EXPECT().FindByEmail(ctx, "jane@example.com").Return(nil, nil)
+	d.tokenSvc.EXPECT().Generate().Return("random-secret", nil).Once()
+	d.passwordSvc.EXPECT().Hash("random-secret").Return("random-hash", nil)
+	d.authRepo.EXPECT().CreateWithUser(ctx,
+		entities.CreateAuthData{Email: "jane@example.com", Password: "random-hash"},
+		mock.MatchedBy(func(data entities.CreateUserData) bool {
+			return *data.FirstName == "Jane" && *data.LastName == "Doe" && data.Phone == nil
+		}),
+	).Return(auth, &entities.PublicUser{User: entities.User{ID: "user-1"}}, nil)
+	d.authRepo.EXPECT().MarkEmailVerified(ctx, int64(100)).Return(nil)
+	d.identityRepo.EXPECT().Create(ctx, entities.CreateExternalIdentityData{Issuer: testIssuer, Subject: "subject-1", AuthRefID: 100}).
+		Return(&entities.ExternalIdentity{ID: "ext-1"}, nil)
+	d.expectSignedIn(ctx, auth, true)
+
+	result, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.True(t, auth.IsEmailVerified())
+}
+
+func TestOidcCallback_UnverifiedEmail(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	claims := verifiedOidcClaims()
+	claims.EmailVerified = false
+
+	d.expectExchange(ctx, claims)
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(nil, nil)
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var notVerified *domainerrors.OidcEmailNotVerifiedError
+	assert.ErrorAs(t, err, &notVerified)
+}
+
+func TestOidcCallback_RefusesUnverifiedLocalAccount(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(nil, nil)
+	d.authRepo.EXPECT().FindByEmail(ctx, "jane@example.com").Return(&entities.Auth{RefID: 100, Email: "jane@example.com"}, nil)
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var failed *domainerrors.OidcLoginFailedError
+	assert.ErrorAs(t, err, &failed)
+}
+
+func TestOidcCallback_AnonymizedAccount(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	anonymizedAt := time.Now()
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(&entities.ExternalIdentity{AuthRefID: 100}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, AnonymizedAt: &anonymizedAt}, nil)
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var failed *domainerrors.OidcLoginFailedError
+	assert.ErrorAs(t, err, &failed)
+}
+
+func TestOidcCallback_TwoFactorChallenge(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	enabledAt := time.Now()
+	secret := "SECRET"
+	auth := &entities.Auth{RefID: 100, Role: "USER", TotpSecret: &secret, TotpEnabledAt: &enabledAt}
+
+	d.expectExchange(ctx, verifiedOidcClaims())
+	d.identityRepo.EXPECT().FindBySubject(ctx, testIssuer, "subject-1").Return(&entities.ExternalIdentity{AuthRefID: 100}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1"}}, nil)
+	d.tokenSvc.EXPECT().Generate().Return("mfa-token", nil).Once()
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 && data.Purpose == entities.OneTimeTokenMfaChallenge
+	})).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+
+	result, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	require.NoError(t, err)
+	assert.True(t, result.MfaRequired)
+	assert.Equal(t, "mfa-token", result.MfaToken)
+	assert.Nil(t, result.AuthResponse)
+}
+
+func TestOidcCallback_UnknownState(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(ctx, "state-hash").Return(nil, nil)
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var invalid *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalid)
+}
+
+func TestOidcCallback_StateFromOtherProvider(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(ctx, "state-hash").Return(pendingOidcLogin(), nil)
+
+	_, err := d.uc.Execute(ctx, "github", testSessionClient, oidcCallbackInput())
+
+	var invalid *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalid)
+}
+
+func TestOidcCallback_ExpiredState(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+	expired := pendingOidcLogin()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(ctx, "state-hash").Return(expired, nil)
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var expiredErr *domainerrors.TokenExpiredError
+	assert.ErrorAs(t, err, &expiredErr)
+}
+
+func TestOidcCallback_ExchangeFails(t *testing.T) {
+	d := setupOidcCallback(t, entities.TwoFactorPolicy{})
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(ctx, "state-hash").Return(pendingOidcLogin(), nil)
+	d.oidcSvc.EXPECT().Exchange(ctx, "google", "auth-code", "verifier", "nonce").Return(nil, errors.New("invalid ID token"))
+
+	_, err := d.uc.Execute(ctx, "google", testSessionClient, oidcCallbackInput())
+
+	var failed *domainerrors.OidcLoginFailedError
+	assert.ErrorAs(t, err, &failed)
+}
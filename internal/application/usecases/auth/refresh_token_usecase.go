@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type RefreshTokenUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+	twoFactorPolicy        entities.TwoFactorPolicy
+	issuer                 *tokenIssuer
+}
+
+func NewRefreshTokenUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	jwtService services.JwtService,
+	tokenService services.TokenService,
+	twoFactorPolicy entities.TwoFactorPolicy,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+		twoFactorPolicy:        twoFactorPolicy,
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
+	}
+}
+
+// Execute rotates a refresh token: the presented token is revoked and a new
+// access/refresh pair in the same family is returned. Presenting a token that
+// was already rotated is treated as theft and revokes the whole family.
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input dtos.RefreshTokenInput) (*dtos.AuthResponse, error) {
+	existing, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenService.Hash(input.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if existing.IsRevoked() {
+		return nil, uc.revokeFamily(ctx, existing.FamilyID)
+	}
+	if existing.IsExpired(time.Now()) {
+		return nil, domainerrors.NewTokenExpiredError()
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, existing.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if err := authorization.EnsureAccountActive(auth.AccountStatus, time.Now()); err != nil {
+		return nil, err
+	}
+	// Sessions started before the role required two-factor must go through a login that sets it up
+	if uc.twoFactorPolicy.Requires(auth.Role) && !auth.IsTwoFactorEnabled() {
+		return nil, domainerrors.NewTwoFactorRequiredError()
+	}
+
+	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+
+	// The family ID is the session the token pair belongs to
+	token, expiresAt, err := uc.issuer.signAccessToken(user.ID, auth, existing.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, data, err := uc.issuer.newRefreshToken(auth.RefID, existing.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := uc.refreshTokenRepository.Rotate(ctx, existing.ID, data)
+	if err != nil {
+		return nil, err
+	}
+	if rotated == nil {
+		// Lost the race against another use of the same token
+		return nil, uc.revokeFamily(ctx, existing.FamilyID)
+	}
+	if err := uc.issuer.sessionRepository.Touch(ctx, existing.FamilyID, time.Now(), rotated.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &dtos.AuthResponse{
+		UserID:                user.ID,
+		Token:                 token,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: rotated.ExpiresAt,
+	}, nil
+}
+
+func (uc *RefreshTokenUseCase) revokeFamily(ctx context.Context, familyID string) error {
+	if err := uc.refreshTokenRepository.RevokeFamily(ctx, familyID); err != nil {
+		return err
+	}
+	if _, err := uc.issuer.sessionRepository.Revoke(ctx, familyID); err != nil {
+		return err
+	}
+	return domainerrors.NewRefreshTokenReusedError()
+}
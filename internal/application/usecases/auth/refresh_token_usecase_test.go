@@ -0,0 +1,241 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testSessionClient is the device the use cases under test are called from
+var testSessionClient = entities.SessionClient{UserAgent: "test-agent", IPAddress: "192.0.2.1"}
+
+// expectTokensIssued sets up the expectations for a successful access/refresh token issuance
+// once the JWT has been signed. The session it starts is "session-1".
+func expectTokensIssued(ctx context.Context, jwtSvc *mocks.MockJwtService, tokenSvc *mocks.MockTokenService, refreshRepo *mocks.MockRefreshTokenRepository, sessionRepo *mocks.MockSessionRepository, authRefID int64) {
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	sessionRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateSessionData) bool {
+		return data.AuthRefID == authRefID && data.Client == testSessionClient && data.ExpiresAt.After(time.Now())
+	})).Return(&entities.Session{ID: "session-1", AuthRefID: authRefID}, nil)
+	tokenSvc.EXPECT().Generate().Return("refresh-token", nil)
+	tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	refreshRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateRefreshTokenData) bool {
+		return data.AuthRefID == authRefID && data.FamilyID == "session-1" && data.TokenHash == "refresh-hash"
+	})).Return(&entities.RefreshToken{ID: "rt-1", AuthRefID: authRefID, FamilyID: "session-1", TokenHash: "refresh-hash"}, nil)
+}
+
+type refreshTokenDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	userRepo    *mocks.MockUserRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	sessionRepo *mocks.MockSessionRepository
+	jwtSvc      *mocks.MockJwtService
+	tokenSvc    *mocks.MockTokenService
+	uc          *RefreshTokenUseCase
+}
+
+func setupRefreshToken(t *testing.T) refreshTokenDeps {
+	d := refreshTokenDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		userRepo:    mocks.NewMockUserRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		jwtSvc:      mocks.NewMockJwtService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewRefreshTokenUseCase(d.authRepo, d.userRepo, d.refreshRepo, d.sessionRepo, d.jwtSvc, d.tokenSvc,
+		entities.TwoFactorPolicy{RequiredRoles: []string{"ADMIN"}})
+	return d
+}
+
+func activeRefreshToken() *entities.RefreshToken {
+	return &entities.RefreshToken{
+		ID:        "rt-1",
+		AuthRefID: 100,
+		FamilyID:  "family-1",
+		TokenHash: "old-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestRefreshToken_Success(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Role: "DRIVER"}
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}
+	newExpiry := time.Now().Add(30 * 24 * time.Hour)
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(activeRefreshToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "DRIVER", SessionID: "family-1"}).Return("jwt-token", nil)
+	d.jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	d.tokenSvc.EXPECT().Generate().Return("new-token", nil)
+	d.tokenSvc.EXPECT().Hash("new-token").Return("new-hash")
+	d.refreshRepo.EXPECT().Rotate(ctx, "rt-1", mock.MatchedBy(func(data entities.CreateRefreshTokenData) bool {
+		return data.FamilyID == "family-1" && data.TokenHash == "new-hash" && data.AuthRefID == 100
+	})).Return(&entities.RefreshToken{ID: "rt-2", FamilyID: "family-1", ExpiresAt: newExpiry}, nil)
+	d.sessionRepo.EXPECT().Touch(ctx, "family-1", mock.AnythingOfType("time.Time"), newExpiry).Return(nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", result.UserID)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.Equal(t, "new-token", result.RefreshToken)
+	assert.Equal(t, newExpiry, result.RefreshTokenExpiresAt)
+}
+
+func TestRefreshToken_TwoFactorRequired(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Role: "ADMIN"}
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(activeRefreshToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var requiredErr *domainerrors.TwoFactorRequiredError
+	assert.True(t, errors.As(err, &requiredErr))
+}
+
+func TestRefreshToken_UnknownToken(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	d.tokenSvc.EXPECT().Hash("bogus").Return("bogus-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "bogus-hash").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "bogus"})
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestRefreshToken_ReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	revokedAt := time.Now().Add(-time.Minute)
+	rotated := activeRefreshToken()
+	rotated.RevokedAt = &revokedAt
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(rotated, nil)
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+	d.sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(true, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var reusedErr *domainerrors.RefreshTokenReusedError
+	assert.True(t, errors.As(err, &reusedErr))
+}
+
+func TestRefreshToken_Expired(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	expired := activeRefreshToken()
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(expired, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var expiredErr *domainerrors.TokenExpiredError
+	assert.True(t, errors.As(err, &expiredErr))
+}
+
+func TestRefreshToken_ConcurrentRotationRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Role: "USER"}
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(activeRefreshToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(user, nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "family-1"}).Return("jwt-token", nil)
+	d.jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	d.tokenSvc.EXPECT().Generate().Return("new-token", nil)
+	d.tokenSvc.EXPECT().Hash("new-token").Return("new-hash")
+	d.refreshRepo.EXPECT().Rotate(ctx, "rt-1", mock.Anything).Return(nil, nil)
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+	d.sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(true, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var reusedErr *domainerrors.RefreshTokenReusedError
+	assert.True(t, errors.As(err, &reusedErr))
+}
+
+func TestRefreshToken_AuthMissing(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(activeRefreshToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestRefreshToken_BannedAccount(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	bannedAt := time.Now().Add(-time.Hour)
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Role: "USER", AccountStatus: entities.AccountStatus{BannedAt: &bannedAt}}
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(activeRefreshToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	var bannedErr *domainerrors.AccountBannedError
+	assert.True(t, errors.As(err, &bannedErr))
+}
+
+func TestRefreshToken_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	d := setupRefreshToken(t)
+
+	dbErr := errors.New("connection refused")
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(ctx, "old-hash").Return(nil, dbErr)
+
+	result, err := d.uc.Execute(ctx, dtos.RefreshTokenInput{RefreshToken: "old-token"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, dbErr, err)
+}
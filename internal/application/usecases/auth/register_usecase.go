@@ -11,27 +11,40 @@ import (
 )
 
 type RegisterUseCase struct {
-	authRepository  repositories.AuthRepository
-	passwordService services.PasswordService
-	emailService    services.EmailService
-	jwtService      services.JwtService
+	authRepository         repositories.AuthRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	passwordService        services.PasswordService
+	emailService           services.EmailService
+	tokenService           services.TokenService
+	issuer                 *tokenIssuer
 }
 
 func NewRegisterUseCase(
 	authRepository repositories.AuthRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
 	passwordService services.PasswordService,
 	emailService services.EmailService,
 	jwtService services.JwtService,
+	tokenService services.TokenService,
 ) *RegisterUseCase {
 	return &RegisterUseCase{
-		authRepository:  authRepository,
-		passwordService: passwordService,
-		emailService:    emailService,
-		jwtService:      jwtService,
+		authRepository:         authRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		passwordService:        passwordService,
+		emailService:           emailService,
+		tokenService:           tokenService,
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
 	}
 }
 
-func (uc *RegisterUseCase) Execute(ctx context.Context, input dtos.RegisterInput) (*dtos.AuthResponse, error) {
+func (uc *RegisterUseCase) Execute(ctx context.Context, client entities.SessionClient, input dtos.RegisterInput) (*dtos.AuthResponse, error) {
 	exists, err := uc.authRepository.ExistsByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, err
@@ -53,20 +66,13 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input dtos.RegisterInput
 		return nil, err
 	}
 
-	// Send welcome email (fire and forget)
-	name := "there"
-	if user.FirstName != nil {
-		name = *user.FirstName
+	// Send verification email (fire and forget, the user can ask for a new link).
+	// The welcome email follows once the address is confirmed.
+	token, err := createOneTimeToken(ctx, uc.oneTimeTokenRepository, uc.tokenService,
+		auth.RefID, entities.OneTimeTokenEmailVerification, emailVerificationTokenTTL)
+	if err == nil {
+		_ = uc.emailService.SendVerificationEmail(auth.Email, token)
 	}
-	_ = uc.emailService.SendWelcomeEmail(auth.Email, name)
 
-	token, err := uc.jwtService.Sign(services.JwtPayload{UserID: user.ID, Role: auth.Role})
-	if err != nil {
-		return nil, err
-	}
-
-	return &dtos.AuthResponse{
-		UserID: user.ID,
-		Token:  token,
-	}, nil
+	return uc.issuer.issue(ctx, user.ID, auth, client)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/domain/entities"
@@ -15,12 +16,27 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// expectVerificationEmail sets up the verification link sent right after account creation.
+// It must be registered before expectTokensIssued so the first Generate call returns the link token.
+func expectVerificationEmail(ctx context.Context, tokenSvc *mocks.MockTokenService, oneTimeRepo *mocks.MockOneTimeTokenRepository, emailSvc *mocks.MockEmailService, sendErr error) {
+	tokenSvc.EXPECT().Generate().Return("verify-token", nil).Once()
+	tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 && data.Purpose == entities.OneTimeTokenEmailVerification && data.TokenHash == "verify-hash"
+	})).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	emailSvc.EXPECT().SendVerificationEmail("new@example.com", "verify-token").Return(sendErr)
+}
+
 func TestRegister_Success(t *testing.T) {
 	ctx := context.Background()
 	authRepo := mocks.NewMockAuthRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	firstName := "John"
 	auth := &entities.Auth{
@@ -45,11 +61,12 @@ func TestRegister_Success(t *testing.T) {
 		entities.CreateAuthData{Email: "new@example.com", Password: "hashed-pw"},
 		entities.CreateUserData{FirstName: nil, LastName: nil, Phone: nil},
 	).Return(auth, user, nil)
-	emailSvc.EXPECT().SendWelcomeEmail("new@example.com", "John").Return(nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	expectVerificationEmail(ctx, tokenSvc, oneTimeRepo, emailSvc, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -66,11 +83,15 @@ func TestRegister_EmailAlreadyExists(t *testing.T) {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	authRepo.EXPECT().ExistsByEmail(ctx, "existing@example.com").Return(true, nil)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "existing@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -88,13 +109,17 @@ func TestRegister_PasswordHashError(t *testing.T) {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	hashErr := errors.New("hashing failed")
 	authRepo.EXPECT().ExistsByEmail(ctx, "new@example.com").Return(false, nil)
 	passwordSvc.EXPECT().Hash("password123").Return("", hashErr)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -111,6 +136,10 @@ func TestRegister_CreateWithUserError(t *testing.T) {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	createErr := errors.New("database constraint violation")
 	authRepo.EXPECT().ExistsByEmail(ctx, "new@example.com").Return(false, nil)
@@ -120,8 +149,8 @@ func TestRegister_CreateWithUserError(t *testing.T) {
 		entities.CreateUserData{FirstName: nil, LastName: nil, Phone: nil},
 	).Return(nil, nil, createErr)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -138,6 +167,10 @@ func TestRegister_EmailFailureDoesNotBlock(t *testing.T) {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	firstName := "Jane"
 	auth := &entities.Auth{
@@ -162,11 +195,12 @@ func TestRegister_EmailFailureDoesNotBlock(t *testing.T) {
 		entities.CreateAuthData{Email: "new@example.com", Password: "hashed-pw"},
 		entities.CreateUserData{FirstName: nil, LastName: nil, Phone: nil},
 	).Return(auth, user, nil)
-	emailSvc.EXPECT().SendWelcomeEmail("new@example.com", "Jane").Return(errors.New("SMTP failure"))
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	expectVerificationEmail(ctx, tokenSvc, oneTimeRepo, emailSvc, errors.New("SMTP failure"))
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -183,6 +217,10 @@ func TestRegister_JwtSignError(t *testing.T) {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	auth := &entities.Auth{
 		ID:    "auth-1",
@@ -207,11 +245,13 @@ func TestRegister_JwtSignError(t *testing.T) {
 		entities.CreateAuthData{Email: "new@example.com", Password: "hashed-pw"},
 		entities.CreateUserData{FirstName: nil, LastName: nil, Phone: nil},
 	).Return(auth, user, nil)
-	emailSvc.EXPECT().SendWelcomeEmail("new@example.com", "there").Return(nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("", jwtErr)
+	expectVerificationEmail(ctx, tokenSvc, oneTimeRepo, emailSvc, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("", jwtErr)
+	jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	sessionRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.Session{ID: "session-1", AuthRefID: 100}, nil)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
@@ -222,12 +262,16 @@ func TestRegister_JwtSignError(t *testing.T) {
 	assert.Equal(t, jwtErr, err)
 }
 
-func TestRegister_WelcomeEmailUsesDefaultName(t *testing.T) {
+func TestRegister_VerificationTokenFailureDoesNotBlock(t *testing.T) {
 	ctx := context.Background()
 	authRepo := mocks.NewMockAuthRepository(t)
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
 
 	auth := &entities.Auth{
 		ID:    "auth-1",
@@ -251,12 +295,13 @@ func TestRegister_WelcomeEmailUsesDefaultName(t *testing.T) {
 		entities.CreateAuthData{Email: "new@example.com", Password: "hashed-pw"},
 		entities.CreateUserData{FirstName: nil, LastName: nil, Phone: nil},
 	).Return(auth, user, nil)
-	// Expect "there" as the default name when FirstName is nil
-	emailSvc.EXPECT().SendWelcomeEmail("new@example.com", "there").Return(nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	// The user can ask for a new link, so registration still succeeds
+	tokenSvc.EXPECT().Generate().Return("", errors.New("entropy exhausted")).Once()
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, jwtSvc, tokenSvc, refreshRepo, sessionRepo, 100)
 
-	uc := NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	result, err := uc.Execute(ctx, dtos.RegisterInput{
+	uc := NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	result, err := uc.Execute(ctx, testSessionClient, dtos.RegisterInput{
 		Email:           "new@example.com",
 		Password:        "password123",
 		ConfirmPassword: "password123",
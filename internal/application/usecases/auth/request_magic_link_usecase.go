@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+const (
+	// magicLinkTokenTTL is how long an emailed sign-in link stays valid
+	magicLinkTokenTTL = 15 * time.Minute
+	// magicLinkLimit caps how many sign-in links an address receives per magicLinkWindow
+	magicLinkLimit  = 3
+	magicLinkWindow = time.Hour
+)
+
+type RequestMagicLinkUseCase struct {
+	authRepository         repositories.AuthRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	emailService           services.EmailService
+	tokenService           services.TokenService
+}
+
+func NewRequestMagicLinkUseCase(
+	authRepository repositories.AuthRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	emailService services.EmailService,
+	tokenService services.TokenService,
+) *RequestMagicLinkUseCase {
+	return &RequestMagicLinkUseCase{
+		authRepository:         authRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		emailService:           emailService,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute emails a sign-in link when the address belongs to an active account and has not
+// reached its hourly quota. It succeeds silently otherwise so callers cannot probe for accounts.
+func (uc *RequestMagicLinkUseCase) Execute(ctx context.Context, input dtos.MagicLinkInput) error {
+	auth, err := uc.authRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		return err
+	}
+	if auth == nil || auth.AnonymizedAt != nil {
+		return nil
+	}
+
+	sent, err := uc.oneTimeTokenRepository.CountCreatedSince(ctx, auth.RefID, entities.OneTimeTokenMagicLink, time.Now().Add(-magicLinkWindow))
+	if err != nil {
+		return err
+	}
+	if sent >= magicLinkLimit {
+		return nil
+	}
+
+	token, err := createOneTimeToken(ctx, uc.oneTimeTokenRepository, uc.tokenService,
+		auth.RefID, entities.OneTimeTokenMagicLink, magicLinkTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	// Send sign-in email (fire and forget, a failure must not reveal the account exists)
+	_ = uc.emailService.SendMagicLinkEmail(auth.Email, token)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type requestMagicLinkDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	oneTimeRepo *mocks.MockOneTimeTokenRepository
+	emailSvc    *mocks.MockEmailService
+	tokenSvc    *mocks.MockTokenService
+	uc          *RequestMagicLinkUseCase
+}
+
+func setupRequestMagicLink(t *testing.T) requestMagicLinkDeps {
+	d := requestMagicLinkDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		oneTimeRepo: mocks.NewMockOneTimeTokenRepository(t),
+		emailSvc:    mocks.NewMockEmailService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewRequestMagicLinkUseCase(d.authRepo, d.oneTimeRepo, d.emailSvc, d.tokenSvc)
+	return d
+}
+
+func TestRequestMagicLink_SendsLink(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().CountCreatedSince(ctx, int64(100), entities.OneTimeTokenMagicLink, mock.MatchedBy(func(since time.Time) bool {
+		return since.Before(time.Now().Add(-magicLinkWindow + time.Minute))
+	})).Return(2, nil)
+	d.tokenSvc.EXPECT().Generate().Return("magic-token", nil)
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.AuthRefID == 100 &&
+			data.Purpose == entities.OneTimeTokenMagicLink &&
+			data.TokenHash == "magic-hash" &&
+			data.ExpiresAt.Before(time.Now().Add(magicLinkTokenTTL+time.Second))
+	})).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	d.emailSvc.EXPECT().SendMagicLinkEmail("user@example.com", "magic-token").Return(nil)
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestRequestMagicLink_QuotaReachedIsSilent(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().CountCreatedSince(ctx, int64(100), entities.OneTimeTokenMagicLink, mock.Anything).Return(magicLinkLimit, nil)
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestRequestMagicLink_UnknownEmail(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "nobody@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestRequestMagicLink_AnonymizedAccount(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "gone@example.com").Return(&entities.Auth{RefID: 100, AnonymizedAt: &now}, nil)
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "gone@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestRequestMagicLink_EmailFailureIsSilent(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().CountCreatedSince(ctx, int64(100), entities.OneTimeTokenMagicLink, mock.Anything).Return(0, nil)
+	d.tokenSvc.EXPECT().Generate().Return("magic-token", nil)
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	d.emailSvc.EXPECT().SendMagicLinkEmail("user@example.com", "magic-token").Return(errors.New("smtp down"))
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestRequestMagicLink_CountError(t *testing.T) {
+	d := setupRequestMagicLink(t)
+	ctx := context.Background()
+
+	d.authRepo.EXPECT().FindByEmail(ctx, "user@example.com").Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().CountCreatedSince(ctx, int64(100), entities.OneTimeTokenMagicLink, mock.Anything).Return(0, errors.New("db error"))
+
+	err := d.uc.Execute(ctx, dtos.MagicLinkInput{Email: "user@example.com"})
+
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type ResendVerificationUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	emailService           services.EmailService
+	tokenService           services.TokenService
+}
+
+func NewResendVerificationUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	emailService services.EmailService,
+	tokenService services.TokenService,
+) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		emailService:           emailService,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute emails a fresh verification link to the authenticated user,
+// invalidating any link sent before.
+func (uc *ResendVerificationUseCase) Execute(ctx context.Context, userID string) error {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domainerrors.NewUserNotFoundError(userID)
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, user.AuthRefID)
+	if err != nil {
+		return err
+	}
+	if auth == nil {
+		return domainerrors.NewUserNotFoundError(userID)
+	}
+	if auth.IsEmailVerified() {
+		return domainerrors.NewEmailAlreadyVerifiedError()
+	}
+
+	if err := uc.oneTimeTokenRepository.InvalidateAll(ctx, auth.RefID, entities.OneTimeTokenEmailVerification); err != nil {
+		return err
+	}
+
+	token, err := createOneTimeToken(ctx, uc.oneTimeTokenRepository, uc.tokenService,
+		auth.RefID, entities.OneTimeTokenEmailVerification, emailVerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return uc.emailService.SendVerificationEmail(auth.Email, token)
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type resendVerificationDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	userRepo    *mocks.MockUserRepository
+	oneTimeRepo *mocks.MockOneTimeTokenRepository
+	emailSvc    *mocks.MockEmailService
+	tokenSvc    *mocks.MockTokenService
+	uc          *ResendVerificationUseCase
+}
+
+func setupResendVerification(t *testing.T) resendVerificationDeps {
+	d := resendVerificationDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		userRepo:    mocks.NewMockUserRepository(t),
+		oneTimeRepo: mocks.NewMockOneTimeTokenRepository(t),
+		emailSvc:    mocks.NewMockEmailService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewResendVerificationUseCase(d.authRepo, d.userRepo, d.oneTimeRepo, d.emailSvc, d.tokenSvc)
+	return d
+}
+
+func TestResendVerification_Success(t *testing.T) {
+	d := setupResendVerification(t)
+	ctx := context.Background()
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenEmailVerification).Return(nil)
+	d.tokenSvc.EXPECT().Generate().Return("verify-token", nil)
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOneTimeTokenData) bool {
+		return data.Purpose == entities.OneTimeTokenEmailVerification && data.TokenHash == "verify-hash"
+	})).Return(&entities.OneTimeToken{ID: "ott-2"}, nil)
+	d.emailSvc.EXPECT().SendVerificationEmail("user@example.com", "verify-token").Return(nil)
+
+	err := d.uc.Execute(ctx, "user-1")
+
+	assert.NoError(t, err)
+}
+
+func TestResendVerification_AlreadyVerified(t *testing.T) {
+	d := setupResendVerification(t)
+	ctx := context.Background()
+	verifiedAt := time.Now()
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, EmailVerifiedAt: &verifiedAt}, nil)
+
+	err := d.uc.Execute(ctx, "user-1")
+
+	var alreadyErr *domainerrors.EmailAlreadyVerifiedError
+	assert.ErrorAs(t, err, &alreadyErr)
+}
+
+func TestResendVerification_UserNotFound(t *testing.T) {
+	d := setupResendVerification(t)
+	ctx := context.Background()
+
+	d.userRepo.EXPECT().FindByID(ctx, "missing").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, "missing")
+
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestResendVerification_EmailError(t *testing.T) {
+	d := setupResendVerification(t)
+	ctx := context.Background()
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Email: "user@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenEmailVerification).Return(nil)
+	d.tokenSvc.EXPECT().Generate().Return("verify-token", nil)
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().Create(ctx, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-2"}, nil)
+	d.emailSvc.EXPECT().SendVerificationEmail("user@example.com", "verify-token").Return(errors.New("SMTP failure"))
+
+	err := d.uc.Execute(ctx, "user-1")
+
+	assert.EqualError(t, err, "SMTP failure")
+}
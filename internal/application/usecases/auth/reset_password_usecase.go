@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type ResetPasswordUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	sessionRepository      repositories.SessionRepository
+	tokenVersionRepository repositories.TokenVersionRepository
+	passwordService        services.PasswordService
+	tokenService           services.TokenService
+	revoker                *sessionRevoker
+}
+
+func NewResetPasswordUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	revokedTokenRepository repositories.RevokedTokenRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+	passwordService services.PasswordService,
+	tokenService services.TokenService,
+	jwtService services.JwtService,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		sessionRepository:      sessionRepository,
+		tokenVersionRepository: tokenVersionRepository,
+		passwordService:        passwordService,
+		tokenService:           tokenService,
+		revoker: &sessionRevoker{
+			refreshTokenRepository: refreshTokenRepository,
+			revokedTokenRepository: revokedTokenRepository,
+			accessTokenTTL:         jwtService.AccessTokenTTL(),
+		},
+	}
+}
+
+// Execute redeems a password reset token and sets the new password. Every
+// other outstanding reset token, refresh token, session and access token of the account is revoked.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input dtos.ResetPasswordInput) error {
+	token, err := uc.oneTimeTokenRepository.FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, uc.tokenService.Hash(input.Token))
+	if err != nil {
+		return err
+	}
+	if token == nil || token.IsUsed() {
+		return domainerrors.NewTokenInvalidError()
+	}
+	if token.IsExpired(time.Now()) {
+		return domainerrors.NewTokenExpiredError()
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, token.AuthRefID)
+	if err != nil {
+		return err
+	}
+	if auth == nil || auth.AnonymizedAt != nil {
+		return domainerrors.NewTokenInvalidError()
+	}
+
+	consumed, err := uc.oneTimeTokenRepository.Consume(ctx, token.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domainerrors.NewTokenInvalidError()
+	}
+
+	hashedPassword, err := uc.passwordService.Hash(input.Password)
+	if err != nil {
+		return err
+	}
+	if err := uc.authRepository.UpdatePassword(ctx, auth.RefID, hashedPassword); err != nil {
+		return err
+	}
+
+	if err := uc.oneTimeTokenRepository.InvalidateAll(ctx, auth.RefID, entities.OneTimeTokenPasswordReset); err != nil {
+		return err
+	}
+
+	// UpdatePassword bumped the token version, which rejects existing access tokens once the cached
+	// version is dropped
+	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
+	if err != nil {
+		return err
+	}
+	if user != nil {
+		if err := uc.tokenVersionRepository.Invalidate(ctx, user.ID); err != nil {
+			return err
+		}
+	}
+
+	ids, err := uc.sessionRepository.RevokeAllForAuth(ctx, auth.RefID)
+	if err != nil {
+		return err
+	}
+	// Also catches refresh tokens issued before sessions were recorded
+	if err := uc.revoker.refreshTokenRepository.RevokeAllForAuth(ctx, auth.RefID); err != nil {
+		return err
+	}
+	return uc.revoker.revoke(ctx, ids...)
+}
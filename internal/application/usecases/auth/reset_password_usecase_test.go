@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type resetPasswordDeps struct {
+	authRepo         *mocks.MockAuthRepository
+	userRepo         *mocks.MockUserRepository
+	oneTimeRepo      *mocks.MockOneTimeTokenRepository
+	refreshRepo      *mocks.MockRefreshTokenRepository
+	sessionRepo      *mocks.MockSessionRepository
+	revokedRepo      *mocks.MockRevokedTokenRepository
+	tokenVersionRepo *mocks.MockTokenVersionRepository
+	passwordSvc      *mocks.MockPasswordService
+	tokenSvc         *mocks.MockTokenService
+	uc               *ResetPasswordUseCase
+}
+
+func setupResetPassword(t *testing.T) resetPasswordDeps {
+	d := resetPasswordDeps{
+		authRepo:         mocks.NewMockAuthRepository(t),
+		userRepo:         mocks.NewMockUserRepository(t),
+		oneTimeRepo:      mocks.NewMockOneTimeTokenRepository(t),
+		refreshRepo:      mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo:      mocks.NewMockSessionRepository(t),
+		revokedRepo:      mocks.NewMockRevokedTokenRepository(t),
+		tokenVersionRepo: mocks.NewMockTokenVersionRepository(t),
+		passwordSvc:      mocks.NewMockPasswordService(t),
+		tokenSvc:         mocks.NewMockTokenService(t),
+	}
+	jwtSvc := mocks.NewMockJwtService(t)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.uc = NewResetPasswordUseCase(d.authRepo, d.userRepo, d.oneTimeRepo, d.refreshRepo, d.sessionRepo, d.revokedRepo, d.tokenVersionRepo, d.passwordSvc, d.tokenSvc, jwtSvc)
+	return d
+}
+
+func validResetInput() dtos.ResetPasswordInput {
+	return dtos.ResetPasswordInput{Token: "reset-token", Password: "NewPassword1", ConfirmPassword: "NewPassword1"}
+}
+
+func activeResetToken() *entities.OneTimeToken {
+	return &entities.OneTimeToken{
+		ID:        "ott-1",
+		AuthRefID: 100,
+		Purpose:   entities.OneTimeTokenPasswordReset,
+		TokenHash: "reset-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestResetPassword_Success(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(activeResetToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.passwordSvc.EXPECT().Hash("NewPassword1").Return("new-hash", nil)
+	d.authRepo.EXPECT().UpdatePassword(ctx, int64(100), "new-hash").Return(nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenPasswordReset).Return(nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1"}}, nil)
+	d.tokenVersionRepo.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+	d.sessionRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return([]string{"family-1"}, nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return(nil)
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+	d.revokedRepo.EXPECT().Revoke(ctx, "family-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_UnknownToken(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalidErr)
+}
+
+func TestResetPassword_UsedToken(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+	used := activeResetToken()
+	now := time.Now()
+	used.UsedAt = &now
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(used, nil)
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalidErr)
+}
+
+func TestResetPassword_ExpiredToken(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+	expired := activeResetToken()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(expired, nil)
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	var expiredErr *domainerrors.TokenExpiredError
+	assert.ErrorAs(t, err, &expiredErr)
+}
+
+func TestResetPassword_ConcurrentRedemption(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(activeResetToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(false, nil)
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalidErr)
+}
+
+func TestResetPassword_UpdateError(t *testing.T) {
+	d := setupResetPassword(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash").Return(activeResetToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100}, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.passwordSvc.EXPECT().Hash("NewPassword1").Return("new-hash", nil)
+	d.authRepo.EXPECT().UpdatePassword(ctx, int64(100), "new-hash").Return(errors.New("db error"))
+
+	err := d.uc.Execute(ctx, validResetInput())
+
+	assert.EqualError(t, err, "db error")
+}
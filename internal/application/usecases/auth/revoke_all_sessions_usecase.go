@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type RevokeAllSessionsUseCase struct {
+	userRepository    repositories.UserRepository
+	sessionRepository repositories.SessionRepository
+	revoker           *sessionRevoker
+}
+
+func NewRevokeAllSessionsUseCase(
+	userRepository repositories.UserRepository,
+	sessionRepository repositories.SessionRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	revokedTokenRepository repositories.RevokedTokenRepository,
+	jwtService services.JwtService,
+) *RevokeAllSessionsUseCase {
+	return &RevokeAllSessionsUseCase{
+		userRepository:    userRepository,
+		sessionRepository: sessionRepository,
+		revoker: &sessionRevoker{
+			refreshTokenRepository: refreshTokenRepository,
+			revokedTokenRepository: revokedTokenRepository,
+			accessTokenTTL:         jwtService.AccessTokenTTL(),
+		},
+	}
+}
+
+// Execute signs the user out everywhere, including the session making the request
+func (uc *RevokeAllSessionsUseCase) Execute(ctx context.Context, userID string) error {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domainerrors.NewUserNotFoundError(userID)
+	}
+
+	ids, err := uc.sessionRepository.RevokeAllForAuth(ctx, user.AuthRefID)
+	if err != nil {
+		return err
+	}
+	// Also catches refresh tokens issued before sessions were recorded
+	if err := uc.revoker.refreshTokenRepository.RevokeAllForAuth(ctx, user.AuthRefID); err != nil {
+		return err
+	}
+	return uc.revoker.revoke(ctx, ids...)
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type RevokeSessionUseCase struct {
+	userRepository    repositories.UserRepository
+	sessionRepository repositories.SessionRepository
+	revoker           *sessionRevoker
+}
+
+func NewRevokeSessionUseCase(
+	userRepository repositories.UserRepository,
+	sessionRepository repositories.SessionRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	revokedTokenRepository repositories.RevokedTokenRepository,
+	jwtService services.JwtService,
+) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		userRepository:    userRepository,
+		sessionRepository: sessionRepository,
+		revoker: &sessionRevoker{
+			refreshTokenRepository: refreshTokenRepository,
+			revokedTokenRepository: revokedTokenRepository,
+			accessTokenTTL:         jwtService.AccessTokenTTL(),
+		},
+	}
+}
+
+// Execute signs one of the user's sessions out. Revoking an already revoked session succeeds.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID, sessionID string) error {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domainerrors.NewUserNotFoundError(userID)
+	}
+
+	session, err := uc.sessionRepository.FindByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	// Someone else's session is reported as missing so session IDs cannot be probed
+	if session == nil || session.AuthRefID != user.AuthRefID {
+		return domainerrors.NewSessionNotFoundError(sessionID)
+	}
+
+	if _, err := uc.sessionRepository.Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+	return uc.revoker.revoke(ctx, sessionID)
+}
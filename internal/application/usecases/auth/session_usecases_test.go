@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionDeps struct {
+	userRepo    *mocks.MockUserRepository
+	sessionRepo *mocks.MockSessionRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	revokedRepo *mocks.MockRevokedTokenRepository
+	jwtSvc      *mocks.MockJwtService
+}
+
+func setupSessions(t *testing.T) sessionDeps {
+	d := sessionDeps{
+		userRepo:    mocks.NewMockUserRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		revokedRepo: mocks.NewMockRevokedTokenRepository(t),
+		jwtSvc:      mocks.NewMockJwtService(t),
+	}
+	d.jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute).Maybe()
+	return d
+}
+
+func (d sessionDeps) expectUser(ctx context.Context) {
+	d.userRepo.EXPECT().FindByID(ctx, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+}
+
+// expectSessionRevoked expects the session's refresh tokens to be revoked and its
+// access tokens to be listed until the last one expires
+func (d sessionDeps) expectSessionRevoked(ctx context.Context, id string) {
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, id).Return(nil)
+	d.revokedRepo.EXPECT().Revoke(ctx, id, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return expiresAt.After(time.Now().Add(14*time.Minute)) && expiresAt.Before(time.Now().Add(16*time.Minute))
+	})).Return(nil)
+}
+
+func TestListSessions_FlagsCurrentSession(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewListSessionsUseCase(d.userRepo, d.sessionRepo)
+
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().FindActiveByAuthRefID(ctx, int64(100)).Return([]entities.Session{
+		{ID: "session-1", UserAgent: "Firefox", IPAddress: "192.0.2.1"},
+		{ID: "session-2", UserAgent: "curl"},
+	}, nil)
+
+	result, err := uc.Execute(ctx, "user-1", "session-2")
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "Firefox", result[0].UserAgent)
+	assert.Equal(t, "192.0.2.1", result[0].IPAddress)
+	assert.False(t, result[0].Current)
+	assert.True(t, result[1].Current)
+}
+
+func TestListSessions_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewListSessionsUseCase(d.userRepo, d.sessionRepo)
+
+	d.userRepo.EXPECT().FindByID(ctx, "ghost").Return(nil, nil)
+
+	result, err := uc.Execute(ctx, "ghost", "")
+
+	assert.Nil(t, result)
+	var notFound *domainerrors.UserNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRevokeSession_Success(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewRevokeSessionUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, d.jwtSvc)
+
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().FindByID(ctx, "session-1").Return(&entities.Session{ID: "session-1", AuthRefID: 100}, nil)
+	d.sessionRepo.EXPECT().Revoke(ctx, "session-1").Return(true, nil)
+	d.expectSessionRevoked(ctx, "session-1")
+
+	assert.NoError(t, uc.Execute(ctx, "user-1", "session-1"))
+}
+
+func TestRevokeSession_OtherUsersSession(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewRevokeSessionUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, d.jwtSvc)
+
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().FindByID(ctx, "session-9").Return(&entities.Session{ID: "session-9", AuthRefID: 999}, nil)
+
+	err := uc.Execute(ctx, "user-1", "session-9")
+
+	var notFound *domainerrors.SessionNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRevokeSession_NotFound(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewRevokeSessionUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, d.jwtSvc)
+
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().FindByID(ctx, "missing").Return(nil, nil)
+
+	err := uc.Execute(ctx, "user-1", "missing")
+
+	var notFound *domainerrors.SessionNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRevokeAllSessions_Success(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewRevokeAllSessionsUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, d.jwtSvc)
+
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return([]string{"session-1", "session-2"}, nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return(nil)
+	d.expectSessionRevoked(ctx, "session-1")
+	d.expectSessionRevoked(ctx, "session-2")
+
+	assert.NoError(t, uc.Execute(ctx, "user-1"))
+}
+
+func TestRevokeAllSessions_RevocationListError(t *testing.T) {
+	ctx := context.Background()
+	d := setupSessions(t)
+	uc := NewRevokeAllSessionsUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, d.jwtSvc)
+
+	storeErr := errors.New("redis down")
+	d.expectUser(ctx)
+	d.sessionRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return([]string{"session-1"}, nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(ctx, int64(100)).Return(nil)
+	d.refreshRepo.EXPECT().RevokeFamily(ctx, "session-1").Return(nil)
+	d.revokedRepo.EXPECT().Revoke(ctx, "session-1", mock.Anything).Return(storeErr)
+
+	assert.Equal(t, storeErr, uc.Execute(ctx, "user-1"))
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// sessionRevoker signs sessions out: their refresh tokens stop working, and their access
+// tokens are rejected through the revocation list until the last one has expired
+type sessionRevoker struct {
+	refreshTokenRepository repositories.RefreshTokenRepository
+	revokedTokenRepository repositories.RevokedTokenRepository
+	accessTokenTTL         time.Duration
+}
+
+func (r *sessionRevoker) revoke(ctx context.Context, sessionIDs ...string) error {
+	expiresAt := time.Now().Add(r.accessTokenTTL)
+	for _, id := range sessionIDs {
+		if err := r.refreshTokenRepository.RevokeFamily(ctx, id); err != nil {
+			return err
+		}
+		if err := r.revokedTokenRepository.Revoke(ctx, id, expiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
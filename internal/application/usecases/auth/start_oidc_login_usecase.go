@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// oidcLoginStateTTL is how long the user has to complete the login at the identity provider
+const oidcLoginStateTTL = 10 * time.Minute
+
+type StartOidcLoginUseCase struct {
+	oidcService              services.OidcService
+	oidcLoginStateRepository repositories.OidcLoginStateRepository
+	tokenService             services.TokenService
+}
+
+func NewStartOidcLoginUseCase(
+	oidcService services.OidcService,
+	oidcLoginStateRepository repositories.OidcLoginStateRepository,
+	tokenService services.TokenService,
+) *StartOidcLoginUseCase {
+	return &StartOidcLoginUseCase{
+		oidcService:              oidcService,
+		oidcLoginStateRepository: oidcLoginStateRepository,
+		tokenService:             tokenService,
+	}
+}
+
+// Execute starts an authorization code login with provider. The state, nonce and PKCE
+// verifier are kept server-side until OidcCallbackUseCase redeems the state.
+func (uc *StartOidcLoginUseCase) Execute(ctx context.Context, provider string) (*dtos.OidcAuthorizationResponse, error) {
+	if !uc.oidcService.HasProvider(provider) {
+		return nil, domainerrors.NewOidcProviderNotFoundError()
+	}
+
+	state, err := uc.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := uc.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := uc.tokenService.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationURL, err := uc.oidcService.AuthorizationURL(ctx, provider, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(oidcLoginStateTTL)
+	err = uc.oidcLoginStateRepository.Create(ctx, entities.CreateOidcLoginStateData{
+		StateHash:    uc.tokenService.Hash(state),
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.OidcAuthorizationResponse{AuthorizationURL: authorizationURL, ExpiresAt: expiresAt}, nil
+}
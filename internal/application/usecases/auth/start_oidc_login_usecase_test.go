@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type startOidcLoginDeps struct {
+	oidcSvc   *mocks.MockOidcService
+	stateRepo *mocks.MockOidcLoginStateRepository
+	tokenSvc  *mocks.MockTokenService
+	uc        *StartOidcLoginUseCase
+}
+
+func setupStartOidcLogin(t *testing.T) startOidcLoginDeps {
+	d := startOidcLoginDeps{
+		oidcSvc:   mocks.NewMockOidcService(t),
+		stateRepo: mocks.NewMockOidcLoginStateRepository(t),
+		tokenSvc:  mocks.NewMockTokenService(t),
+	}
+	d.uc = NewStartOidcLoginUseCase(d.oidcSvc, d.stateRepo, d.tokenSvc)
+	return d
+}
+
+func expectOidcSecrets(tokenSvc *mocks.MockTokenService) {
+	tokenSvc.EXPECT().Generate().Return("state", nil).Once()
+	tokenSvc.EXPECT().Generate().Return("nonce", nil).Once()
+	tokenSvc.EXPECT().Generate().Return("verifier", nil).Once()
+}
+
+func TestStartOidcLogin_Success(t *testing.T) {
+	d := setupStartOidcLogin(t)
+	ctx := context.Background()
+
+	d.oidcSvc.EXPECT().HasProvider("google").Return(true)
+	expectOidcSecrets(d.tokenSvc)
+	d.oidcSvc.EXPECT().AuthorizationURL(ctx, "google", "state", "nonce", "verifier").Return("https://idp.example.com/authorize?x=1", nil)
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateOidcLoginStateData) bool {
+		return data.StateHash == "state-hash" && data.Provider == "google" &&
+			data.Nonce == "nonce" && data.CodeVerifier == "verifier" &&
+			time.Until(data.ExpiresAt) > 9*time.Minute
+	})).Return(nil)
+
+	result, err := d.uc.Execute(ctx, "google")
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/authorize?x=1", result.AuthorizationURL)
+	assert.True(t, result.ExpiresAt.After(time.Now()))
+}
+
+func TestStartOidcLogin_UnknownProvider(t *testing.T) {
+	d := setupStartOidcLogin(t)
+
+	d.oidcSvc.EXPECT().HasProvider("myspace").Return(false)
+
+	_, err := d.uc.Execute(context.Background(), "myspace")
+
+	var notFound *domainerrors.OidcProviderNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestStartOidcLogin_DiscoveryError(t *testing.T) {
+	d := setupStartOidcLogin(t)
+	ctx := context.Background()
+
+	d.oidcSvc.EXPECT().HasProvider("google").Return(true)
+	expectOidcSecrets(d.tokenSvc)
+	d.oidcSvc.EXPECT().AuthorizationURL(ctx, "google", "state", "nonce", "verifier").Return("", errors.New("discovery failed"))
+
+	_, err := d.uc.Execute(ctx, "google")
+
+	assert.EqualError(t, err, "discovery failed")
+}
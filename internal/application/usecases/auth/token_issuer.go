@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// tokenIssuer signs access tokens and persists the sessions and refresh tokens handed out with them
+type tokenIssuer struct {
+	refreshTokenRepository repositories.RefreshTokenRepository
+	sessionRepository      repositories.SessionRepository
+	jwtService             services.JwtService
+	tokenService           services.TokenService
+}
+
+// issue starts a session for the account on the client's device and returns its first
+// token pair. The session ID doubles as the refresh token family.
+func (i *tokenIssuer) issue(ctx context.Context, userID string, auth *entities.Auth, client entities.SessionClient) (*dtos.AuthResponse, error) {
+	session, err := i.sessionRepository.Create(ctx, entities.CreateSessionData{
+		AuthRefID: auth.RefID,
+		Client:    client,
+		ExpiresAt: time.Now().Add(i.jwtService.RefreshTokenTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := i.signAccessToken(userID, auth, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, data, err := i.newRefreshToken(auth.RefID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := i.refreshTokenRepository.Create(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &dtos.AuthResponse{
+		UserID:                userID,
+		Token:                 token,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: data.ExpiresAt,
+	}, nil
+}
+
+func (i *tokenIssuer) signAccessToken(userID string, auth *entities.Auth, sessionID string) (string, time.Time, error) {
+	token, err := i.jwtService.Sign(services.JwtPayload{
+		UserID:        userID,
+		Role:          auth.Role,
+		EmailVerified: auth.IsEmailVerified(),
+		TokenVersion:  auth.TokenVersion,
+		SessionID:     sessionID,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, time.Now().Add(i.jwtService.AccessTokenTTL()), nil
+}
+
+// newRefreshToken generates a refresh token and the record that persists its hash.
+// An empty familyID starts a new family.
+func (i *tokenIssuer) newRefreshToken(authRefID int64, familyID string) (string, entities.CreateRefreshTokenData, error) {
+	token, err := i.tokenService.Generate()
+	if err != nil {
+		return "", entities.CreateRefreshTokenData{}, err
+	}
+	return token, entities.CreateRefreshTokenData{
+		AuthRefID: authRefID,
+		FamilyID:  familyID,
+		TokenHash: i.tokenService.Hash(token),
+		ExpiresAt: time.Now().Add(i.jwtService.RefreshTokenTTL()),
+	}, nil
+}
+
+// createOneTimeToken generates a single-use token for the account and persists its hash.
+// The plain token is returned so it can be emailed; it is never stored.
+func createOneTimeToken(
+	ctx context.Context,
+	repository repositories.OneTimeTokenRepository,
+	tokenService services.TokenService,
+	authRefID int64,
+	purpose string,
+	ttl time.Duration,
+) (string, error) {
+	token, err := tokenService.Generate()
+	if err != nil {
+		return "", err
+	}
+	_, err = repository.Create(ctx, entities.CreateOneTimeTokenData{
+		AuthRefID: authRefID,
+		Purpose:   purpose,
+		TokenHash: tokenService.Hash(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+const (
+	mfaChallengeTTL   = 5 * time.Minute
+	recoveryCodeCount = 10
+)
+
+// findAuthForUser loads the auth record behind a user profile
+func findAuthForUser(
+	ctx context.Context,
+	userRepository repositories.UserRepository,
+	authRepository repositories.AuthRepository,
+	userID string,
+) (*entities.Auth, error) {
+	user, err := userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+
+	auth, err := authRepository.FindByRefID(ctx, user.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+	return auth, nil
+}
+
+// startTotpEnrollment stores a fresh, unconfirmed TOTP secret for the account
+func startTotpEnrollment(
+	ctx context.Context,
+	authRepository repositories.AuthRepository,
+	totpService services.TotpService,
+	auth *entities.Auth,
+) (*dtos.TotpEnrollmentResponse, error) {
+	secret, err := totpService.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := authRepository.StartTotpEnrollment(ctx, auth.RefID, secret); err != nil {
+		return nil, err
+	}
+	return &dtos.TotpEnrollmentResponse{
+		Secret:     secret,
+		OtpauthURI: totpService.ProvisioningURI(secret, auth.Email),
+	}, nil
+}
+
+// startMfaChallenge issues a short-lived MFA token for the second login step. Accounts that must
+// use two-factor but have not set it up also get a new TOTP secret, confirmed by that step.
+func startMfaChallenge(
+	ctx context.Context,
+	authRepository repositories.AuthRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	totpService services.TotpService,
+	tokenService services.TokenService,
+	auth *entities.Auth,
+) (*dtos.LoginResponse, error) {
+	response := &dtos.LoginResponse{MfaRequired: true}
+
+	if !auth.IsTwoFactorEnabled() {
+		enrollment, err := startTotpEnrollment(ctx, authRepository, totpService, auth)
+		if err != nil {
+			return nil, err
+		}
+		response.TotpEnrollment = enrollment
+	}
+
+	token, err := createOneTimeToken(ctx, oneTimeTokenRepository, tokenService,
+		auth.RefID, entities.OneTimeTokenMfaChallenge, mfaChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(mfaChallengeTTL)
+
+	response.MfaToken = token
+	response.MfaTokenExpiresAt = &expiresAt
+	return response, nil
+}
+
+// verifyTotpCode checks a TOTP code against the account's secret and records its
+// time step, so the same code is never accepted twice
+func verifyTotpCode(
+	ctx context.Context,
+	authRepository repositories.AuthRepository,
+	totpService services.TotpService,
+	auth *entities.Auth,
+	code string,
+	now time.Time,
+) (bool, error) {
+	if auth.TotpSecret == nil {
+		return false, nil
+	}
+	step, ok := totpService.Validate(*auth.TotpSecret, code, now)
+	if !ok {
+		return false, nil
+	}
+	return authRepository.UseTotpStep(ctx, auth.RefID, step)
+}
+
+// generateRecoveryCodes replaces the account's recovery codes with a new set and
+// returns them in plain text; only their hashes are stored. Like opaque tokens, each
+// code carries 80 random bits, too many to recover it from its unsalted hash.
+func generateRecoveryCodes(
+	ctx context.Context,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+	totpService services.TotpService,
+	tokenService services.TokenService,
+	authRefID int64,
+) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := totpService.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		hashes[i] = tokenService.Hash(normalizeRecoveryCode(code))
+	}
+
+	if err := recoveryCodeRepository.ReplaceAll(ctx, authRefID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode ignores case and separators so codes can be typed loosely
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type TwoFactorLoginUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	recoveryCodeRepository repositories.RecoveryCodeRepository
+	tokenService           services.TokenService
+	totpService            services.TotpService
+	throttle               *loginThrottle
+	issuer                 *tokenIssuer
+}
+
+func NewTwoFactorLoginUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	sessionRepository repositories.SessionRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+	jwtService services.JwtService,
+	tokenService services.TokenService,
+	totpService services.TotpService,
+	lockoutPolicy entities.LoginLockoutPolicy,
+) *TwoFactorLoginUseCase {
+	return &TwoFactorLoginUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		tokenService:           tokenService,
+		totpService:            totpService,
+		throttle: &loginThrottle{
+			authRepository: authRepository,
+			policy:         lockoutPolicy,
+		},
+		issuer: &tokenIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			sessionRepository:      sessionRepository,
+			jwtService:             jwtService,
+			tokenService:           tokenService,
+		},
+	}
+}
+
+// Execute completes a login that answered with an MFA challenge. The code is a TOTP code or,
+// once two-factor is enabled, a recovery code. A pending enrollment is confirmed by its first
+// valid code, and the new recovery codes are returned alongside the token pair.
+func (uc *TwoFactorLoginUseCase) Execute(ctx context.Context, client entities.SessionClient, input dtos.TwoFactorLoginInput) (*dtos.LoginResponse, error) {
+	challenge, err := uc.oneTimeTokenRepository.FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, uc.tokenService.Hash(input.MfaToken))
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil || challenge.IsUsed() {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	now := time.Now()
+	if challenge.IsExpired(now) {
+		return nil, domainerrors.NewTokenExpiredError()
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, challenge.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+	if err := uc.throttle.checkLocked(auth, now); err != nil {
+		return nil, err
+	}
+	if err := authorization.EnsureAccountActive(auth.AccountStatus, now); err != nil {
+		return nil, err
+	}
+
+	var valid bool
+	switch {
+	case auth.IsTwoFactorEnabled():
+		valid, err = uc.verifySecondFactor(ctx, auth, input.Code, now)
+	case auth.HasPendingTotpEnrollment():
+		valid, err = verifyTotpCode(ctx, uc.authRepository, uc.totpService, auth, input.Code, now)
+	default:
+		return nil, domainerrors.NewTwoFactorNotEnrolledError()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, uc.throttle.recordFailure(ctx, auth.RefID, now, domainerrors.NewInvalidTwoFactorCodeError())
+	}
+
+	// Consume the challenge atomically so it cannot complete two logins
+	consumed, err := uc.oneTimeTokenRepository.Consume(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, domainerrors.NewTokenInvalidError()
+	}
+
+	var recoveryCodes []string
+	if !auth.IsTwoFactorEnabled() {
+		if err := uc.authRepository.EnableTotp(ctx, auth.RefID); err != nil {
+			return nil, err
+		}
+		recoveryCodes, err = generateRecoveryCodes(ctx, uc.recoveryCodeRepository, uc.totpService, uc.tokenService, auth.RefID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.throttle.reset(ctx, auth); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewInvalidCredentialsError()
+	}
+
+	result, err := uc.issuer.issue(ctx, user.ID, auth, client)
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.LoginResponse{AuthResponse: result, RecoveryCodes: recoveryCodes}, nil
+}
+
+// verifySecondFactor accepts a TOTP code, falling back to the account's recovery codes
+func (uc *TwoFactorLoginUseCase) verifySecondFactor(ctx context.Context, auth *entities.Auth, code string, now time.Time) (bool, error) {
+	valid, err := verifyTotpCode(ctx, uc.authRepository, uc.totpService, auth, code, now)
+	if err != nil || valid {
+		return valid, err
+	}
+	return uc.recoveryCodeRepository.Consume(ctx, auth.RefID, uc.tokenService.Hash(normalizeRecoveryCode(code)))
+}
@@ -0,0 +1,242 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type twoFactorLoginDeps struct {
+	authRepo     *mocks.MockAuthRepository
+	userRepo     *mocks.MockUserRepository
+	refreshRepo  *mocks.MockRefreshTokenRepository
+	sessionRepo  *mocks.MockSessionRepository
+	oneTimeRepo  *mocks.MockOneTimeTokenRepository
+	recoveryRepo *mocks.MockRecoveryCodeRepository
+	jwtSvc       *mocks.MockJwtService
+	tokenSvc     *mocks.MockTokenService
+	totpSvc      *mocks.MockTotpService
+	uc           *TwoFactorLoginUseCase
+}
+
+func setupTwoFactorLogin(t *testing.T) twoFactorLoginDeps {
+	d := twoFactorLoginDeps{
+		authRepo:     mocks.NewMockAuthRepository(t),
+		userRepo:     mocks.NewMockUserRepository(t),
+		refreshRepo:  mocks.NewMockRefreshTokenRepository(t),
+		sessionRepo:  mocks.NewMockSessionRepository(t),
+		oneTimeRepo:  mocks.NewMockOneTimeTokenRepository(t),
+		recoveryRepo: mocks.NewMockRecoveryCodeRepository(t),
+		jwtSvc:       mocks.NewMockJwtService(t),
+		tokenSvc:     mocks.NewMockTokenService(t),
+		totpSvc:      mocks.NewMockTotpService(t),
+	}
+	d.uc = NewTwoFactorLoginUseCase(d.authRepo, d.userRepo, d.refreshRepo, d.sessionRepo, d.oneTimeRepo, d.recoveryRepo,
+		d.jwtSvc, d.tokenSvc, d.totpSvc, entities.DefaultLoginLockoutPolicy())
+	return d
+}
+
+func activeMfaChallenge() *entities.OneTimeToken {
+	return &entities.OneTimeToken{
+		ID:        "ott-1",
+		AuthRefID: 100,
+		Purpose:   entities.OneTimeTokenMfaChallenge,
+		TokenHash: "mfa-hash",
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	}
+}
+
+func twoFactorAuth() *entities.Auth {
+	secret := "SECRET"
+	enabledAt := time.Now().Add(-time.Hour)
+	return &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Role: "USER", TotpSecret: &secret, TotpEnabledAt: &enabledAt}
+}
+
+// expectChallengeCompleted sets up the expectations shared by every successful second step
+func (d twoFactorLoginDeps) expectChallengeCompleted(ctx context.Context) {
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 100}}, nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	expectTokensIssued(ctx, d.jwtSvc, d.tokenSvc, d.refreshRepo, d.sessionRepo, 100)
+}
+
+func TestTwoFactorLogin_TotpSuccess(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+	d.totpSvc.EXPECT().Validate("SECRET", "123456", mock.AnythingOfType("time.Time")).Return(int64(42), true)
+	d.authRepo.EXPECT().UseTotpStep(ctx, int64(100), int64(42)).Return(true, nil)
+	d.expectChallengeCompleted(ctx)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.Empty(t, result.RecoveryCodes)
+}
+
+func TestTwoFactorLogin_RecoveryCodeSuccess(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+	d.totpSvc.EXPECT().Validate("SECRET", "abcd-efgh-jklm-npqr", mock.AnythingOfType("time.Time")).Return(int64(0), false)
+	// Recovery codes are matched case- and separator-insensitively
+	d.tokenSvc.EXPECT().Hash("ABCDEFGHJKLMNPQR").Return("code-hash")
+	d.recoveryRepo.EXPECT().Consume(ctx, int64(100), "code-hash").Return(true, nil)
+	d.expectChallengeCompleted(ctx)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "abcd-efgh-jklm-npqr"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+}
+
+func TestTwoFactorLogin_CompletesRequiredEnrollment(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	secret := "NEWSECRET"
+	auth := &entities.Auth{ID: "auth-1", RefID: 100, Email: "user@example.com", Role: "USER", TotpSecret: &secret}
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.totpSvc.EXPECT().Validate("NEWSECRET", "123456", mock.AnythingOfType("time.Time")).Return(int64(42), true)
+	d.authRepo.EXPECT().UseTotpStep(ctx, int64(100), int64(42)).Return(true, nil)
+	d.authRepo.EXPECT().EnableTotp(ctx, int64(100)).Return(nil)
+	d.totpSvc.EXPECT().GenerateRecoveryCode().Return("ABCD-EFGH-JKLM-NPQR", nil).Times(recoveryCodeCount)
+	d.tokenSvc.EXPECT().Hash("ABCDEFGHJKLMNPQR").Return("code-hash").Times(recoveryCodeCount)
+	d.recoveryRepo.EXPECT().ReplaceAll(ctx, int64(100), mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == recoveryCodeCount
+	})).Return(nil)
+	d.expectChallengeCompleted(ctx)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", result.Token)
+	assert.Len(t, result.RecoveryCodes, recoveryCodeCount)
+}
+
+func TestTwoFactorLogin_InvalidCodeCountsAsFailure(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+	d.totpSvc.EXPECT().Validate("SECRET", "000000", mock.AnythingOfType("time.Time")).Return(int64(0), false)
+	d.tokenSvc.EXPECT().Hash("000000").Return("wrong-hash")
+	d.recoveryRepo.EXPECT().Consume(ctx, int64(100), "wrong-hash").Return(false, nil)
+	d.authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(1, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "000000"})
+
+	assert.Nil(t, result)
+	var codeErr *domainerrors.InvalidTwoFactorCodeError
+	assert.True(t, errors.As(err, &codeErr))
+}
+
+func TestTwoFactorLogin_ReplayedTotpRejected(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+	d.totpSvc.EXPECT().Validate("SECRET", "123456", mock.AnythingOfType("time.Time")).Return(int64(42), true)
+	d.authRepo.EXPECT().UseTotpStep(ctx, int64(100), int64(42)).Return(false, nil)
+	d.tokenSvc.EXPECT().Hash("123456").Return("code-hash")
+	d.recoveryRepo.EXPECT().Consume(ctx, int64(100), "code-hash").Return(false, nil)
+	d.authRepo.EXPECT().IncrementFailedLogins(ctx, int64(100)).Return(1, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	assert.Nil(t, result)
+	var codeErr *domainerrors.InvalidTwoFactorCodeError
+	assert.True(t, errors.As(err, &codeErr))
+}
+
+func TestTwoFactorLogin_UnknownChallenge(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestTwoFactorLogin_ExpiredChallenge(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	challenge := activeMfaChallenge()
+	challenge.ExpiresAt = time.Now().Add(-time.Second)
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(challenge, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	assert.Nil(t, result)
+	var expiredErr *domainerrors.TokenExpiredError
+	assert.True(t, errors.As(err, &expiredErr))
+}
+
+func TestTwoFactorLogin_LockedAccount(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	auth := twoFactorAuth()
+	lockedUntil := time.Now().Add(time.Minute)
+	auth.LockedUntil = &lockedUntil
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	assert.Nil(t, result)
+	var lockedErr *domainerrors.AccountLockedError
+	assert.True(t, errors.As(err, &lockedErr))
+}
+
+func TestTwoFactorLogin_ChallengeAlreadyConsumed(t *testing.T) {
+	ctx := context.Background()
+	d := setupTwoFactorLogin(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(activeMfaChallenge(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(twoFactorAuth(), nil)
+	d.totpSvc.EXPECT().Validate("SECRET", "123456", mock.AnythingOfType("time.Time")).Return(int64(42), true)
+	d.authRepo.EXPECT().UseTotpStep(ctx, int64(100), int64(42)).Return(true, nil)
+	// Lost the race against a concurrent use of the same challenge
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(false, nil)
+
+	result, err := d.uc.Execute(ctx, testSessionClient, dtos.TwoFactorLoginInput{MfaToken: "mfa-token", Code: "123456"})
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.True(t, errors.As(err, &invalidErr))
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// emailVerificationTokenTTL is how long an emailed verification link stays valid
+const emailVerificationTokenTTL = 48 * time.Hour
+
+type VerifyEmailUseCase struct {
+	authRepository         repositories.AuthRepository
+	userRepository         repositories.UserRepository
+	oneTimeTokenRepository repositories.OneTimeTokenRepository
+	emailService           services.EmailService
+	tokenService           services.TokenService
+}
+
+func NewVerifyEmailUseCase(
+	authRepository repositories.AuthRepository,
+	userRepository repositories.UserRepository,
+	oneTimeTokenRepository repositories.OneTimeTokenRepository,
+	emailService services.EmailService,
+	tokenService services.TokenService,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		emailService:           emailService,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute redeems an email verification token and marks the account's address as verified.
+// Access tokens pick up the new status on their next refresh.
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input dtos.VerifyEmailInput) error {
+	token, err := uc.oneTimeTokenRepository.FindByTokenHash(ctx, entities.OneTimeTokenEmailVerification, uc.tokenService.Hash(input.Token))
+	if err != nil {
+		return err
+	}
+	if token == nil || token.IsUsed() {
+		return domainerrors.NewTokenInvalidError()
+	}
+	if token.IsExpired(time.Now()) {
+		return domainerrors.NewTokenExpiredError()
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, token.AuthRefID)
+	if err != nil {
+		return err
+	}
+	if auth == nil || auth.AnonymizedAt != nil {
+		return domainerrors.NewTokenInvalidError()
+	}
+
+	consumed, err := uc.oneTimeTokenRepository.Consume(ctx, token.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domainerrors.NewTokenInvalidError()
+	}
+
+	if auth.IsEmailVerified() {
+		return nil
+	}
+
+	if err := uc.authRepository.MarkEmailVerified(ctx, auth.RefID); err != nil {
+		return err
+	}
+	if err := uc.oneTimeTokenRepository.InvalidateAll(ctx, auth.RefID, entities.OneTimeTokenEmailVerification); err != nil {
+		return err
+	}
+
+	// Send welcome email (fire and forget)
+	name := "there"
+	if user, err := uc.userRepository.FindByAuthRefID(ctx, auth.RefID); err == nil && user != nil && user.FirstName != nil {
+		name = *user.FirstName
+	}
+	_ = uc.emailService.SendWelcomeEmail(auth.Email, name)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type verifyEmailDeps struct {
+	authRepo    *mocks.MockAuthRepository
+	userRepo    *mocks.MockUserRepository
+	oneTimeRepo *mocks.MockOneTimeTokenRepository
+	emailSvc    *mocks.MockEmailService
+	tokenSvc    *mocks.MockTokenService
+	uc          *VerifyEmailUseCase
+}
+
+func setupVerifyEmail(t *testing.T) verifyEmailDeps {
+	d := verifyEmailDeps{
+		authRepo:    mocks.NewMockAuthRepository(t),
+		userRepo:    mocks.NewMockUserRepository(t),
+		oneTimeRepo: mocks.NewMockOneTimeTokenRepository(t),
+		emailSvc:    mocks.NewMockEmailService(t),
+		tokenSvc:    mocks.NewMockTokenService(t),
+	}
+	d.uc = NewVerifyEmailUseCase(d.authRepo, d.userRepo, d.oneTimeRepo, d.emailSvc, d.tokenSvc)
+	return d
+}
+
+func activeVerificationToken() *entities.OneTimeToken {
+	return &entities.OneTimeToken{
+		ID:        "ott-1",
+		AuthRefID: 100,
+		Purpose:   entities.OneTimeTokenEmailVerification,
+		TokenHash: "verify-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func (d verifyEmailDeps) expectTokenRedeemed(ctx context.Context, auth *entities.Auth) {
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenEmailVerification, "verify-hash").Return(activeVerificationToken(), nil)
+	d.authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(auth, nil)
+	d.oneTimeRepo.EXPECT().Consume(ctx, "ott-1").Return(true, nil)
+}
+
+func TestVerifyEmail_Success(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+	firstName := "John"
+
+	d.expectTokenRedeemed(ctx, &entities.Auth{RefID: 100, Email: "user@example.com"})
+	d.authRepo.EXPECT().MarkEmailVerified(ctx, int64(100)).Return(nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenEmailVerification).Return(nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{User: entities.User{FirstName: &firstName}}, nil)
+	d.emailSvc.EXPECT().SendWelcomeEmail("user@example.com", "John").Return(nil)
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyEmail_WelcomeEmailUsesDefaultName(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+
+	d.expectTokenRedeemed(ctx, &entities.Auth{RefID: 100, Email: "user@example.com"})
+	d.authRepo.EXPECT().MarkEmailVerified(ctx, int64(100)).Return(nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(ctx, int64(100), entities.OneTimeTokenEmailVerification).Return(nil)
+	d.userRepo.EXPECT().FindByAuthRefID(ctx, int64(100)).Return(&entities.PublicUser{}, nil)
+	// Expect "there" as the default name when FirstName is nil
+	d.emailSvc.EXPECT().SendWelcomeEmail("user@example.com", "there").Return(errors.New("SMTP failure"))
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyEmail_AlreadyVerified(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+	verifiedAt := time.Now().Add(-time.Hour)
+
+	d.expectTokenRedeemed(ctx, &entities.Auth{RefID: 100, EmailVerifiedAt: &verifiedAt})
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyEmail_UnknownToken(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenEmailVerification, "verify-hash").Return(nil, nil)
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	var invalidErr *domainerrors.TokenInvalidError
+	assert.ErrorAs(t, err, &invalidErr)
+}
+
+func TestVerifyEmail_ExpiredToken(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+	expired := activeVerificationToken()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(ctx, entities.OneTimeTokenEmailVerification, "verify-hash").Return(expired, nil)
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	var expiredErr *domainerrors.TokenExpiredError
+	assert.ErrorAs(t, err, &expiredErr)
+}
+
+func TestVerifyEmail_MarkError(t *testing.T) {
+	d := setupVerifyEmail(t)
+	ctx := context.Background()
+
+	d.expectTokenRedeemed(ctx, &entities.Auth{RefID: 100})
+	d.authRepo.EXPECT().MarkEmailVerified(ctx, int64(100)).Return(errors.New("db error"))
+
+	err := d.uc.Execute(ctx, dtos.VerifyEmailInput{Token: "verify-token"})
+
+	assert.EqualError(t, err, "db error")
+}
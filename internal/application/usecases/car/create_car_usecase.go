@@ -70,8 +70,9 @@ func (uc *CreateCarUseCase) Execute(ctx context.Context, userID string, input dt
 	}
 
 	return uc.carRepository.Create(ctx, entities.CreateCarData{
-		LicensePlate: input.LicensePlate,
-		ModelRefID:   model.RefID,
-		DriverRefID:  driver.RefID,
+		LicensePlate:    input.LicensePlate,
+		ModelRefID:      model.RefID,
+		DriverRefID:     driver.RefID,
+		FuelConsumption: input.FuelConsumption,
 	})
 }
@@ -3,6 +3,7 @@ package car
 import (
 	"context"
 
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 )
@@ -38,8 +39,8 @@ func (uc *DeleteCarUseCase) Execute(ctx context.Context, id, userID string) erro
 	if driver == nil {
 		return domainerrors.NewDriverNotFoundError(userID)
 	}
-	if existing.DriverRefID != driver.RefID {
-		return domainerrors.NewForbiddenError("car", id)
+	if err := authorization.EnsureDriverOwnsCar(driver, existing); err != nil {
+		return err
 	}
 
 	return uc.carRepository.Delete(ctx, id)
@@ -3,6 +3,7 @@ package car
 import (
 	"context"
 
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
@@ -11,9 +12,10 @@ import (
 // UpdateCarData holds partial update fields for a car use case.
 // All fields are optional to support both PUT (all set) and PATCH (some set).
 type UpdateCarData struct {
-	Model        *string
-	BrandID      *string
-	LicensePlate *string
+	Model           *string
+	BrandID         *string
+	LicensePlate    *string
+	FuelConsumption *float64
 }
 
 type UpdateCarUseCase struct {
@@ -53,11 +55,11 @@ func (uc *UpdateCarUseCase) Execute(ctx context.Context, id, userID string, inpu
 	if driver == nil {
 		return nil, domainerrors.NewDriverNotFoundError(userID)
 	}
-	if existing.DriverRefID != driver.RefID {
-		return nil, domainerrors.NewForbiddenError("car", id)
+	if err := authorization.EnsureDriverOwnsCar(driver, existing); err != nil {
+		return nil, err
 	}
 
-	updateData := entities.UpdateCarData{}
+	updateData := entities.UpdateCarData{FuelConsumption: input.FuelConsumption}
 
 	if input.LicensePlate != nil {
 		if *input.LicensePlate != existing.LicensePlate {
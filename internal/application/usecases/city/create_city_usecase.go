@@ -19,8 +19,16 @@ func NewCreateCityUseCase(cityRepository repositories.CityRepository) *CreateCit
 }
 
 func (uc *CreateCityUseCase) Execute(ctx context.Context, input dtos.CreateCityInput) (*entities.City, error) {
+	timeZone := input.TimeZone
+	if timeZone == "" {
+		timeZone = entities.DefaultTimeZone
+	}
+
 	return uc.cityRepository.Create(ctx, entities.CreateCityData{
-		CityName: input.CityName,
-		Zipcode:  input.Zipcode,
+		CityName:  input.CityName,
+		Zipcode:   input.Zipcode,
+		TimeZone:  timeZone,
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
 	})
 }
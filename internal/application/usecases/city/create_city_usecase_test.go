@@ -25,6 +25,7 @@ func TestCreateCity_Success(t *testing.T) {
 	cityRepo.EXPECT().Create(mock.Anything, entities.CreateCityData{
 		CityName: "Paris",
 		Zipcode:  "75000",
+		TimeZone: entities.DefaultTimeZone,
 	}).Return(expectedCity, nil)
 
 	uc := NewCreateCityUseCase(cityRepo)
@@ -36,3 +37,53 @@ func TestCreateCity_Success(t *testing.T) {
 	assert.Equal(t, "Paris", result.CityName)
 	assert.Equal(t, "75000", result.Zipcode)
 }
+
+func TestCreateCity_WithTimeZone(t *testing.T) {
+	ctx := context.Background()
+	input := dtos.CreateCityInput{
+		CityName: "Montreal",
+		Zipcode:  "H2X",
+		TimeZone: "America/Toronto",
+	}
+
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	cityRepo.EXPECT().Create(mock.Anything, entities.CreateCityData{
+		CityName: "Montreal",
+		Zipcode:  "H2X",
+		TimeZone: "America/Toronto",
+	}).Return(&entities.City{ID: "city-2", CityName: "Montreal", TimeZone: "America/Toronto"}, nil)
+
+	uc := NewCreateCityUseCase(cityRepo)
+	result, err := uc.Execute(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "America/Toronto", result.TimeZone)
+}
+
+func TestCreateCity_WithCoordinates(t *testing.T) {
+	ctx := context.Background()
+	lat, lng := 45.7719, 4.8902
+	input := dtos.CreateCityInput{
+		CityName:  "Villeurbanne",
+		Zipcode:   "69100",
+		Latitude:  &lat,
+		Longitude: &lng,
+	}
+
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	cityRepo.EXPECT().Create(mock.Anything, entities.CreateCityData{
+		CityName:  "Villeurbanne",
+		Zipcode:   "69100",
+		TimeZone:  entities.DefaultTimeZone,
+		Latitude:  &lat,
+		Longitude: &lng,
+	}).Return(&entities.City{ID: "city-3", CityName: "Villeurbanne", Latitude: &lat, Longitude: &lng}, nil)
+
+	uc := NewCreateCityUseCase(cityRepo)
+	result, err := uc.Execute(ctx, input)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Located())
+}
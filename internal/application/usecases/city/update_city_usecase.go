@@ -0,0 +1,36 @@
+package city
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type UpdateCityUseCase struct {
+	cityRepository repositories.CityRepository
+}
+
+func NewUpdateCityUseCase(cityRepository repositories.CityRepository) *UpdateCityUseCase {
+	return &UpdateCityUseCase{
+		cityRepository: cityRepository,
+	}
+}
+
+func (uc *UpdateCityUseCase) Execute(ctx context.Context, id string, input dtos.UpdateCityInput) (*entities.City, error) {
+	existing, err := uc.cityRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewCityNotFoundError(id)
+	}
+
+	return uc.cityRepository.Update(ctx, id, entities.UpdateCityData{
+		TimeZone:  input.TimeZone,
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+	})
+}
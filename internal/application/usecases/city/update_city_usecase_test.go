@@ -0,0 +1,78 @@
+package city
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateCity_Success(t *testing.T) {
+	ctx := context.Background()
+	cityID := "city-1"
+	lat, lng := 45.7640, 4.8357
+
+	existing := &entities.City{ID: cityID, RefID: 1, CityName: "Lyon", Zipcode: "69000"}
+	updatedCity := &entities.City{ID: cityID, RefID: 1, CityName: "Lyon", Zipcode: "69000", Latitude: &lat, Longitude: &lng}
+
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	cityRepo.EXPECT().FindByID(mock.Anything, cityID).Return(existing, nil)
+	cityRepo.EXPECT().Update(mock.Anything, cityID, entities.UpdateCityData{
+		Latitude:  &lat,
+		Longitude: &lng,
+	}).Return(updatedCity, nil)
+
+	uc := NewUpdateCityUseCase(cityRepo)
+	result, err := uc.Execute(ctx, cityID, dtos.UpdateCityInput{
+		Latitude:  &lat,
+		Longitude: &lng,
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.True(t, result.Located())
+}
+
+func TestUpdateCity_TimeZone(t *testing.T) {
+	ctx := context.Background()
+	cityID := "city-1"
+	timeZone := "America/Cayenne"
+
+	existing := &entities.City{ID: cityID, RefID: 1, CityName: "Cayenne", Zipcode: "97300", TimeZone: entities.DefaultTimeZone}
+	updatedCity := &entities.City{ID: cityID, RefID: 1, CityName: "Cayenne", Zipcode: "97300", TimeZone: timeZone}
+
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	cityRepo.EXPECT().FindByID(mock.Anything, cityID).Return(existing, nil)
+	cityRepo.EXPECT().Update(mock.Anything, cityID, entities.UpdateCityData{TimeZone: &timeZone}).Return(updatedCity, nil)
+
+	uc := NewUpdateCityUseCase(cityRepo)
+	result, err := uc.Execute(ctx, cityID, dtos.UpdateCityInput{TimeZone: &timeZone})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "America/Cayenne", result.TimeZone)
+}
+
+func TestUpdateCity_NotFound(t *testing.T) {
+	ctx := context.Background()
+	cityID := "city-nonexistent"
+
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	cityRepo.EXPECT().FindByID(mock.Anything, cityID).Return(nil, nil)
+
+	uc := NewUpdateCityUseCase(cityRepo)
+	result, err := uc.Execute(ctx, cityID, dtos.UpdateCityInput{})
+
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	var notFoundErr *domainerrors.CityNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
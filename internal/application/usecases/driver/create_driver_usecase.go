@@ -2,32 +2,42 @@ package driver
 
 import (
 	"context"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
 )
 
 type CreateDriverUseCase struct {
 	driverRepository repositories.DriverRepository
 	userRepository   repositories.UserRepository
 	authRepository   repositories.AuthRepository
+	tokenVersions    repositories.TokenVersionRepository
+	jwtService       services.JwtService
 }
 
 func NewCreateDriverUseCase(
 	driverRepository repositories.DriverRepository,
 	userRepository repositories.UserRepository,
 	authRepository repositories.AuthRepository,
+	tokenVersions repositories.TokenVersionRepository,
+	jwtService services.JwtService,
 ) *CreateDriverUseCase {
 	return &CreateDriverUseCase{
 		driverRepository: driverRepository,
 		userRepository:   userRepository,
 		authRepository:   authRepository,
+		tokenVersions:    tokenVersions,
+		jwtService:       jwtService,
 	}
 }
 
-func (uc *CreateDriverUseCase) Execute(ctx context.Context, userID string, input dtos.CreateDriverInput) (*entities.Driver, error) {
+// Execute registers the user as a driver. Promoting them to DRIVER invalidates their current
+// access token, so a new one in the same session is returned with the driver profile.
+func (uc *CreateDriverUseCase) Execute(ctx context.Context, userID, sessionID string, input dtos.CreateDriverInput) (*dtos.CreateDriverResponse, error) {
 	user, err := uc.userRepository.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -56,6 +66,32 @@ func (uc *CreateDriverUseCase) Execute(ctx context.Context, userID string, input
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.tokenVersions.Invalidate(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	auth, err := uc.authRepository.FindByRefID(ctx, user.AuthRefID)
+	if err != nil {
+		return nil, err
+	}
+	if auth == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+
+	token, err := uc.jwtService.Sign(services.JwtPayload{
+		UserID:        userID,
+		Role:          auth.Role,
+		EmailVerified: auth.IsEmailVerified(),
+		TokenVersion:  auth.TokenVersion,
+		SessionID:     sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return driver, nil
+	return &dtos.CreateDriverResponse{
+		Driver:    driver,
+		Token:     token,
+		ExpiresAt: time.Now().Add(uc.jwtService.AccessTokenTTL()),
+	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/services"
 	"github.com/lgxju/gogretago/internal/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,8 @@ func TestCreateDriver_Success(t *testing.T) {
 	driverRepo := mocks.NewMockDriverRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
 	authRepo := mocks.NewMockAuthRepository(t)
+	tokenVersions := mocks.NewMockTokenVersionRepository(t)
+	jwtSvc := mocks.NewMockJwtService(t)
 
 	user := &entities.PublicUser{
 		User: entities.User{
@@ -45,9 +48,13 @@ func TestCreateDriver_Success(t *testing.T) {
 		UserRefID:     200,
 	}).Return(createdDriver, nil)
 	authRepo.EXPECT().UpdateRole(ctx, int64(100), "DRIVER").Return(nil)
+	tokenVersions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+	authRepo.EXPECT().FindByRefID(ctx, int64(100)).Return(&entities.Auth{RefID: 100, Role: "DRIVER", TokenVersion: 1}, nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "DRIVER", TokenVersion: 1, SessionID: "session-1"}).Return("driver-token", nil)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
 
-	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo)
-	result, err := uc.Execute(ctx, "user-1", dtos.CreateDriverInput{
+	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo, tokenVersions, jwtSvc)
+	result, err := uc.Execute(ctx, "user-1", "session-1", dtos.CreateDriverInput{
 		DriverLicense: "DL-12345",
 	})
 
@@ -55,6 +62,8 @@ func TestCreateDriver_Success(t *testing.T) {
 	assert.Equal(t, "driver-1", result.ID)
 	assert.Equal(t, "DL-12345", result.DriverLicense)
 	assert.Equal(t, int64(200), result.UserRefID)
+	assert.Equal(t, "driver-token", result.Token)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), result.ExpiresAt, time.Minute)
 }
 
 func TestCreateDriver_UserNotFound(t *testing.T) {
@@ -65,8 +74,8 @@ func TestCreateDriver_UserNotFound(t *testing.T) {
 
 	userRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
 
-	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo)
-	result, err := uc.Execute(ctx, "nonexistent", dtos.CreateDriverInput{
+	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo, mocks.NewMockTokenVersionRepository(t), mocks.NewMockJwtService(t))
+	result, err := uc.Execute(ctx, "nonexistent", "session-1", dtos.CreateDriverInput{
 		DriverLicense: "DL-12345",
 	})
 
@@ -103,8 +112,8 @@ func TestCreateDriver_AlreadyExists(t *testing.T) {
 	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
 	driverRepo.EXPECT().FindByUserRefID(ctx, int64(200)).Return(existingDriver, nil)
 
-	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo)
-	result, err := uc.Execute(ctx, "user-1", dtos.CreateDriverInput{
+	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo, mocks.NewMockTokenVersionRepository(t), mocks.NewMockJwtService(t))
+	result, err := uc.Execute(ctx, "user-1", "session-1", dtos.CreateDriverInput{
 		DriverLicense: "DL-12345",
 	})
 
@@ -123,8 +132,8 @@ func TestCreateDriver_RepoError(t *testing.T) {
 	repoErr := errors.New("database error")
 	userRepo.EXPECT().FindByID(ctx, "user-1").Return(nil, repoErr)
 
-	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo)
-	result, err := uc.Execute(ctx, "user-1", dtos.CreateDriverInput{
+	uc := NewCreateDriverUseCase(driverRepo, userRepo, authRepo, mocks.NewMockTokenVersionRepository(t), mocks.NewMockJwtService(t))
+	result, err := uc.Execute(ctx, "user-1", "session-1", dtos.CreateDriverInput{
 		DriverLicense: "DL-12345",
 	})
 
@@ -0,0 +1,44 @@
+package inscription
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ConfirmInscriptionUseCase struct {
+	inscriptionRepository repositories.InscriptionRepository
+}
+
+func NewConfirmInscriptionUseCase(inscriptionRepository repositories.InscriptionRepository) *ConfirmInscriptionUseCase {
+	return &ConfirmInscriptionUseCase{
+		inscriptionRepository: inscriptionRepository,
+	}
+}
+
+// Execute keeps a booking after the driver moved the trip's cities or departure
+func (uc *ConfirmInscriptionUseCase) Execute(ctx context.Context, id, userID string) (*entities.Inscription, error) {
+	existing, err := uc.inscriptionRepository.FindByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewInscriptionNotFoundError(id)
+	}
+	if existing.Status != entities.InscriptionStatusPendingConfirmation {
+		return nil, domainerrors.NewInscriptionNotPendingError(id)
+	}
+
+	// The trip may have been cancelled since, which leaves nothing to confirm
+	confirmed, err := uc.inscriptionRepository.UpdateStatus(ctx, id, entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		return nil, domainerrors.NewInscriptionNotPendingError(id)
+	}
+	existing.Status = entities.InscriptionStatusActive
+	return existing, nil
+}
@@ -0,0 +1,75 @@
+package inscription
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmInscription_Success(t *testing.T) {
+	ctx := context.Background()
+	existing := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20, Status: entities.InscriptionStatusPendingConfirmation}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(existing, nil)
+	inscriptionRepo.EXPECT().UpdateStatus(mock.Anything, "insc-1", entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive).Return(true, nil)
+
+	uc := NewConfirmInscriptionUseCase(inscriptionRepo)
+	result, err := uc.Execute(ctx, "insc-1", "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.InscriptionStatusActive, result.Status)
+}
+
+func TestConfirmInscription_NotPending(t *testing.T) {
+	ctx := context.Background()
+	existing := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20, Status: entities.InscriptionStatusActive}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(existing, nil)
+
+	uc := NewConfirmInscriptionUseCase(inscriptionRepo)
+	result, err := uc.Execute(ctx, "insc-1", "user-1")
+
+	assert.Nil(t, result)
+	var pendingErr *domainerrors.InscriptionNotPendingError
+	assert.True(t, errors.As(err, &pendingErr))
+}
+
+func TestConfirmInscription_CancelledMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	existing := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20, Status: entities.InscriptionStatusPendingConfirmation}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(existing, nil)
+	// The driver cancelled the trip between reading the booking and confirming it
+	inscriptionRepo.EXPECT().UpdateStatus(mock.Anything, "insc-1", entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive).Return(false, nil)
+
+	uc := NewConfirmInscriptionUseCase(inscriptionRepo)
+	result, err := uc.Execute(ctx, "insc-1", "user-1")
+
+	assert.Nil(t, result)
+	var pendingErr *domainerrors.InscriptionNotPendingError
+	assert.True(t, errors.As(err, &pendingErr))
+}
+
+func TestConfirmInscription_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(nil, nil)
+
+	uc := NewConfirmInscriptionUseCase(inscriptionRepo)
+	result, err := uc.Execute(ctx, "insc-1", "user-1")
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.InscriptionNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
@@ -43,6 +43,12 @@ func (uc *CreateInscriptionUseCase) Execute(ctx context.Context, userID string,
 	if trip == nil {
 		return nil, domainerrors.NewTripNotFoundError(input.TripID)
 	}
+	if trip.Status == entities.TripStatusFull {
+		return nil, domainerrors.NewNoSeatsAvailableError(input.TripID)
+	}
+	if !trip.IsBookable() {
+		return nil, domainerrors.NewTripNotBookableError(input.TripID)
+	}
 
 	alreadyInscribed, err := uc.inscriptionRepository.ExistsByUserAndTrip(ctx, user.RefID, trip.RefID)
 	if err != nil {
@@ -52,16 +58,54 @@ func (uc *CreateInscriptionUseCase) Execute(ctx context.Context, userID string,
 		return nil, domainerrors.NewAlreadyInscribedError(userID, input.TripID)
 	}
 
-	count, err := uc.inscriptionRepository.CountByTripRefID(ctx, trip.RefID)
+	from, to, err := bookedSegment(trip, input)
 	if err != nil {
 		return nil, err
 	}
-	if count >= trip.Seats {
+
+	bookings, err := uc.inscriptionRepository.FindByTripID(ctx, trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	if trip.SeatsLeft(bookings, from, to) <= 0 {
 		return nil, domainerrors.NewNoSeatsAvailableError(input.TripID)
 	}
 
-	return uc.inscriptionRepository.Create(ctx, entities.CreateInscriptionData{
-		UserRefID: user.RefID,
-		TripRefID: trip.RefID,
+	// Checked again with the trip locked, which also closes the trip when the last seat is taken
+	inscription, err := uc.inscriptionRepository.Book(ctx, trip, entities.CreateInscriptionData{
+		UserRefID:    user.RefID,
+		TripRefID:    trip.RefID,
+		FromPosition: from,
+		ToPosition:   to,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if inscription == nil {
+		return nil, domainerrors.NewNoSeatsAvailableError(input.TripID)
+	}
+	return inscription, nil
+}
+
+// bookedSegment returns the positions of the stops the passenger rides between, the whole trip by default
+func bookedSegment(trip *entities.Trip, input dtos.CreateInscriptionInput) (int, int, error) {
+	from, to := 0, trip.LastPosition()
+	if input.FromCity != nil {
+		stop := trip.FindStop(*input.FromCity)
+		if stop == nil {
+			return 0, 0, domainerrors.NewInvalidTripSegmentError(input.TripID)
+		}
+		from = stop.Position
+	}
+	if input.ToCity != nil {
+		stop := trip.FindStop(*input.ToCity)
+		if stop == nil {
+			return 0, 0, domainerrors.NewInvalidTripSegmentError(input.TripID)
+		}
+		to = stop.Position
+	}
+	if from >= to {
+		return 0, 0, domainerrors.NewInvalidTripSegmentError(input.TripID)
+	}
+	return from, to, nil
 }
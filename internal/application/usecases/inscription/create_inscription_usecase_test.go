@@ -13,6 +13,15 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// activeBookings returns n bookings for the whole of a trip without intermediate stops
+func activeBookings(n int) []entities.Inscription {
+	bookings := make([]entities.Inscription, n)
+	for i := range bookings {
+		bookings[i] = entities.Inscription{Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1}
+	}
+	return bookings
+}
+
 func TestCreateInscription_Success(t *testing.T) {
 	ctx := context.Background()
 	userID := "user-1"
@@ -22,8 +31,8 @@ func TestCreateInscription_Success(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3}
-	expectedInscription := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
+	expectedInscription := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -32,10 +41,12 @@ func TestCreateInscription_Success(t *testing.T) {
 	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
 	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
-	inscriptionRepo.EXPECT().CountByTripRefID(mock.Anything, int64(20)).Return(2, nil)
-	inscriptionRepo.EXPECT().Create(mock.Anything, entities.CreateInscriptionData{
-		UserRefID: 10,
-		TripRefID: 20,
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(2), nil)
+	inscriptionRepo.EXPECT().Book(mock.Anything, trip, entities.CreateInscriptionData{
+		UserRefID:    10,
+		TripRefID:    20,
+		FromPosition: 0,
+		ToPosition:   1,
 	}).Return(expectedInscription, nil)
 
 	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
@@ -103,7 +114,7 @@ func TestCreateInscription_AlreadyInscribed(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -131,7 +142,7 @@ func TestCreateInscription_NoSeatsAvailable(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -140,7 +151,7 @@ func TestCreateInscription_NoSeatsAvailable(t *testing.T) {
 	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
 	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
-	inscriptionRepo.EXPECT().CountByTripRefID(mock.Anything, int64(20)).Return(3, nil)
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(3), nil)
 
 	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
 	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: tripID})
@@ -151,6 +162,55 @@ func TestCreateInscription_NoSeatsAvailable(t *testing.T) {
 	assert.True(t, errors.As(err, &noSeatsErr))
 }
 
+func TestCreateInscription_TripFull(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	tripID := "trip-1"
+
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusFull}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
+
+	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: tripID})
+
+	assert.Nil(t, result)
+	var noSeatsErr *domainerrors.NoSeatsAvailableError
+	assert.True(t, errors.As(err, &noSeatsErr))
+}
+
+func TestCreateInscription_TripNotBookable(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	tripID := "trip-1"
+
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+
+	for _, status := range []string{entities.TripStatusInProgress, entities.TripStatusCompleted, entities.TripStatusCancelled} {
+		trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: status}
+
+		inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+		userRepo := mocks.NewMockUserRepository(t)
+		tripRepo := mocks.NewMockTripRepository(t)
+
+		userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+		tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
+
+		uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+		result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: tripID})
+
+		assert.Nil(t, result)
+		var notBookableErr *domainerrors.TripNotBookableError
+		assert.True(t, errors.As(err, &notBookableErr), status)
+	}
+}
+
 func TestCreateInscription_ExactlyAtCapacity(t *testing.T) {
 	ctx := context.Background()
 	userID := "user-1"
@@ -160,7 +220,7 @@ func TestCreateInscription_ExactlyAtCapacity(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 5}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 5, Status: entities.TripStatusScheduled}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -169,8 +229,8 @@ func TestCreateInscription_ExactlyAtCapacity(t *testing.T) {
 	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
 	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
-	// count == seats triggers the >= condition
-	inscriptionRepo.EXPECT().CountByTripRefID(mock.Anything, int64(20)).Return(5, nil)
+	// every seat is taken on the only leg
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(5), nil)
 
 	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
 	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: tripID})
@@ -190,8 +250,8 @@ func TestCreateInscription_OneSlotLeft(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3}
-	expectedInscription := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
+	expectedInscription := &entities.Inscription{ID: "insc-1", RefID: 1, UserRefID: 10, TripRefID: 20, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -200,11 +260,13 @@ func TestCreateInscription_OneSlotLeft(t *testing.T) {
 	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
 	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
-	// count=2, seats=3 -> one slot left, should succeed
-	inscriptionRepo.EXPECT().CountByTripRefID(mock.Anything, int64(20)).Return(2, nil)
-	inscriptionRepo.EXPECT().Create(mock.Anything, entities.CreateInscriptionData{
-		UserRefID: 10,
-		TripRefID: 20,
+	// 2 of 3 seats taken -> one slot left, should succeed
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(2), nil)
+	inscriptionRepo.EXPECT().Book(mock.Anything, trip, entities.CreateInscriptionData{
+		UserRefID:    10,
+		TripRefID:    20,
+		FromPosition: 0,
+		ToPosition:   1,
 	}).Return(expectedInscription, nil)
 
 	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
@@ -224,7 +286,7 @@ func TestCreateInscription_RepoError(t *testing.T) {
 		User:  entities.User{ID: userID, RefID: 10},
 		Email: "test@example.com",
 	}
-	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
@@ -233,10 +295,12 @@ func TestCreateInscription_RepoError(t *testing.T) {
 	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
 	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
-	inscriptionRepo.EXPECT().CountByTripRefID(mock.Anything, int64(20)).Return(0, nil)
-	inscriptionRepo.EXPECT().Create(mock.Anything, entities.CreateInscriptionData{
-		UserRefID: 10,
-		TripRefID: 20,
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(0), nil)
+	inscriptionRepo.EXPECT().Book(mock.Anything, trip, entities.CreateInscriptionData{
+		UserRefID:    10,
+		TripRefID:    20,
+		FromPosition: 0,
+		ToPosition:   1,
 	}).Return(nil, errors.New("database error"))
 
 	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
@@ -246,3 +310,134 @@ func TestCreateInscription_RepoError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "database error", err.Error())
 }
+
+func TestCreateInscription_SeatTakenMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	tripID := "trip-1"
+
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+	trip := &entities.Trip{ID: tripID, RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+	tripRepo.EXPECT().FindByID(mock.Anything, tripID).Return(trip, nil)
+	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, tripID).Return(activeBookings(2), nil)
+	// Another passenger booked the last seat once the trip was locked
+	inscriptionRepo.EXPECT().Book(mock.Anything, trip, mock.Anything).Return(nil, nil)
+
+	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: tripID})
+
+	assert.Nil(t, result)
+	var noSeatsErr *domainerrors.NoSeatsAvailableError
+	assert.True(t, errors.As(err, &noSeatsErr))
+}
+
+// Paris (0) -> Dijon (1) -> Macon (2) -> Lyon (3)
+func multiStopTrip(seats int) *entities.Trip {
+	return &entities.Trip{ID: "trip-1", RefID: 20, Seats: seats, Status: entities.TripStatusScheduled, Stops: []entities.TripStop{
+		{CityName: "Paris", Position: 0, Type: entities.TripStopDeparture},
+		{CityName: "Dijon", Position: 1, Type: entities.TripStopWaypoint},
+		{CityName: "Macon", Position: 2, Type: entities.TripStopWaypoint},
+		{CityName: "Lyon", Position: 3, Type: entities.TripStopArrival},
+	}}
+}
+
+func TestCreateInscription_Segment(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+	from, to := "Dijon", "Lyon"
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+	trip := multiStopTrip(1)
+	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(trip, nil)
+	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
+	// The only seat is taken from Paris to Dijon, so it is free from Dijon on
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{
+		{Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+	}, nil)
+	inscriptionRepo.EXPECT().Book(mock.Anything, trip, entities.CreateInscriptionData{
+		UserRefID:    10,
+		TripRefID:    20,
+		FromPosition: 1,
+		ToPosition:   3,
+	}).Return(&entities.Inscription{ID: "insc-1", Status: entities.InscriptionStatusActive, FromPosition: 1, ToPosition: 3}, nil)
+
+	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: "trip-1", FromCity: &from, ToCity: &to})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.FromPosition)
+	assert.Equal(t, 3, result.ToPosition)
+}
+
+func TestCreateInscription_SegmentLegFull(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+	from, to := "Paris", "Macon"
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(multiStopTrip(1), nil)
+	inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
+	// Dijon -> Macon is taken, and the requested segment rides it
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{
+		{Status: entities.InscriptionStatusActive, FromPosition: 1, ToPosition: 2},
+	}, nil)
+
+	uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+	result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: "trip-1", FromCity: &from, ToCity: &to})
+
+	assert.Nil(t, result)
+	var noSeatsErr *domainerrors.NoSeatsAvailableError
+	assert.True(t, errors.As(err, &noSeatsErr))
+}
+
+func TestCreateInscription_InvalidSegment(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	user := &entities.PublicUser{User: entities.User{ID: userID, RefID: 10}}
+	lyon, paris, marseille := "Lyon", "Paris", "Marseille"
+
+	tests := []struct {
+		name     string
+		from, to *string
+	}{
+		{"backwards", &lyon, &paris},
+		{"unknown city", &marseille, nil},
+		{"arrival as boarding stop", &lyon, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+			userRepo := mocks.NewMockUserRepository(t)
+			tripRepo := mocks.NewMockTripRepository(t)
+
+			userRepo.EXPECT().FindByID(mock.Anything, userID).Return(user, nil)
+			tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(multiStopTrip(3), nil)
+			inscriptionRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(10), int64(20)).Return(false, nil)
+
+			uc := NewCreateInscriptionUseCase(inscriptionRepo, userRepo, tripRepo)
+			result, err := uc.Execute(ctx, userID, dtos.CreateInscriptionInput{TripID: "trip-1", FromCity: tt.from, ToCity: tt.to})
+
+			assert.Nil(t, result)
+			var segmentErr *domainerrors.InvalidTripSegmentError
+			assert.True(t, errors.As(err, &segmentErr))
+		})
+	}
+}
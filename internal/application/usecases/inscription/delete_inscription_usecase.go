@@ -9,11 +9,16 @@ import (
 
 type DeleteInscriptionUseCase struct {
 	inscriptionRepository repositories.InscriptionRepository
+	tripRepository        repositories.TripRepository
 }
 
-func NewDeleteInscriptionUseCase(inscriptionRepository repositories.InscriptionRepository) *DeleteInscriptionUseCase {
+func NewDeleteInscriptionUseCase(
+	inscriptionRepository repositories.InscriptionRepository,
+	tripRepository repositories.TripRepository,
+) *DeleteInscriptionUseCase {
 	return &DeleteInscriptionUseCase{
 		inscriptionRepository: inscriptionRepository,
+		tripRepository:        tripRepository,
 	}
 }
 
@@ -26,5 +31,23 @@ func (uc *DeleteInscriptionUseCase) Execute(ctx context.Context, id, userID stri
 		return domainerrors.NewInscriptionNotFoundError(id)
 	}
 
-	return uc.inscriptionRepository.Delete(ctx, id)
+	if err := uc.inscriptionRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// A seat freed on a full trip opens it to bookings again
+	trip, err := uc.tripRepository.FindByRefID(ctx, existing.TripRefID)
+	if err != nil || trip == nil {
+		return err
+	}
+	bookings, err := uc.inscriptionRepository.FindByTripID(ctx, trip.ID)
+	if err != nil {
+		return err
+	}
+	// Left alone when the trip moved on meanwhile, for instance when its driver started it
+	if next := trip.StatusForBookings(bookings); next != trip.Status {
+		_, err := uc.tripRepository.UpdateStatus(ctx, trip.ID, trip.Status, next)
+		return err
+	}
+	return nil
 }
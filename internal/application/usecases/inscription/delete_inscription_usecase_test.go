@@ -20,11 +20,41 @@ func TestDeleteInscription_Success(t *testing.T) {
 	existing := &entities.Inscription{ID: inscriptionID, RefID: 1, UserRefID: 10, TripRefID: 20}
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
 
 	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, inscriptionID, userID).Return(existing, nil)
 	inscriptionRepo.EXPECT().Delete(mock.Anything, inscriptionID).Return(nil)
+	tripRepo.EXPECT().FindByRefID(mock.Anything, int64(20)).Return(&entities.Trip{ID: "trip-1", RefID: 20, Seats: 3, Status: entities.TripStatusScheduled}, nil)
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{
+		{Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+	}, nil)
 
-	uc := NewDeleteInscriptionUseCase(inscriptionRepo)
+	uc := NewDeleteInscriptionUseCase(inscriptionRepo, tripRepo)
+	err := uc.Execute(ctx, inscriptionID, userID)
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteInscription_ReopensFullTrip(t *testing.T) {
+	ctx := context.Background()
+	inscriptionID := "insc-1"
+	userID := "user-1"
+
+	existing := &entities.Inscription{ID: inscriptionID, RefID: 1, UserRefID: 10, TripRefID: 20}
+
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, inscriptionID, userID).Return(existing, nil)
+	inscriptionRepo.EXPECT().Delete(mock.Anything, inscriptionID).Return(nil)
+	tripRepo.EXPECT().FindByRefID(mock.Anything, int64(20)).Return(&entities.Trip{ID: "trip-1", RefID: 20, Seats: 3, Status: entities.TripStatusFull}, nil)
+	inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{
+		{Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+		{Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+	}, nil)
+	tripRepo.EXPECT().UpdateStatus(mock.Anything, "trip-1", entities.TripStatusFull, entities.TripStatusScheduled).Return(true, nil)
+
+	uc := NewDeleteInscriptionUseCase(inscriptionRepo, tripRepo)
 	err := uc.Execute(ctx, inscriptionID, userID)
 
 	assert.NoError(t, err)
@@ -36,10 +66,11 @@ func TestDeleteInscription_NotFoundOrNotOwner(t *testing.T) {
 	userID := "user-1"
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
 
 	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, inscriptionID, userID).Return(nil, nil)
 
-	uc := NewDeleteInscriptionUseCase(inscriptionRepo)
+	uc := NewDeleteInscriptionUseCase(inscriptionRepo, tripRepo)
 	err := uc.Execute(ctx, inscriptionID, userID)
 
 	assert.Error(t, err)
@@ -53,10 +84,11 @@ func TestDeleteInscription_RepoError(t *testing.T) {
 	userID := "user-1"
 
 	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+	tripRepo := mocks.NewMockTripRepository(t)
 
 	inscriptionRepo.EXPECT().FindByIDAndUserID(mock.Anything, inscriptionID, userID).Return(nil, errors.New("database error"))
 
-	uc := NewDeleteInscriptionUseCase(inscriptionRepo)
+	uc := NewDeleteInscriptionUseCase(inscriptionRepo, tripRepo)
 	err := uc.Execute(ctx, inscriptionID, userID)
 
 	assert.Error(t, err)
@@ -0,0 +1,106 @@
+package trip
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type CancelTripUseCase struct {
+	tripRepository        repositories.TripRepository
+	driverRepository      repositories.DriverRepository
+	inscriptionRepository repositories.InscriptionRepository
+	userRepository        repositories.UserRepository
+	emailService          services.EmailService
+}
+
+func NewCancelTripUseCase(
+	tripRepository repositories.TripRepository,
+	driverRepository repositories.DriverRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+) *CancelTripUseCase {
+	return &CancelTripUseCase{
+		tripRepository:        tripRepository,
+		driverRepository:      driverRepository,
+		inscriptionRepository: inscriptionRepository,
+		userRepository:        userRepository,
+		emailService:          emailService,
+	}
+}
+
+// Execute cancels one of the driver's trips, cancels its bookings and emails every booked passenger
+func (uc *CancelTripUseCase) Execute(ctx context.Context, id, userID string, input dtos.CancelTripInput) (*entities.Trip, error) {
+	existing, err := uc.tripRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewTripNotFoundError(id)
+	}
+
+	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, domainerrors.NewDriverNotFoundError(userID)
+	}
+	if err := authorization.EnsureDriverOwnsTrip(driver, existing); err != nil {
+		return nil, err
+	}
+	if !existing.CanTransitionTo(entities.TripStatusCancelled) {
+		return nil, domainerrors.NewInvalidTripTransitionError(id, existing.Status, entities.TripStatusCancelled)
+	}
+
+	cancelled, err := uc.tripRepository.Cancel(ctx, id, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		// The trip left or was cancelled since it was read
+		current, err := uc.tripRepository.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, domainerrors.NewTripNotFoundError(id)
+		}
+		return nil, domainerrors.NewInvalidTripTransitionError(id, current.Status, entities.TripStatusCancelled)
+	}
+
+	// Read once cancelled, so passengers who booked in the meantime are told as well
+	inscriptions, err := uc.inscriptionRepository.FindByTripID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, inscription := range inscriptions {
+		if inscription.Status == entities.InscriptionStatusCancelledByDriver {
+			uc.notifyPassenger(ctx, inscription.UserRefID, existing, input.Reason)
+		}
+	}
+
+	existing.Status = entities.TripStatusCancelled
+	existing.CancellationReason = &input.Reason
+	return existing, nil
+}
+
+// notifyPassenger emails a passenger about the cancellation. The trip is already cancelled, so a
+// passenger who cannot be reached does not fail the request.
+func (uc *CancelTripUseCase) notifyPassenger(ctx context.Context, userRefID int64, trip *entities.Trip, reason string) {
+	user, err := uc.userRepository.FindByRefID(ctx, userRefID)
+	if err != nil || user == nil || user.AnonymizedAt != nil {
+		return
+	}
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+	_ = uc.emailService.SendTripCancelledEmail(user.Email, firstName, trip.LocalDateTrip, reason)
+}
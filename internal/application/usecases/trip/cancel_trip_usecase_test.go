@@ -0,0 +1,131 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cancelTripDeps struct {
+	tripRepo        *mocks.MockTripRepository
+	driverRepo      *mocks.MockDriverRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+	uc              *CancelTripUseCase
+}
+
+func setupCancelTrip(t *testing.T) cancelTripDeps {
+	d := cancelTripDeps{
+		tripRepo:        mocks.NewMockTripRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
+	d.uc = NewCancelTripUseCase(d.tripRepo, d.driverRepo, d.inscriptionRepo, d.userRepo, d.emailService)
+	return d
+}
+
+func TestCancelTrip_NotifiesActivePassengers(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelTrip(t)
+
+	dateTrip := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, DateTrip: dateTrip, LocalDateTrip: dateTrip, Seats: 3, DriverRefID: 300, Status: entities.TripStatusFull}
+	firstName := "Alice"
+	anonymizedAt := time.Now()
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-1", "Car broke down").Return(true, nil)
+	// Bookings are read once the trip is cancelled, along with the one taken meanwhile
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", UserRefID: 10, TripRefID: 500, Status: entities.InscriptionStatusCancelledByDriver},
+		{ID: "insc-2", UserRefID: 11, TripRefID: 500, Status: entities.InscriptionStatusCancelledByDriver},
+	}, nil)
+	d.userRepo.EXPECT().FindByRefID(ctx, int64(10)).Return(&entities.PublicUser{
+		User: entities.User{RefID: 10, FirstName: &firstName}, Email: "alice@example.com",
+	}, nil)
+	d.userRepo.EXPECT().FindByRefID(ctx, int64(11)).Return(&entities.PublicUser{
+		User: entities.User{RefID: 11, AnonymizedAt: &anonymizedAt}, Email: "anonymized@example.com",
+	}, nil)
+	d.emailService.EXPECT().SendTripCancelledEmail("alice@example.com", "Alice", dateTrip, "Car broke down").Return(errors.New("smtp down"))
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", dtos.CancelTripInput{Reason: "Car broke down"})
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusCancelled, result.Status)
+	require.NotNil(t, result.CancellationReason)
+	assert.Equal(t, "Car broke down", *result.CancellationReason)
+}
+
+func TestCancelTrip_AlreadyStarted(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelTrip(t)
+
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300, Status: entities.TripStatusInProgress}
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", dtos.CancelTripInput{Reason: "Too late"})
+
+	assert.Nil(t, result)
+	var transitionErr *domainerrors.InvalidTripTransitionError
+	assert.True(t, errors.As(err, &transitionErr))
+}
+
+func TestCancelTrip_StartedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelTrip(t)
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300, Status: entities.TripStatusScheduled}, nil).Once()
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-1", "Too late").Return(false, nil)
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300, Status: entities.TripStatusInProgress}, nil).Once()
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", dtos.CancelTripInput{Reason: "Too late"})
+
+	assert.Nil(t, result)
+	var transitionErr *domainerrors.InvalidTripTransitionError
+	require.ErrorAs(t, err, &transitionErr)
+	assert.Contains(t, transitionErr.Message, entities.TripStatusInProgress)
+}
+
+func TestCancelTrip_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelTrip(t)
+
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 999, Status: entities.TripStatusScheduled}
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", dtos.CancelTripInput{Reason: "Not mine"})
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
+func TestCancelTrip_TripNotFound(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelTrip(t)
+
+	d.tripRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, "nonexistent", "user-1", dtos.CancelTripInput{Reason: "Gone"})
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.TripNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
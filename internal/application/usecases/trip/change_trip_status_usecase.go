@@ -0,0 +1,77 @@
+package trip
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// statusChangeAttempts bounds how often a status change is retried when the trip's status keeps changing
+const statusChangeAttempts = 3
+
+type ChangeTripStatusUseCase struct {
+	tripRepository   repositories.TripRepository
+	driverRepository repositories.DriverRepository
+}
+
+func NewChangeTripStatusUseCase(
+	tripRepository repositories.TripRepository,
+	driverRepository repositories.DriverRepository,
+) *ChangeTripStatusUseCase {
+	return &ChangeTripStatusUseCase{
+		tripRepository:   tripRepository,
+		driverRepository: driverRepository,
+	}
+}
+
+// Execute moves one of the driver's trips to the given status when its lifecycle allows it
+func (uc *ChangeTripStatusUseCase) Execute(ctx context.Context, id, userID, status string) (*entities.Trip, error) {
+	existing, err := uc.tripRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewTripNotFoundError(id)
+	}
+
+	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, domainerrors.NewDriverNotFoundError(userID)
+	}
+	if err := authorization.EnsureDriverOwnsTrip(driver, existing); err != nil {
+		return nil, err
+	}
+
+	// The status may change between reading and writing it, for instance when a booking takes the
+	// last seat, so the transition is checked again against the new status
+	for attempt := 1; ; attempt++ {
+		if !existing.CanTransitionTo(status) {
+			return nil, domainerrors.NewInvalidTripTransitionError(id, existing.Status, status)
+		}
+		moved, err := uc.tripRepository.UpdateStatus(ctx, id, existing.Status, status)
+		if err != nil {
+			return nil, err
+		}
+		if moved {
+			break
+		}
+		if attempt == statusChangeAttempts {
+			return nil, domainerrors.NewInvalidTripTransitionError(id, existing.Status, status)
+		}
+		if existing, err = uc.tripRepository.FindByID(ctx, id); err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, domainerrors.NewTripNotFoundError(id)
+		}
+	}
+
+	existing.Status = status
+	return existing, nil
+}
@@ -0,0 +1,119 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeTripStatus_Start(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, Seats: 3, DriverRefID: 300, Status: entities.TripStatusFull}
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	tripRepo.EXPECT().UpdateStatus(ctx, "trip-1", entities.TripStatusFull, entities.TripStatusInProgress).Return(true, nil)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-1", entities.TripStatusInProgress)
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusInProgress, result.Status)
+}
+
+func TestChangeTripStatus_StatusChangedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", DriverRefID: 300, Status: entities.TripStatusScheduled}, nil).Once()
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	tripRepo.EXPECT().UpdateStatus(ctx, "trip-1", entities.TripStatusScheduled, entities.TripStatusInProgress).Return(false, nil)
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", DriverRefID: 300, Status: entities.TripStatusFull}, nil).Once()
+	tripRepo.EXPECT().UpdateStatus(ctx, "trip-1", entities.TripStatusFull, entities.TripStatusInProgress).Return(true, nil)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-1", entities.TripStatusInProgress)
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusInProgress, result.Status)
+}
+
+func TestChangeTripStatus_StatusKeepsChanging(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").RunAndReturn(func(context.Context, string) (*entities.Trip, error) {
+		return &entities.Trip{ID: "trip-1", DriverRefID: 300, Status: entities.TripStatusScheduled}, nil
+	}).Times(statusChangeAttempts)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	tripRepo.EXPECT().UpdateStatus(ctx, "trip-1", entities.TripStatusScheduled, entities.TripStatusCancelled).Return(false, nil).Times(statusChangeAttempts)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-1", entities.TripStatusCancelled)
+
+	assert.Nil(t, result)
+	var transitionErr *domainerrors.InvalidTripTransitionError
+	assert.True(t, errors.As(err, &transitionErr))
+}
+
+func TestChangeTripStatus_IllegalTransition(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300, Status: entities.TripStatusCompleted}
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-1", entities.TripStatusCancelled)
+
+	assert.Nil(t, result)
+	var transitionErr *domainerrors.InvalidTripTransitionError
+	assert.True(t, errors.As(err, &transitionErr))
+}
+
+func TestChangeTripStatus_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 999, Status: entities.TripStatusScheduled}
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-1", entities.TripStatusInProgress)
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
+func TestChangeTripStatus_TripNotFound(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+
+	tripRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	uc := NewChangeTripStatusUseCase(tripRepo, driverRepo)
+	result, err := uc.Execute(ctx, "nonexistent", "user-1", entities.TripStatusInProgress)
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.TripNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
@@ -15,6 +16,7 @@ type CreateTripUseCase struct {
 	driverRepository repositories.DriverRepository
 	carRepository    repositories.CarRepository
 	cityRepository   repositories.CityRepository
+	pricing          entities.PricingRules
 }
 
 func NewCreateTripUseCase(
@@ -22,12 +24,14 @@ func NewCreateTripUseCase(
 	driverRepository repositories.DriverRepository,
 	carRepository repositories.CarRepository,
 	cityRepository repositories.CityRepository,
+	pricing entities.PricingRules,
 ) *CreateTripUseCase {
 	return &CreateTripUseCase{
 		tripRepository:   tripRepository,
 		driverRepository: driverRepository,
 		carRepository:    carRepository,
 		cityRepository:   cityRepository,
+		pricing:          pricing,
 	}
 }
 
@@ -47,34 +51,107 @@ func (uc *CreateTripUseCase) Execute(ctx context.Context, userID string, input d
 	if car == nil {
 		return nil, domainerrors.NewCarNotFoundError(input.CarID)
 	}
-
-	dateTrip, err := time.Parse("2006-01-02", input.Date)
-	if err != nil {
+	if err := authorization.EnsureDriverOwnsCar(driver, car); err != nil {
 		return nil, err
 	}
 
-	departureCity, err := uc.findOrCreateCity(ctx, input.DepartureCity)
-	if err != nil {
+	// Drivers share the cost of the trip, they do not make a profit on it
+	quote := uc.pricing.Quote(input.Kms, input.Seats, car.FuelConsumption)
+	price := quote.SuggestedPrice
+	if input.PricePerSeat != nil {
+		if !quote.Allows(*input.PricePerSeat) {
+			return nil, domainerrors.NewPriceAboveCapError(entities.FormatPrice(quote.MaxPrice, quote.Currency))
+		}
+		price = *input.PricePerSeat
+	}
+
+	// The route goes from the departure through each stop to the arrival
+	names := []string{input.DepartureCity}
+	times := []*time.Time{nil}
+	for i := range input.Stops {
+		names = append(names, input.Stops[i].City)
+		times = append(times, &input.Stops[i].Time)
+	}
+	names = append(names, input.ArrivalCity)
+	times = append(times, nil)
+
+	if err := validateCities(names); err != nil {
 		return nil, err
 	}
 
-	arrivalCity, err := uc.findOrCreateCity(ctx, input.ArrivalCity)
+	cities := make([]*entities.City, len(names))
+	stops := make([]entities.CreateTripStopData, len(names))
+	for i, name := range names {
+		city, err := findOrCreateCity(ctx, uc.cityRepository, name)
+		if err != nil {
+			return nil, err
+		}
+		cities[i] = city
+		stops[i] = entities.CreateTripStopData{CityRefID: city.RefID, Time: times[i]}
+	}
+
+	// The trip keeps the time zone of its departure city
+	timeZone := cities[0].TimeZone
+	dateTrip, err := entities.ParseDepartureTime(input.Date, timeZone)
 	if err != nil {
+		return nil, domainerrors.NewInvalidTripDateError(input.Date)
+	}
+
+	arrival := entities.EstimateArrival(dateTrip, input.Kms)
+	if input.ArrivalTime != nil {
+		arrival = *input.ArrivalTime
+	}
+	if err := validateTimes(input.Stops, dateTrip, arrival); err != nil {
 		return nil, err
 	}
 
 	return uc.tripRepository.Create(ctx, entities.CreateTripData{
-		DateTrip:    dateTrip,
-		Kms:         input.Kms,
-		Seats:       input.Seats,
-		DriverRefID: driver.RefID,
-		CarRefID:    car.RefID,
-		CityRefIDs:  []int64{departureCity.RefID, arrivalCity.RefID},
+		DateTrip:         dateTrip.UTC(),
+		EstimatedArrival: arrival.UTC(),
+		TimeZone:         timeZone,
+		Kms:              input.Kms,
+		Seats:            input.Seats,
+		PricePerSeat:     price,
+		Currency:         quote.Currency,
+		DriverRefID:      driver.RefID,
+		CarRefID:         car.RefID,
+		Stops:            stops,
 	})
 }
 
-func (uc *CreateTripUseCase) findOrCreateCity(ctx context.Context, cityName string) (*entities.City, error) {
-	city, err := uc.cityRepository.FindByCityName(ctx, cityName)
+// validateCities refuses routes that visit a city twice
+func validateCities(names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return domainerrors.NewInvalidTripStopsError("each city can only appear once on the route")
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validateTimes refuses stop times that do not follow the route from departure to arrival
+func validateTimes(stops []dtos.TripStopInput, departure, arrival time.Time) error {
+	if !arrival.After(departure) {
+		return domainerrors.NewInvalidTripDateError("the arrival must follow the departure")
+	}
+	previous := departure
+	for _, stop := range stops {
+		if stop.Time.Before(previous) {
+			return domainerrors.NewInvalidTripStopsError("stop times must follow the route and the trip date")
+		}
+		previous = stop.Time
+	}
+	if arrival.Before(previous) {
+		return domainerrors.NewInvalidTripStopsError("stop times must come before the arrival time")
+	}
+	return nil
+}
+
+// findOrCreateCity returns the named city, adding it in the default time zone when it is new
+func findOrCreateCity(ctx context.Context, cityRepository repositories.CityRepository, cityName string) (*entities.City, error) {
+	city, err := cityRepository.FindByCityName(ctx, cityName)
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +159,9 @@ func (uc *CreateTripUseCase) findOrCreateCity(ctx context.Context, cityName stri
 		return city, nil
 	}
 
-	return uc.cityRepository.Create(ctx, entities.CreateCityData{
+	return cityRepository.Create(ctx, entities.CreateCityData{
 		CityName: cityName,
 		Zipcode:  "",
+		TimeZone: entities.DefaultTimeZone,
 	})
 }
@@ -15,6 +15,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testPricing charges 20 cents per km for cars of unknown consumption
+var testPricing = entities.PricingRules{Currency: "EUR", CostPerKm: 20, FuelPricePerLitre: 185, WearPerKm: 8}
+
 func TestCreateTrip_Success(t *testing.T) {
 	ctx := context.Background()
 	tripRepo := mocks.NewMockTripRepository(t)
@@ -31,6 +34,7 @@ func TestCreateTrip_Success(t *testing.T) {
 	car := &entities.Car{
 		ID:           "car-1",
 		RefID:        400,
+		DriverRefID:  300,
 		LicensePlate: "ABC-123",
 	}
 	departureCity := &entities.City{
@@ -46,7 +50,7 @@ func TestCreateTrip_Success(t *testing.T) {
 		Zipcode:  "69000",
 	}
 
-	dateTrip, _ := time.Parse("2006-01-02", "2026-06-15")
+	dateTrip, _ := time.Parse("2006-01-02T15:04", "2026-06-15T08:00")
 	createdTrip := &entities.Trip{
 		ID:          "trip-1",
 		RefID:       500,
@@ -62,18 +66,21 @@ func TestCreateTrip_Success(t *testing.T) {
 	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(departureCity, nil)
 	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(arrivalCity, nil)
 	tripRepo.EXPECT().Create(ctx, entities.CreateTripData{
-		DateTrip:    dateTrip,
-		Kms:         450,
-		Seats:       3,
-		DriverRefID: 300,
-		CarRefID:    400,
-		CityRefIDs:  []int64{10, 20},
+		DateTrip:         dateTrip,
+		EstimatedArrival: entities.EstimateArrival(dateTrip, 450),
+		Kms:              450,
+		Seats:            3,
+		PricePerSeat:     2250,
+		Currency:         "EUR",
+		DriverRefID:      300,
+		CarRefID:         400,
+		Stops:            []entities.CreateTripStopData{{CityRefID: 10}, {CityRefID: 20}},
 	}).Return(createdTrip, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -96,10 +103,10 @@ func TestCreateTrip_DriverNotFound(t *testing.T) {
 
 	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(nil, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -129,10 +136,10 @@ func TestCreateTrip_CarNotFound(t *testing.T) {
 	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(driver, nil)
 	carRepo.EXPECT().FindByID(ctx, "car-1").Return(nil, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -145,6 +152,40 @@ func TestCreateTrip_CarNotFound(t *testing.T) {
 	assert.True(t, errors.As(err, &notFoundErr))
 }
 
+func TestCreateTrip_CarNotOwned(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	driver := &entities.Driver{
+		ID:            "driver-1",
+		RefID:         300,
+		DriverLicense: "DL-12345",
+		UserRefID:     200,
+	}
+	car := &entities.Car{ID: "car-1", RefID: 500, DriverRefID: 301}
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(driver, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(car, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+	})
+
+	assert.Nil(t, result)
+	require.Error(t, err)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
 func TestCreateTrip_InvalidDateFormat(t *testing.T) {
 	ctx := context.Background()
 	tripRepo := mocks.NewMockTripRepository(t)
@@ -161,16 +202,19 @@ func TestCreateTrip_InvalidDateFormat(t *testing.T) {
 	car := &entities.Car{
 		ID:           "car-1",
 		RefID:        400,
+		DriverRefID:  300,
 		LicensePlate: "ABC-123",
 	}
 
 	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(driver, nil)
 	carRepo.EXPECT().FindByID(ctx, "car-1").Return(car, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(&entities.City{RefID: 10, CityName: "Paris"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon"}, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "not-a-date",
+		Date:          "2026-06-15",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -178,7 +222,8 @@ func TestCreateTrip_InvalidDateFormat(t *testing.T) {
 	})
 
 	assert.Nil(t, result)
-	require.Error(t, err)
+	var dateErr *domainerrors.InvalidTripDateError
+	assert.True(t, errors.As(err, &dateErr), "a day without a departure time is refused")
 }
 
 func TestCreateTrip_FindsExistingCity(t *testing.T) {
@@ -197,6 +242,7 @@ func TestCreateTrip_FindsExistingCity(t *testing.T) {
 	car := &entities.Car{
 		ID:           "car-1",
 		RefID:        400,
+		DriverRefID:  300,
 		LicensePlate: "ABC-123",
 	}
 	existingDeparture := &entities.City{
@@ -212,7 +258,7 @@ func TestCreateTrip_FindsExistingCity(t *testing.T) {
 		Zipcode:  "69000",
 	}
 
-	dateTrip, _ := time.Parse("2006-01-02", "2026-06-15")
+	dateTrip, _ := time.Parse("2006-01-02T15:04", "2026-06-15T08:00")
 	createdTrip := &entities.Trip{
 		ID:          "trip-1",
 		RefID:       500,
@@ -229,18 +275,21 @@ func TestCreateTrip_FindsExistingCity(t *testing.T) {
 	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(existingDeparture, nil)
 	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(existingArrival, nil)
 	tripRepo.EXPECT().Create(ctx, entities.CreateTripData{
-		DateTrip:    dateTrip,
-		Kms:         450,
-		Seats:       3,
-		DriverRefID: 300,
-		CarRefID:    400,
-		CityRefIDs:  []int64{10, 20},
+		DateTrip:         dateTrip,
+		EstimatedArrival: entities.EstimateArrival(dateTrip, 450),
+		Kms:              450,
+		Seats:            3,
+		PricePerSeat:     2250,
+		Currency:         "EUR",
+		DriverRefID:      300,
+		CarRefID:         400,
+		Stops:            []entities.CreateTripStopData{{CityRefID: 10}, {CityRefID: 20}},
 	}).Return(createdTrip, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -267,6 +316,7 @@ func TestCreateTrip_CreatesNewCity(t *testing.T) {
 	car := &entities.Car{
 		ID:           "car-1",
 		RefID:        400,
+		DriverRefID:  300,
 		LicensePlate: "ABC-123",
 	}
 	newDeparture := &entities.City{
@@ -282,7 +332,7 @@ func TestCreateTrip_CreatesNewCity(t *testing.T) {
 		Zipcode:  "69000",
 	}
 
-	dateTrip, _ := time.Parse("2006-01-02", "2026-06-15")
+	dateTrip, _ := time.Parse("2006-01-02T15:04", "2026-06-15T08:00")
 	createdTrip := &entities.Trip{
 		ID:          "trip-1",
 		RefID:       500,
@@ -300,21 +350,25 @@ func TestCreateTrip_CreatesNewCity(t *testing.T) {
 	cityRepo.EXPECT().Create(ctx, entities.CreateCityData{
 		CityName: "Marseille",
 		Zipcode:  "",
+		TimeZone: entities.DefaultTimeZone,
 	}).Return(newDeparture, nil)
 	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(existingArrival, nil)
 	tripRepo.EXPECT().Create(ctx, entities.CreateTripData{
-		DateTrip:    dateTrip,
-		Kms:         350,
-		Seats:       2,
-		DriverRefID: 300,
-		CarRefID:    400,
-		CityRefIDs:  []int64{30, 20},
+		DateTrip:         dateTrip,
+		EstimatedArrival: entities.EstimateArrival(dateTrip, 350),
+		Kms:              350,
+		Seats:            2,
+		PricePerSeat:     2333,
+		Currency:         "EUR",
+		DriverRefID:      300,
+		CarRefID:         400,
+		Stops:            []entities.CreateTripStopData{{CityRefID: 30}, {CityRefID: 20}},
 	}).Return(createdTrip, nil)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           350,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Marseille",
 		ArrivalCity:   "Lyon",
 		Seats:         2,
@@ -336,10 +390,10 @@ func TestCreateTrip_RepoError(t *testing.T) {
 	repoErr := errors.New("database error")
 	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(nil, repoErr)
 
-	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
 	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
 		Kms:           450,
-		Date:          "2026-06-15",
+		Date:          "2026-06-15T08:00",
 		DepartureCity: "Paris",
 		ArrivalCity:   "Lyon",
 		Seats:         3,
@@ -353,3 +407,237 @@ func TestCreateTrip_RepoError(t *testing.T) {
 
 // Ensure mock import is used
 var _ mock.TestingT = (*testing.T)(nil)
+
+func TestCreateTrip_WithStops(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	dateTrip, _ := time.Parse("2006-01-02T15:04", "2026-06-15T08:00")
+	dijonTime := time.Date(2026, 6, 15, 10, 30, 0, 0, time.UTC)
+	maconTime := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(&entities.City{RefID: 10, CityName: "Paris"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Dijon").Return(&entities.City{RefID: 11, CityName: "Dijon"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Macon").Return(&entities.City{RefID: 12, CityName: "Macon"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon"}, nil)
+	tripRepo.EXPECT().Create(ctx, entities.CreateTripData{
+		DateTrip:         dateTrip,
+		EstimatedArrival: entities.EstimateArrival(dateTrip, 450),
+		Kms:              450,
+		Seats:            3,
+		PricePerSeat:     2250,
+		Currency:         "EUR",
+		DriverRefID:      300,
+		CarRefID:         400,
+		Stops: []entities.CreateTripStopData{
+			{CityRefID: 10},
+			{CityRefID: 11, Time: &dijonTime},
+			{CityRefID: 12, Time: &maconTime},
+			{CityRefID: 20},
+		},
+	}).Return(&entities.Trip{ID: "trip-1", RefID: 500}, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+		Stops: []dtos.TripStopInput{
+			{City: "Dijon", Time: dijonTime},
+			{City: "Macon", Time: maconTime},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip-1", result.ID)
+}
+
+func TestCreateTrip_InvalidStops(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		stops []dtos.TripStopInput
+	}{
+		{"city visited twice", []dtos.TripStopInput{{City: "Paris", Time: time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)}}},
+		{"stop before the trip date", []dtos.TripStopInput{{City: "Dijon", Time: time.Date(2026, 6, 14, 10, 0, 0, 0, time.UTC)}}},
+		{"stop times out of order", []dtos.TripStopInput{
+			{City: "Dijon", Time: time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)},
+			{City: "Macon", Time: time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)},
+		}},
+		{"stop after the estimated arrival", []dtos.TripStopInput{{City: "Dijon", Time: time.Date(2026, 6, 15, 20, 0, 0, 0, time.UTC)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tripRepo := mocks.NewMockTripRepository(t)
+			driverRepo := mocks.NewMockDriverRepository(t)
+			carRepo := mocks.NewMockCarRepository(t)
+			cityRepo := mocks.NewMockCityRepository(t)
+
+			driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+			carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300}, nil)
+			cityRepo.EXPECT().FindByCityName(ctx, mock.Anything).Return(&entities.City{RefID: 10}, nil).Maybe()
+
+			uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+			result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+				Kms:           450,
+				Date:          "2026-06-15T08:00",
+				DepartureCity: "Paris",
+				ArrivalCity:   "Lyon",
+				Seats:         3,
+				CarID:         "car-1",
+				Stops:         tt.stops,
+			})
+
+			assert.Nil(t, result)
+			var stopsErr *domainerrors.InvalidTripStopsError
+			assert.True(t, errors.As(err, &stopsErr))
+		})
+	}
+}
+
+func TestCreateTrip_LocalDepartureTime(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	// 08:00 in Paris is 06:00 UTC in summer
+	dateTrip := time.Date(2026, 6, 15, 6, 0, 0, 0, time.UTC)
+	arrival := time.Date(2026, 6, 15, 11, 0, 0, 0, time.UTC)
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(&entities.City{RefID: 10, CityName: "Paris", TimeZone: "Europe/Paris"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon", TimeZone: "Europe/Paris"}, nil)
+	tripRepo.EXPECT().Create(ctx, entities.CreateTripData{
+		DateTrip:         dateTrip,
+		EstimatedArrival: arrival,
+		TimeZone:         "Europe/Paris",
+		Kms:              450,
+		Seats:            3,
+		PricePerSeat:     2250,
+		Currency:         "EUR",
+		DriverRefID:      300,
+		CarRefID:         400,
+		Stops:            []entities.CreateTripStopData{{CityRefID: 10}, {CityRefID: 20}},
+	}).Return(&entities.Trip{ID: "trip-1", RefID: 500}, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+		ArrivalTime:   &arrival,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip-1", result.ID)
+}
+
+func TestCreateTrip_ArrivalBeforeDeparture(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	arrival := time.Date(2026, 6, 15, 7, 0, 0, 0, time.UTC)
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(&entities.City{RefID: 10, CityName: "Paris"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon"}, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00:00Z",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+		ArrivalTime:   &arrival,
+	})
+
+	assert.Nil(t, result)
+	var dateErr *domainerrors.InvalidTripDateError
+	assert.True(t, errors.As(err, &dateErr))
+}
+
+func TestCreateTrip_DriverPriceFromFuelConsumption(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	consumption := 6.0
+	price := 2500
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300, FuelConsumption: &consumption}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(&entities.City{RefID: 10, CityName: "Paris"}, nil)
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon"}, nil)
+	tripRepo.EXPECT().Create(ctx, mock.MatchedBy(func(data entities.CreateTripData) bool {
+		return data.PricePerSeat == 2500 && data.Currency == "EUR"
+	})).Return(&entities.Trip{ID: "trip-1", RefID: 500, PricePerSeat: 2500, Currency: "EUR"}, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00:00Z",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+		PricePerSeat:  &price,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2500, result.PricePerSeat)
+}
+
+func TestCreateTrip_PriceAboveCap(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	// 27 litres at 1.85 plus 0.08 of wear per km make 85.95 EUR, 28.65 EUR for each of three seats
+	consumption := 6.0
+	price := 3000
+
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 400, DriverRefID: 300, FuelConsumption: &consumption}, nil)
+
+	uc := NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo, testPricing)
+	result, err := uc.Execute(ctx, "user-1", dtos.CreateTripInput{
+		Kms:           450,
+		Date:          "2026-06-15T08:00:00Z",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		Seats:         3,
+		CarID:         "car-1",
+		PricePerSeat:  &price,
+	})
+
+	assert.Nil(t, result)
+	var capErr *domainerrors.PriceAboveCapError
+	require.True(t, errors.As(err, &capErr))
+	assert.Contains(t, err.Error(), "28.65 EUR")
+}
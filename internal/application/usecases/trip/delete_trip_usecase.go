@@ -8,21 +8,23 @@ import (
 )
 
 type DeleteTripUseCase struct {
-	tripRepository   repositories.TripRepository
-	driverRepository repositories.DriverRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
 }
 
 func NewDeleteTripUseCase(
 	tripRepository repositories.TripRepository,
-	driverRepository repositories.DriverRepository,
+	inscriptionRepository repositories.InscriptionRepository,
 ) *DeleteTripUseCase {
 	return &DeleteTripUseCase{
-		tripRepository:   tripRepository,
-		driverRepository: driverRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
 	}
 }
 
-func (uc *DeleteTripUseCase) Execute(ctx context.Context, id, userID string) error {
+// Execute permanently removes a trip. Trips that were ever booked keep their history and can only
+// be cancelled.
+func (uc *DeleteTripUseCase) Execute(ctx context.Context, id string) error {
 	existing, err := uc.tripRepository.FindByID(ctx, id)
 	if err != nil {
 		return err
@@ -31,15 +33,12 @@ func (uc *DeleteTripUseCase) Execute(ctx context.Context, id, userID string) err
 		return domainerrors.NewTripNotFoundError(id)
 	}
 
-	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	inscriptions, err := uc.inscriptionRepository.FindByTripID(ctx, id)
 	if err != nil {
 		return err
 	}
-	if driver == nil {
-		return domainerrors.NewDriverNotFoundError(userID)
-	}
-	if existing.DriverRefID != driver.RefID {
-		return domainerrors.NewForbiddenError("trip", id)
+	if len(inscriptions) > 0 {
+		return domainerrors.NewTripHasBookingsError(id)
 	}
 
 	return uc.tripRepository.Delete(ctx, id)
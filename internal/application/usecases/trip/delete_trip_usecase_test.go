@@ -15,7 +15,7 @@ import (
 func TestDeleteTrip_Success(t *testing.T) {
 	ctx := context.Background()
 	tripRepo := mocks.NewMockTripRepository(t)
-	driverRepo := mocks.NewMockDriverRepository(t)
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 
 	existingTrip := &entities.Trip{
 		ID:          "trip-1",
@@ -25,19 +25,13 @@ func TestDeleteTrip_Success(t *testing.T) {
 		DriverRefID: 300,
 		CarRefID:    400,
 	}
-	driver := &entities.Driver{
-		ID:            "driver-1",
-		RefID:         300,
-		DriverLicense: "DL-12345",
-		UserRefID:     200,
-	}
 
 	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
-	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(driver, nil)
+	inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{}, nil)
 	tripRepo.EXPECT().Delete(ctx, "trip-1").Return(nil)
 
-	uc := NewDeleteTripUseCase(tripRepo, driverRepo)
-	err := uc.Execute(ctx, "trip-1", "user-1")
+	uc := NewDeleteTripUseCase(tripRepo, inscriptionRepo)
+	err := uc.Execute(ctx, "trip-1")
 
 	require.NoError(t, err)
 }
@@ -45,70 +39,35 @@ func TestDeleteTrip_Success(t *testing.T) {
 func TestDeleteTrip_TripNotFound(t *testing.T) {
 	ctx := context.Background()
 	tripRepo := mocks.NewMockTripRepository(t)
-	driverRepo := mocks.NewMockDriverRepository(t)
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 
 	tripRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
 
-	uc := NewDeleteTripUseCase(tripRepo, driverRepo)
-	err := uc.Execute(ctx, "nonexistent", "user-1")
+	uc := NewDeleteTripUseCase(tripRepo, inscriptionRepo)
+	err := uc.Execute(ctx, "nonexistent")
 
 	require.Error(t, err)
 	var notFoundErr *domainerrors.TripNotFoundError
 	assert.True(t, errors.As(err, &notFoundErr))
 }
 
-func TestDeleteTrip_DriverNotFound(t *testing.T) {
-	ctx := context.Background()
-	tripRepo := mocks.NewMockTripRepository(t)
-	driverRepo := mocks.NewMockDriverRepository(t)
-
-	existingTrip := &entities.Trip{
-		ID:          "trip-1",
-		RefID:       500,
-		Kms:         450,
-		Seats:       3,
-		DriverRefID: 300,
-		CarRefID:    400,
-	}
-
-	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
-	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(nil, nil)
-
-	uc := NewDeleteTripUseCase(tripRepo, driverRepo)
-	err := uc.Execute(ctx, "trip-1", "user-1")
-
-	require.Error(t, err)
-	var notFoundErr *domainerrors.DriverNotFoundError
-	assert.True(t, errors.As(err, &notFoundErr))
-}
-
-func TestDeleteTrip_NotOwner(t *testing.T) {
+func TestDeleteTrip_HasBookings(t *testing.T) {
 	ctx := context.Background()
 	tripRepo := mocks.NewMockTripRepository(t)
-	driverRepo := mocks.NewMockDriverRepository(t)
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
 
-	existingTrip := &entities.Trip{
-		ID:          "trip-1",
-		RefID:       500,
-		Kms:         450,
-		Seats:       3,
-		DriverRefID: 300, // owned by driver with RefID 300
-		CarRefID:    400,
-	}
-	differentDriver := &entities.Driver{
-		ID:            "driver-2",
-		RefID:         999, // different RefID than the trip's DriverRefID
-		DriverLicense: "DL-OTHER",
-		UserRefID:     201,
-	}
+	existingTrip := &entities.Trip{ID: "trip-1", RefID: 500, Seats: 3, DriverRefID: 300}
 
 	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(existingTrip, nil)
-	driverRepo.EXPECT().FindByUserID(ctx, "user-2").Return(differentDriver, nil)
+	// Bookings cancelled by the driver still count: they are the passengers' history
+	inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", TripRefID: 500, Status: entities.InscriptionStatusCancelledByDriver},
+	}, nil)
 
-	uc := NewDeleteTripUseCase(tripRepo, driverRepo)
-	err := uc.Execute(ctx, "trip-1", "user-2")
+	uc := NewDeleteTripUseCase(tripRepo, inscriptionRepo)
+	err := uc.Execute(ctx, "trip-1")
 
 	require.Error(t, err)
-	var forbiddenErr *domainerrors.ForbiddenError
-	assert.True(t, errors.As(err, &forbiddenErr))
+	var hasBookingsErr *domainerrors.TripHasBookingsError
+	assert.True(t, errors.As(err, &hasBookingsErr))
 }
@@ -6,16 +6,19 @@ import (
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 )
 
 type FindTripsUseCase struct {
 	tripRepository repositories.TripRepository
+	cityRepository repositories.CityRepository
 }
 
-func NewFindTripsUseCase(tripRepository repositories.TripRepository) *FindTripsUseCase {
+func NewFindTripsUseCase(tripRepository repositories.TripRepository, cityRepository repositories.CityRepository) *FindTripsUseCase {
 	return &FindTripsUseCase{
 		tripRepository: tripRepository,
+		cityRepository: cityRepository,
 	}
 }
 
@@ -23,15 +26,91 @@ func (uc *FindTripsUseCase) Execute(ctx context.Context, query dtos.FindTripQuer
 	filters := entities.TripFilters{
 		DepartureCity: query.DepartureCity,
 		ArrivalCity:   query.ArrivalCity,
+		// Only trips that can still be booked are offered
+		Statuses: []string{entities.TripStatusScheduled},
 	}
 
 	if query.Date != nil {
 		parsed, err := time.Parse("2006-01-02", *query.Date)
 		if err != nil {
-			return nil, err
+			return nil, domainerrors.NewInvalidTripDateError(*query.Date)
 		}
 		filters.Date = &parsed
 	}
 
+	// Departure windows are times of day, compared with the clock of each trip's time zone
+	for _, value := range []*string{query.DepartureAfter, query.DepartureBefore} {
+		if value == nil {
+			continue
+		}
+		if _, err := time.Parse("15:04", *value); err != nil {
+			return nil, domainerrors.NewInvalidTripDateError(*value)
+		}
+	}
+	filters.DepartureAfter = query.DepartureAfter
+	filters.DepartureBefore = query.DepartureBefore
+	filters.MinPrice = query.MinPrice
+	filters.MaxPrice = query.MaxPrice
+
+	departureArea, err := uc.searchArea(ctx, query.DepartureCity, query.DepartureLat, query.DepartureLng, query.DepartureRadius)
+	if err != nil {
+		return nil, err
+	}
+	arrivalArea, err := uc.searchArea(ctx, query.ArrivalCity, query.ArrivalLat, query.ArrivalLng, query.ArrivalRadius)
+	if err != nil {
+		return nil, err
+	}
+	if departureArea != nil {
+		filters.DepartureCity = nil
+		filters.DepartureArea = departureArea
+	}
+	if arrivalArea != nil {
+		filters.ArrivalCity = nil
+		filters.ArrivalArea = arrivalArea
+	}
+
+	// Searches around a place list the closest trips first unless asked otherwise
+	near := departureArea != nil || arrivalArea != nil
+	switch {
+	case query.Sort != nil && *query.Sort == entities.TripSortDistance && !near:
+		return nil, domainerrors.NewInvalidSearchAreaError("sorting by distance needs a radius")
+	case query.Sort != nil:
+		filters.Sort = *query.Sort
+	case near:
+		filters.Sort = entities.TripSortDistance
+	}
+
 	return uc.tripRepository.FindByFilters(ctx, filters)
 }
+
+// searchArea returns the area to search around when a radius is given, and nil to match the city
+// by name. A point takes precedence over the city.
+func (uc *FindTripsUseCase) searchArea(ctx context.Context, cityName *string, lat, lng, radius *float64) (*entities.GeoArea, error) {
+	if (lat == nil) != (lng == nil) {
+		return nil, domainerrors.NewInvalidSearchAreaError("a point needs both a latitude and a longitude")
+	}
+	if radius == nil {
+		if lat != nil {
+			return nil, domainerrors.NewInvalidSearchAreaError("a point needs a radius")
+		}
+		return nil, nil
+	}
+	if lat != nil {
+		return &entities.GeoArea{Latitude: *lat, Longitude: *lng, RadiusKm: *radius}, nil
+	}
+	if cityName == nil {
+		return nil, domainerrors.NewInvalidSearchAreaError("a radius needs a point or a city")
+	}
+
+	city, err := uc.cityRepository.FindByCityName(ctx, *cityName)
+	if err != nil {
+		return nil, err
+	}
+	if city == nil {
+		return nil, domainerrors.NewCityNotFoundError(*cityName)
+	}
+	if !city.Located() {
+		return nil, domainerrors.NewCityNotLocatedError(*cityName)
+	}
+	return &entities.GeoArea{Latitude: *city.Latitude, Longitude: *city.Longitude, RadiusKm: *radius}, nil
+}
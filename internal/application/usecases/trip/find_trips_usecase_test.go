@@ -2,11 +2,13 @@ package trip
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
 	"github.com/lgxju/gogretago/internal/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,9 +39,10 @@ func TestFindTrips_AllFilters(t *testing.T) {
 		DepartureCity: &departure,
 		ArrivalCity:   &arrival,
 		Date:          &parsedDate,
+		Statuses:      []string{entities.TripStatusScheduled},
 	}).Return(expectedTrips, nil)
 
-	uc := NewFindTripsUseCase(tripRepo)
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
 	result, err := uc.Execute(ctx, dtos.FindTripQuery{
 		DepartureCity: &departure,
 		ArrivalCity:   &arrival,
@@ -70,9 +73,10 @@ func TestFindTrips_NoFilters(t *testing.T) {
 		DepartureCity: nil,
 		ArrivalCity:   nil,
 		Date:          nil,
+		Statuses:      []string{entities.TripStatusScheduled},
 	}).Return(expectedTrips, nil)
 
-	uc := NewFindTripsUseCase(tripRepo)
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
 	result, err := uc.Execute(ctx, dtos.FindTripQuery{
 		DepartureCity: nil,
 		ArrivalCity:   nil,
@@ -89,7 +93,7 @@ func TestFindTrips_InvalidDateFilter(t *testing.T) {
 
 	badDate := "not-a-valid-date"
 
-	uc := NewFindTripsUseCase(tripRepo)
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
 	result, err := uc.Execute(ctx, dtos.FindTripQuery{
 		DepartureCity: nil,
 		ArrivalCity:   nil,
@@ -121,9 +125,10 @@ func TestFindTrips_DateOnly(t *testing.T) {
 		DepartureCity: nil,
 		ArrivalCity:   nil,
 		Date:          &parsedDate,
+		Statuses:      []string{entities.TripStatusScheduled},
 	}).Return(expectedTrips, nil)
 
-	uc := NewFindTripsUseCase(tripRepo)
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
 	result, err := uc.Execute(ctx, dtos.FindTripQuery{
 		DepartureCity: nil,
 		ArrivalCity:   nil,
@@ -135,3 +140,152 @@ func TestFindTrips_DateOnly(t *testing.T) {
 	assert.Equal(t, "trip-1", result[0].ID)
 	assert.Equal(t, parsedDate, result[0].DateTrip)
 }
+
+func TestFindTrips_DepartureWindow(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	after, before := "07:00", "09:00"
+
+	tripRepo.EXPECT().FindByFilters(ctx, entities.TripFilters{
+		DepartureAfter:  &after,
+		DepartureBefore: &before,
+		Statuses:        []string{entities.TripStatusScheduled},
+	}).Return([]entities.Trip{}, nil)
+
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
+	result, err := uc.Execute(ctx, dtos.FindTripQuery{
+		DepartureAfter:  &after,
+		DepartureBefore: &before,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestFindTrips_PriceRangeSortedByPrice(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	minPrice, maxPrice := 1000, 2500
+	sort := entities.TripSortPrice
+
+	tripRepo.EXPECT().FindByFilters(ctx, entities.TripFilters{
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+		Sort:     entities.TripSortPrice,
+		Statuses: []string{entities.TripStatusScheduled},
+	}).Return([]entities.Trip{}, nil)
+
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
+	result, err := uc.Execute(ctx, dtos.FindTripQuery{
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+		Sort:     &sort,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestFindTrips_InvalidDepartureWindow(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	after := "7am"
+
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
+	result, err := uc.Execute(ctx, dtos.FindTripQuery{DepartureAfter: &after})
+
+	assert.Nil(t, result)
+	var dateErr *domainerrors.InvalidTripDateError
+	assert.True(t, errors.As(err, &dateErr))
+}
+
+func TestFindTrips_AroundPoint(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+
+	lat, lng, radius := 45.76, 4.83, 15.0
+	arrival := "Paris"
+
+	tripRepo.EXPECT().FindByFilters(ctx, entities.TripFilters{
+		ArrivalCity:   &arrival,
+		DepartureArea: &entities.GeoArea{Latitude: 45.76, Longitude: 4.83, RadiusKm: 15},
+		Statuses:      []string{entities.TripStatusScheduled},
+		Sort:          entities.TripSortDistance,
+	}).Return([]entities.Trip{}, nil)
+
+	uc := NewFindTripsUseCase(tripRepo, mocks.NewMockCityRepository(t))
+	_, err := uc.Execute(ctx, dtos.FindTripQuery{
+		DepartureLat:    &lat,
+		DepartureLng:    &lng,
+		DepartureRadius: &radius,
+		ArrivalCity:     &arrival,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestFindTrips_AroundCity(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	arrival, radius, sort := "Lyon", 10.0, entities.TripSortPrice
+	lat, lng := 45.76, 4.83
+
+	cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(&entities.City{RefID: 20, CityName: "Lyon", Latitude: &lat, Longitude: &lng}, nil)
+	tripRepo.EXPECT().FindByFilters(ctx, entities.TripFilters{
+		ArrivalArea: &entities.GeoArea{Latitude: 45.76, Longitude: 4.83, RadiusKm: 10},
+		Statuses:    []string{entities.TripStatusScheduled},
+		Sort:        entities.TripSortPrice,
+	}).Return([]entities.Trip{}, nil)
+
+	uc := NewFindTripsUseCase(tripRepo, cityRepo)
+	_, err := uc.Execute(ctx, dtos.FindTripQuery{ArrivalCity: &arrival, ArrivalRadius: &radius, Sort: &sort})
+
+	require.NoError(t, err)
+}
+
+func TestFindTrips_CityNotLocated(t *testing.T) {
+	ctx := context.Background()
+	cityRepo := mocks.NewMockCityRepository(t)
+
+	departure, radius := "Villeurbanne", 10.0
+
+	cityRepo.EXPECT().FindByCityName(ctx, "Villeurbanne").Return(&entities.City{RefID: 30, CityName: "Villeurbanne"}, nil)
+
+	uc := NewFindTripsUseCase(mocks.NewMockTripRepository(t), cityRepo)
+	result, err := uc.Execute(ctx, dtos.FindTripQuery{DepartureCity: &departure, DepartureRadius: &radius})
+
+	assert.Nil(t, result)
+	var locatedErr *domainerrors.CityNotLocatedError
+	assert.True(t, errors.As(err, &locatedErr))
+}
+
+func TestFindTrips_InvalidSearchArea(t *testing.T) {
+	ctx := context.Background()
+	lat, lng, radius, sort := 45.76, 4.83, 10.0, entities.TripSortDistance
+
+	tests := []struct {
+		name  string
+		query dtos.FindTripQuery
+	}{
+		{"point without radius", dtos.FindTripQuery{DepartureLat: &lat, DepartureLng: &lng}},
+		{"latitude without longitude", dtos.FindTripQuery{ArrivalLat: &lat, ArrivalRadius: &radius}},
+		{"radius without center", dtos.FindTripQuery{DepartureRadius: &radius}},
+		{"distance sort without radius", dtos.FindTripQuery{Sort: &sort}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewFindTripsUseCase(mocks.NewMockTripRepository(t), mocks.NewMockCityRepository(t))
+			result, err := uc.Execute(ctx, tt.query)
+
+			assert.Nil(t, result)
+			var areaErr *domainerrors.InvalidSearchAreaError
+			assert.True(t, errors.As(err, &areaErr))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package trip
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ListTripChangesUseCase struct {
+	tripRepository        repositories.TripRepository
+	driverRepository      repositories.DriverRepository
+	userRepository        repositories.UserRepository
+	inscriptionRepository repositories.InscriptionRepository
+}
+
+func NewListTripChangesUseCase(
+	tripRepository repositories.TripRepository,
+	driverRepository repositories.DriverRepository,
+	userRepository repositories.UserRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+) *ListTripChangesUseCase {
+	return &ListTripChangesUseCase{
+		tripRepository:        tripRepository,
+		driverRepository:      driverRepository,
+		userRepository:        userRepository,
+		inscriptionRepository: inscriptionRepository,
+	}
+}
+
+// Execute returns the history of a trip to its driver and to the passengers who booked it
+func (uc *ListTripChangesUseCase) Execute(ctx context.Context, id, userID string) ([]entities.TripChange, error) {
+	trip, err := uc.tripRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if trip == nil {
+		return nil, domainerrors.NewTripNotFoundError(id)
+	}
+
+	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	booked := false
+	if !authorization.DrivesTrip(driver, trip) {
+		user, err := uc.userRepository.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, domainerrors.NewUserNotFoundError(userID)
+		}
+		if booked, err = uc.inscriptionRepository.ExistsByUserAndTrip(ctx, user.RefID, trip.RefID); err != nil {
+			return nil, err
+		}
+	}
+	if err := authorization.EnsureCanViewTripChanges(driver, trip, booked); err != nil {
+		return nil, err
+	}
+
+	return uc.tripRepository.FindChanges(ctx, trip.RefID)
+}
@@ -0,0 +1,70 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTripChanges_Driver(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	changes := []entities.TripChange{{ID: "change-1", TripRefID: 500, Field: entities.TripChangeSeats, OldValue: "3", NewValue: "4"}}
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300}, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	tripRepo.EXPECT().FindChanges(ctx, int64(500)).Return(changes, nil)
+
+	uc := NewListTripChangesUseCase(tripRepo, driverRepo, mocks.NewMockUserRepository(t), mocks.NewMockInscriptionRepository(t))
+	result, err := uc.Execute(ctx, "trip-1", "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, changes, result)
+}
+
+func TestListTripChanges_BookedPassenger(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300}, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-2").Return(nil, nil)
+	userRepo.EXPECT().FindByID(ctx, "user-2").Return(&entities.PublicUser{User: entities.User{ID: "user-2", RefID: 10}}, nil)
+	inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(10), int64(500)).Return(true, nil)
+	tripRepo.EXPECT().FindChanges(ctx, int64(500)).Return([]entities.TripChange{}, nil)
+
+	uc := NewListTripChangesUseCase(tripRepo, driverRepo, userRepo, inscriptionRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-2")
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestListTripChanges_NotBooked(t *testing.T) {
+	ctx := context.Background()
+	tripRepo := mocks.NewMockTripRepository(t)
+	driverRepo := mocks.NewMockDriverRepository(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	inscriptionRepo := mocks.NewMockInscriptionRepository(t)
+
+	tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 500, DriverRefID: 300}, nil)
+	driverRepo.EXPECT().FindByUserID(ctx, "user-3").Return(&entities.Driver{ID: "driver-3", RefID: 301}, nil)
+	userRepo.EXPECT().FindByID(ctx, "user-3").Return(&entities.PublicUser{User: entities.User{ID: "user-3", RefID: 11}}, nil)
+	inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(11), int64(500)).Return(false, nil)
+
+	uc := NewListTripChangesUseCase(tripRepo, driverRepo, userRepo, inscriptionRepo)
+	result, err := uc.Execute(ctx, "trip-1", "user-3")
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
@@ -0,0 +1,40 @@
+package trip
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type QuoteTripPriceUseCase struct {
+	carRepository repositories.CarRepository
+	pricing       entities.PricingRules
+}
+
+func NewQuoteTripPriceUseCase(carRepository repositories.CarRepository, pricing entities.PricingRules) *QuoteTripPriceUseCase {
+	return &QuoteTripPriceUseCase{
+		carRepository: carRepository,
+		pricing:       pricing,
+	}
+}
+
+// Execute suggests a price per seat for a trip before the driver offers it
+func (uc *QuoteTripPriceUseCase) Execute(ctx context.Context, query dtos.PriceQuoteQuery) (*entities.PriceQuote, error) {
+	var fuelConsumption *float64
+	if query.CarID != nil {
+		car, err := uc.carRepository.FindByID(ctx, *query.CarID)
+		if err != nil {
+			return nil, err
+		}
+		if car == nil {
+			return nil, domainerrors.NewCarNotFoundError(*query.CarID)
+		}
+		fuelConsumption = car.FuelConsumption
+	}
+
+	quote := uc.pricing.Quote(query.Kms, query.Seats, fuelConsumption)
+	return &quote, nil
+}
@@ -0,0 +1,55 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteTripPrice_WithoutCar(t *testing.T) {
+	ctx := context.Background()
+	carRepo := mocks.NewMockCarRepository(t)
+
+	uc := NewQuoteTripPriceUseCase(carRepo, testPricing)
+	result, err := uc.Execute(ctx, dtos.PriceQuoteQuery{Kms: 450, Seats: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.PriceQuote{Currency: "EUR", SuggestedPrice: 2250, MaxPrice: 3000}, *result)
+}
+
+func TestQuoteTripPrice_CarFuelConsumption(t *testing.T) {
+	ctx := context.Background()
+	carRepo := mocks.NewMockCarRepository(t)
+
+	carID := "car-1"
+	consumption := 6.0
+	carRepo.EXPECT().FindByID(ctx, carID).Return(&entities.Car{ID: carID, FuelConsumption: &consumption}, nil)
+
+	uc := NewQuoteTripPriceUseCase(carRepo, testPricing)
+	result, err := uc.Execute(ctx, dtos.PriceQuoteQuery{Kms: 450, Seats: 3, CarID: &carID})
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.PriceQuote{Currency: "EUR", SuggestedPrice: 2149, MaxPrice: 2865}, *result)
+}
+
+func TestQuoteTripPrice_CarNotFound(t *testing.T) {
+	ctx := context.Background()
+	carRepo := mocks.NewMockCarRepository(t)
+
+	carID := "car-1"
+	carRepo.EXPECT().FindByID(ctx, carID).Return(nil, nil)
+
+	uc := NewQuoteTripPriceUseCase(carRepo, testPricing)
+	result, err := uc.Execute(ctx, dtos.PriceQuoteQuery{Kms: 450, Seats: 3, CarID: &carID})
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.CarNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
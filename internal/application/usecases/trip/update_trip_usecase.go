@@ -0,0 +1,365 @@
+package trip
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// UpdateTripData holds partial update fields for a trip use case.
+// All fields are optional to support both PUT (all set) and PATCH (some set).
+type UpdateTripData struct {
+	Kms           *int
+	Date          *string
+	DepartureCity *string
+	ArrivalCity   *string
+	Seats         *int
+	CarID         *string
+	ArrivalTime   *time.Time
+	PricePerSeat  *int
+	// Departure replaces Date when the departure is already worked out, as for series occurrences
+	Departure *time.Time
+	// FollowSeries is set when the change comes from the occurrence's series, which it keeps following
+	FollowSeries bool
+}
+
+// TripUpdate is a change of a trip checked against the editing rules, ready to be saved
+type TripUpdate struct {
+	trip     *entities.Trip
+	data     entities.UpdateTripData
+	bookings []entities.Inscription
+}
+
+type UpdateTripUseCase struct {
+	tripRepository        repositories.TripRepository
+	driverRepository      repositories.DriverRepository
+	carRepository         repositories.CarRepository
+	cityRepository        repositories.CityRepository
+	inscriptionRepository repositories.InscriptionRepository
+	userRepository        repositories.UserRepository
+	emailService          services.EmailService
+	pricing               entities.PricingRules
+}
+
+func NewUpdateTripUseCase(
+	tripRepository repositories.TripRepository,
+	driverRepository repositories.DriverRepository,
+	carRepository repositories.CarRepository,
+	cityRepository repositories.CityRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+	pricing entities.PricingRules,
+) *UpdateTripUseCase {
+	return &UpdateTripUseCase{
+		tripRepository:        tripRepository,
+		driverRepository:      driverRepository,
+		carRepository:         carRepository,
+		cityRepository:        cityRepository,
+		inscriptionRepository: inscriptionRepository,
+		userRepository:        userRepository,
+		emailService:          emailService,
+		pricing:               pricing,
+	}
+}
+
+// Execute changes one of the driver's trips before it leaves and records what changed. Passengers
+// holding a seat are told, and must confirm their seat again when the cities or the departure move.
+func (uc *UpdateTripUseCase) Execute(ctx context.Context, id, userID string, input UpdateTripData) (*entities.Trip, error) {
+	existing, err := uc.tripRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domainerrors.NewTripNotFoundError(id)
+	}
+
+	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, domainerrors.NewDriverNotFoundError(userID)
+	}
+	if err := authorization.EnsureDriverOwnsTrip(driver, existing); err != nil {
+		return nil, err
+	}
+
+	update, err := uc.Prepare(ctx, existing, driver, input)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Save(ctx, update)
+}
+
+// Prepare checks a change of one of the driver's trips and works out what it changes, without
+// saving anything yet
+func (uc *UpdateTripUseCase) Prepare(ctx context.Context, existing *entities.Trip, driver *entities.Driver, input UpdateTripData) (*TripUpdate, error) {
+	id := existing.ID
+	if existing.Status != entities.TripStatusScheduled && existing.Status != entities.TripStatusFull {
+		return nil, domainerrors.NewTripNotEditableError(id)
+	}
+
+	var data entities.UpdateTripData
+	record := func(field, oldValue, newValue string) {
+		data.Changes = append(data.Changes, entities.CreateTripChangeData{Field: field, OldValue: oldValue, NewValue: newValue})
+	}
+
+	// Stops along the way are kept, only the ends of the route can be replaced
+	timeZone := existing.TimeZone
+	if input.DepartureCity != nil || input.ArrivalCity != nil {
+		if len(existing.Stops) < 2 {
+			return nil, domainerrors.NewInvalidTripStopsError("the trip has no route to change")
+		}
+		last := len(existing.Stops) - 1
+		names := make([]string, len(existing.Stops))
+		for i, stop := range existing.Stops {
+			names[i] = stop.CityName
+		}
+		if input.DepartureCity != nil {
+			names[0] = *input.DepartureCity
+		}
+		if input.ArrivalCity != nil {
+			names[last] = *input.ArrivalCity
+		}
+		if err := validateCities(names); err != nil {
+			return nil, err
+		}
+
+		if names[0] != existing.Stops[0].CityName {
+			city, err := findOrCreateCity(ctx, uc.cityRepository, names[0])
+			if err != nil {
+				return nil, err
+			}
+			data.DepartureCityRefID = &city.RefID
+			// The trip keeps the time zone of its departure city
+			timeZone = city.TimeZone
+			record(entities.TripChangeDepartureCity, existing.Stops[0].CityName, city.CityName)
+		}
+		if names[last] != existing.Stops[last].CityName {
+			city, err := findOrCreateCity(ctx, uc.cityRepository, names[last])
+			if err != nil {
+				return nil, err
+			}
+			data.ArrivalCityRefID = &city.RefID
+			record(entities.TripChangeArrivalCity, existing.Stops[last].CityName, city.CityName)
+		}
+	}
+	if timeZone != existing.TimeZone {
+		data.TimeZone = &timeZone
+	}
+
+	departure := existing.DateTrip
+	if input.Date != nil {
+		parsed, err := entities.ParseDepartureTime(*input.Date, timeZone)
+		if err != nil {
+			return nil, domainerrors.NewInvalidTripDateError(*input.Date)
+		}
+		departure = parsed.UTC()
+	} else if input.Departure != nil {
+		departure = input.Departure.UTC()
+	}
+	kms := existing.Kms
+	if input.Kms != nil {
+		kms = *input.Kms
+	}
+
+	// Stop times move with the departure, and the arrival too unless the distance changes
+	shift := departure.Sub(existing.DateTrip)
+	arrival := existing.EstimatedArrival.Add(shift)
+	if input.ArrivalTime != nil {
+		arrival = input.ArrivalTime.UTC()
+	} else if kms != existing.Kms {
+		arrival = entities.EstimateArrival(departure, kms)
+	}
+	if !arrival.After(departure) {
+		return nil, domainerrors.NewInvalidTripDateError("the arrival must follow the departure")
+	}
+	for _, stop := range existing.Stops {
+		if stop.Time != nil && stop.Time.Add(shift).After(arrival) {
+			return nil, domainerrors.NewInvalidTripStopsError("stop times must come before the arrival time")
+		}
+	}
+	if shift != 0 {
+		data.DateTrip = &departure
+		data.StopShift = shift
+		record(entities.TripChangeDate, formatLocalTime(existing.DateTrip, existing.TimeZone), formatLocalTime(departure, timeZone))
+	}
+	if !arrival.Equal(existing.EstimatedArrival) {
+		data.EstimatedArrival = &arrival
+		record(entities.TripChangeEstimatedArrival, formatLocalTime(existing.EstimatedArrival, existing.TimeZone), formatLocalTime(arrival, timeZone))
+	}
+	if kms != existing.Kms {
+		data.Kms = &kms
+		record(entities.TripChangeKms, strconv.Itoa(existing.Kms), strconv.Itoa(kms))
+	}
+
+	bookings, err := uc.inscriptionRepository.FindByTripID(ctx, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	seats := existing.Seats
+	if input.Seats != nil {
+		seats = *input.Seats
+	}
+	if existing.SeatsTaken(bookings) > seats {
+		return nil, domainerrors.NewSeatsBelowBookingsError(id)
+	}
+	if seats != existing.Seats {
+		data.Seats = &seats
+		record(entities.TripChangeSeats, strconv.Itoa(existing.Seats), strconv.Itoa(seats))
+	}
+
+	if err := uc.updateCarAndPrice(ctx, existing, driver, input, kms, seats, &data, record); err != nil {
+		return nil, err
+	}
+
+	update := &TripUpdate{trip: existing, data: data, bookings: bookings}
+	if len(data.Changes) == 0 {
+		return update, nil
+	}
+
+	passengers := 0
+	for i := range bookings {
+		if bookings[i].HoldsSeat() {
+			passengers++
+		}
+	}
+	for _, change := range data.Changes {
+		if change.RequiresReconfirmation() && passengers > 0 {
+			update.data.ReconfirmBookings = true
+		}
+	}
+	// An occurrence changed on its own is left alone by later edits of its series
+	if existing.SeriesRefID != nil && !input.FollowSeries {
+		overridden := true
+		update.data.SeriesOverridden = &overridden
+	}
+	return update, nil
+}
+
+// Save records a prepared change and tells the passengers holding a seat
+func (uc *UpdateTripUseCase) Save(ctx context.Context, update *TripUpdate) (*entities.Trip, error) {
+	if len(update.data.Changes) == 0 {
+		return update.trip, nil
+	}
+
+	// Adding or removing seats can fill the trip or open it again. The seats taken are counted again
+	// as the trip is saved, in case a passenger booked meanwhile.
+	updated, err := uc.tripRepository.Update(ctx, update.trip.ID, update.data)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, domainerrors.NewSeatsBelowBookingsError(update.trip.ID)
+	}
+
+	for _, booking := range update.bookings {
+		if booking.HoldsSeat() {
+			uc.notifyPassenger(ctx, booking.UserRefID, updated, update.data.Changes, update.data.ReconfirmBookings)
+		}
+	}
+	return updated, nil
+}
+
+// updateCarAndPrice applies a new car or price. Whenever the distance, seats, car or price change,
+// the price must stay within the cost-sharing cap.
+func (uc *UpdateTripUseCase) updateCarAndPrice(
+	ctx context.Context,
+	existing *entities.Trip,
+	driver *entities.Driver,
+	input UpdateTripData,
+	kms, seats int,
+	data *entities.UpdateTripData,
+	record func(field, oldValue, newValue string),
+) error {
+	var car *entities.Car
+	if input.CarID != nil {
+		found, err := uc.carRepository.FindByID(ctx, *input.CarID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return domainerrors.NewCarNotFoundError(*input.CarID)
+		}
+		if err := authorization.EnsureDriverOwnsCar(driver, found); err != nil {
+			return err
+		}
+		car = found
+	}
+	carChanged := car != nil && car.RefID != existing.CarRefID
+	if !carChanged && input.PricePerSeat == nil && kms == existing.Kms && seats == existing.Seats {
+		return nil
+	}
+
+	current := car
+	if car == nil || carChanged {
+		found, err := uc.carRepository.FindByRefID(ctx, existing.CarRefID)
+		if err != nil {
+			return err
+		}
+		current = found
+	}
+	if carChanged {
+		data.CarRefID = &car.RefID
+		previous := ""
+		if current != nil {
+			previous = current.LicensePlate
+		}
+		record(entities.TripChangeCar, previous, car.LicensePlate)
+	} else {
+		car = current
+	}
+
+	var fuelConsumption *float64
+	if car != nil {
+		fuelConsumption = car.FuelConsumption
+	}
+	quote := uc.pricing.Quote(kms, seats, fuelConsumption)
+	price := existing.PricePerSeat
+	if input.PricePerSeat != nil {
+		price = *input.PricePerSeat
+	}
+	if !quote.Allows(price) {
+		return domainerrors.NewPriceAboveCapError(entities.FormatPrice(quote.MaxPrice, quote.Currency))
+	}
+	if price != existing.PricePerSeat {
+		data.PricePerSeat = &price
+		record(entities.TripChangePricePerSeat, entities.FormatPrice(existing.PricePerSeat, existing.Currency), entities.FormatPrice(price, existing.Currency))
+	}
+	return nil
+}
+
+// notifyPassenger emails a passenger the changes. The trip is already changed, so a passenger who
+// cannot be reached does not fail the request.
+func (uc *UpdateTripUseCase) notifyPassenger(ctx context.Context, userRefID int64, trip *entities.Trip, changes []entities.CreateTripChangeData, reconfirm bool) {
+	user, err := uc.userRepository.FindByRefID(ctx, userRefID)
+	if err != nil || user == nil || user.AnonymizedAt != nil {
+		return
+	}
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = fmt.Sprintf("%s: %s → %s", change.Field, change.OldValue, change.NewValue)
+	}
+	_ = uc.emailService.SendTripChangedEmail(user.Email, firstName, trip.LocalDateTrip, lines, reconfirm)
+}
+
+// formatLocalTime renders a time on the clock of the time zone, in UTC when the zone is unknown
+func formatLocalTime(t time.Time, timeZone string) string {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format("2006-01-02 15:04 MST")
+}
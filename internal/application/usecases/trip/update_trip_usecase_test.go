@@ -0,0 +1,286 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type updateTripDeps struct {
+	tripRepo        *mocks.MockTripRepository
+	driverRepo      *mocks.MockDriverRepository
+	carRepo         *mocks.MockCarRepository
+	cityRepo        *mocks.MockCityRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+	uc              *UpdateTripUseCase
+}
+
+func setupUpdateTrip(t *testing.T) updateTripDeps {
+	d := updateTripDeps{
+		tripRepo:        mocks.NewMockTripRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		carRepo:         mocks.NewMockCarRepository(t),
+		cityRepo:        mocks.NewMockCityRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
+	d.uc = NewUpdateTripUseCase(d.tripRepo, d.driverRepo, d.carRepo, d.cityRepo, d.inscriptionRepo, d.userRepo, d.emailService, testPricing)
+	return d
+}
+
+// editableTrip leaves Paris at 08:00 local time for a 400 km ride to Lyon at 20.00 EUR a seat
+func editableTrip() *entities.Trip {
+	departure := time.Date(2026, 6, 15, 6, 0, 0, 0, time.UTC)
+	return &entities.Trip{
+		ID: "trip-1", RefID: 500,
+		DateTrip: departure, LocalDateTrip: departure, EstimatedArrival: departure.Add(5 * time.Hour),
+		TimeZone: "Europe/Paris", Kms: 400, Seats: 3, PricePerSeat: 2000, Currency: "EUR",
+		DriverRefID: 300, CarRefID: 400, Status: entities.TripStatusScheduled,
+		Stops: []entities.TripStop{
+			{CityRefID: 10, CityName: "Paris", Position: 0, Type: entities.TripStopDeparture},
+			{CityRefID: 20, CityName: "Lyon", Position: 1, Type: entities.TripStopArrival},
+		},
+	}
+}
+
+func (d updateTripDeps) expectDriverTrip(ctx context.Context, trip *entities.Trip) {
+	d.tripRepo.EXPECT().FindByID(ctx, trip.ID).Return(trip, nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+}
+
+func (d updateTripDeps) expectPassenger(ctx context.Context, userRefID int64, email, firstName string) {
+	d.userRepo.EXPECT().FindByRefID(ctx, userRefID).Return(&entities.PublicUser{
+		User: entities.User{RefID: userRefID, FirstName: &firstName}, Email: email,
+	}, nil)
+}
+
+func TestUpdateTrip_SeatsAndPrice(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	existing := editableTrip()
+	seats, price := 4, 1500
+
+	updated := editableTrip()
+	updated.Seats, updated.PricePerSeat = seats, price
+
+	d.expectDriverTrip(ctx, existing)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", UserRefID: 10, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+	}, nil)
+	d.carRepo.EXPECT().FindByRefID(ctx, int64(400)).Return(&entities.Car{ID: "car-1", RefID: 400, LicensePlate: "AB-123-CD"}, nil)
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		Seats:        &seats,
+		PricePerSeat: &price,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeSeats, OldValue: "3", NewValue: "4"},
+			{Field: entities.TripChangePricePerSeat, OldValue: "20.00 EUR", NewValue: "15.00 EUR"},
+		},
+	}).Return(updated, nil)
+	d.expectPassenger(ctx, 10, "alice@example.com", "Alice")
+	d.emailService.EXPECT().SendTripChangedEmail("alice@example.com", "Alice", updated.LocalDateTrip,
+		[]string{"seats: 3 → 4", "pricePerSeat: 20.00 EUR → 15.00 EUR"}, false).Return(nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Seats: &seats, PricePerSeat: &price})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Seats)
+	assert.Equal(t, 1500, result.PricePerSeat)
+}
+
+func TestUpdateTrip_NewDepartureAsksReconfirmation(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	existing := editableTrip()
+	date, city := "2026-06-15T10:00", "Dijon"
+
+	departure := time.Date(2026, 6, 15, 8, 0, 0, 0, time.UTC)
+	arrival := departure.Add(5 * time.Hour)
+	dijonRefID := int64(30)
+	updated := editableTrip()
+
+	d.expectDriverTrip(ctx, existing)
+	d.cityRepo.EXPECT().FindByCityName(ctx, "Dijon").Return(&entities.City{RefID: 30, CityName: "Dijon", TimeZone: "Europe/Paris"}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", UserRefID: 10, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+		{ID: "insc-2", UserRefID: 11, Status: entities.InscriptionStatusCancelledByDriver, FromPosition: 0, ToPosition: 1},
+	}, nil)
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		DateTrip:           &departure,
+		EstimatedArrival:   &arrival,
+		DepartureCityRefID: &dijonRefID,
+		StopShift:          2 * time.Hour,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeDepartureCity, OldValue: "Paris", NewValue: "Dijon"},
+			{Field: entities.TripChangeDate, OldValue: "2026-06-15 08:00 CEST", NewValue: "2026-06-15 10:00 CEST"},
+			{Field: entities.TripChangeEstimatedArrival, OldValue: "2026-06-15 13:00 CEST", NewValue: "2026-06-15 15:00 CEST"},
+		},
+		ReconfirmBookings: true,
+	}).Return(updated, nil)
+	d.expectPassenger(ctx, 10, "alice@example.com", "Alice")
+	d.emailService.EXPECT().SendTripChangedEmail("alice@example.com", "Alice", updated.LocalDateTrip, []string{
+		"departureCity: Paris → Dijon",
+		"date: 2026-06-15 08:00 CEST → 2026-06-15 10:00 CEST",
+		"estimatedArrival: 2026-06-15 13:00 CEST → 2026-06-15 15:00 CEST",
+	}, true).Return(nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Date: &date, DepartureCity: &city})
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip-1", result.ID)
+}
+
+func TestUpdateTrip_OccurrenceLeavesItsSeries(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	existing := editableTrip()
+	seriesRefID := int64(40)
+	existing.SeriesRefID = &seriesRefID
+	kms := 300
+
+	arrival := existing.DateTrip.Add(225 * time.Minute)
+	overridden := true
+
+	d.expectDriverTrip(ctx, existing)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{}, nil)
+	d.carRepo.EXPECT().FindByRefID(ctx, int64(400)).Return(&entities.Car{ID: "car-1", RefID: 400}, nil)
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		EstimatedArrival: &arrival,
+		Kms:              &kms,
+		SeriesOverridden: &overridden,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeEstimatedArrival, OldValue: "2026-06-15 13:00 CEST", NewValue: "2026-06-15 11:45 CEST"},
+			{Field: entities.TripChangeKms, OldValue: "400", NewValue: "300"},
+		},
+	}).Return(existing, nil)
+
+	_, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Kms: &kms})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateTrip_NoChanges(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	existing := editableTrip()
+	seats := 3
+
+	d.expectDriverTrip(ctx, existing)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Seats: &seats})
+
+	require.NoError(t, err)
+	assert.Same(t, existing, result)
+}
+
+func TestUpdateTrip_SeatsBelowBookings(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	seats := 1
+
+	d.expectDriverTrip(ctx, editableTrip())
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", UserRefID: 10, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+		{ID: "insc-2", UserRefID: 11, Status: entities.InscriptionStatusPendingConfirmation, FromPosition: 0, ToPosition: 1},
+	}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Seats: &seats})
+
+	assert.Nil(t, result)
+	var seatsErr *domainerrors.SeatsBelowBookingsError
+	assert.True(t, errors.As(err, &seatsErr))
+}
+
+func TestUpdateTrip_BookedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	seats := 2
+
+	d.expectDriverTrip(ctx, editableTrip())
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{
+		{ID: "insc-1", UserRefID: 10, Status: entities.InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+	}, nil)
+	d.carRepo.EXPECT().FindByRefID(ctx, int64(400)).Return(&entities.Car{ID: "car-1", RefID: 400, LicensePlate: "AB-123-CD"}, nil)
+	// Two more passengers booked before the trip was locked to save the change
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		Seats:   &seats,
+		Changes: []entities.CreateTripChangeData{{Field: entities.TripChangeSeats, OldValue: "3", NewValue: "2"}},
+	}).Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Seats: &seats})
+
+	assert.Nil(t, result)
+	var seatsErr *domainerrors.SeatsBelowBookingsError
+	assert.True(t, errors.As(err, &seatsErr))
+}
+
+func TestUpdateTrip_PriceAboveCap(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	price := 3000
+
+	d.expectDriverTrip(ctx, editableTrip())
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{}, nil)
+	d.carRepo.EXPECT().FindByRefID(ctx, int64(400)).Return(&entities.Car{ID: "car-1", RefID: 400}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{PricePerSeat: &price})
+
+	assert.Nil(t, result)
+	var capErr *domainerrors.PriceAboveCapError
+	assert.True(t, errors.As(err, &capErr))
+}
+
+func TestUpdateTrip_CityVisitedTwice(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	city := "Paris"
+
+	d.expectDriverTrip(ctx, editableTrip())
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{ArrivalCity: &city})
+
+	assert.Nil(t, result)
+	var stopsErr *domainerrors.InvalidTripStopsError
+	assert.True(t, errors.As(err, &stopsErr))
+}
+
+func TestUpdateTrip_NotEditable(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	existing := editableTrip()
+	existing.Status = entities.TripStatusInProgress
+	seats := 4
+
+	d.expectDriverTrip(ctx, existing)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-1", UpdateTripData{Seats: &seats})
+
+	assert.Nil(t, result)
+	var editErr *domainerrors.TripNotEditableError
+	assert.True(t, errors.As(err, &editErr))
+}
+
+func TestUpdateTrip_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateTrip(t)
+	seats := 4
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(editableTrip(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-2").Return(&entities.Driver{ID: "driver-2", RefID: 999}, nil)
+
+	result, err := d.uc.Execute(ctx, "trip-1", "user-2", UpdateTripData{Seats: &seats})
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
@@ -0,0 +1,112 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+type CancelTripSeriesUseCase struct {
+	seriesRepository      repositories.TripSeriesRepository
+	driverRepository      repositories.DriverRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
+	userRepository        repositories.UserRepository
+	emailService          services.EmailService
+}
+
+func NewCancelTripSeriesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	driverRepository repositories.DriverRepository,
+	tripRepository repositories.TripRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+) *CancelTripSeriesUseCase {
+	return &CancelTripSeriesUseCase{
+		seriesRepository:      seriesRepository,
+		driverRepository:      driverRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
+		userRepository:        userRepository,
+		emailService:          emailService,
+	}
+}
+
+// Execute cancels the series and all its upcoming occurrences. Subscribers and passengers booked
+// on an occurrence are emailed once.
+func (uc *CancelTripSeriesUseCase) Execute(ctx context.Context, id, userID string, input dtos.CancelTripInput) (*entities.TripSeries, error) {
+	existing, _, err := findDriverSeries(ctx, uc.seriesRepository, uc.driverRepository, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming, err := uc.tripRepository.FindUpcomingBySeries(ctx, existing.RefID)
+	if err != nil {
+		return nil, err
+	}
+	subscribers, err := uc.seriesRepository.FindSubscriberRefIDs(ctx, existing.RefID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.seriesRepository.Cancel(ctx, id, input.Reason); err != nil {
+		return nil, err
+	}
+
+	passengers := make([]int64, 0, len(subscribers))
+	seen := map[int64]bool{}
+	addPassenger := func(userRefID int64) {
+		if !seen[userRefID] {
+			seen[userRefID] = true
+			passengers = append(passengers, userRefID)
+		}
+	}
+	for _, userRefID := range subscribers {
+		addPassenger(userRefID)
+	}
+	for _, trip := range upcoming {
+		cancelled, err := uc.tripRepository.Cancel(ctx, trip.ID, input.Reason)
+		if err != nil {
+			return nil, err
+		}
+		// An occurrence that left meanwhile is no longer cancelled
+		if !cancelled {
+			continue
+		}
+		inscriptions, err := uc.inscriptionRepository.FindByTripID(ctx, trip.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, inscription := range inscriptions {
+			if inscription.Status == entities.InscriptionStatusCancelledByDriver {
+				addPassenger(inscription.UserRefID)
+			}
+		}
+	}
+
+	for _, userRefID := range passengers {
+		uc.notifyPassenger(ctx, userRefID, existing, input.Reason)
+	}
+
+	existing.Status = entities.TripSeriesStatusCancelled
+	existing.CancellationReason = &input.Reason
+	return existing, nil
+}
+
+// notifyPassenger emails a passenger about the cancellation. The series is already cancelled, so a
+// passenger who cannot be reached does not fail the request.
+func (uc *CancelTripSeriesUseCase) notifyPassenger(ctx context.Context, userRefID int64, series *entities.TripSeries, reason string) {
+	user, err := uc.userRepository.FindByRefID(ctx, userRefID)
+	if err != nil || user == nil || user.AnonymizedAt != nil {
+		return
+	}
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+	_ = uc.emailService.SendTripSeriesCancelledEmail(user.Email, firstName, series.DepartureCity, series.ArrivalCity, reason)
+}
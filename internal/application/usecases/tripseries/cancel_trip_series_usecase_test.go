@@ -0,0 +1,85 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cancelSeriesDeps struct {
+	seriesRepo      *mocks.MockTripSeriesRepository
+	driverRepo      *mocks.MockDriverRepository
+	tripRepo        *mocks.MockTripRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+	uc              *CancelTripSeriesUseCase
+}
+
+func setupCancelSeries(t *testing.T) cancelSeriesDeps {
+	d := cancelSeriesDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
+	d.uc = NewCancelTripSeriesUseCase(d.seriesRepo, d.driverRepo, d.tripRepo, d.inscriptionRepo, d.userRepo, d.emailService)
+	return d
+}
+
+func cancelledBooking(id string, userRefID int64) entities.Inscription {
+	return entities.Inscription{ID: id, UserRefID: userRefID, Status: entities.InscriptionStatusCancelledByDriver, ToPosition: 1}
+}
+
+func TestCancelTripSeries_CancelsOccurrencesAndNotifiesOnce(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelSeries(t)
+	reason := "Changed jobs"
+	alice, bob := "Alice", "Bob"
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{
+		upcomingOccurrence("trip-1", 500, "2026-10-05", false),
+		upcomingOccurrence("trip-2", 501, "2026-10-12", false),
+	}, nil)
+	d.seriesRepo.EXPECT().FindSubscriberRefIDs(ctx, int64(40)).Return([]int64{10}, nil)
+	d.seriesRepo.EXPECT().Cancel(ctx, "series-1", reason).Return(nil)
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-1", reason).Return(true, nil)
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-2", reason).Return(true, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{cancelledBooking("insc-1", 10), cancelledBooking("insc-2", 11)}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-2").Return([]entities.Inscription{cancelledBooking("insc-3", 10)}, nil)
+	d.userRepo.EXPECT().FindByRefID(ctx, int64(10)).Return(&entities.PublicUser{User: entities.User{RefID: 10, FirstName: &alice}, Email: "alice@example.com"}, nil).Once()
+	d.userRepo.EXPECT().FindByRefID(ctx, int64(11)).Return(&entities.PublicUser{User: entities.User{RefID: 11, FirstName: &bob}, Email: "bob@example.com"}, nil).Once()
+	d.emailService.EXPECT().SendTripSeriesCancelledEmail("alice@example.com", "Alice", "Paris", "Lyon", reason).Return(nil).Once()
+	d.emailService.EXPECT().SendTripSeriesCancelledEmail("bob@example.com", "Bob", "Paris", "Lyon", reason).Return(errors.New("smtp down")).Once()
+
+	result, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.CancelTripInput{Reason: reason})
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripSeriesStatusCancelled, result.Status)
+	require.NotNil(t, result.CancellationReason)
+	assert.Equal(t, reason, *result.CancellationReason)
+}
+
+func TestCancelTripSeries_NotFound(t *testing.T) {
+	ctx := context.Background()
+	d := setupCancelSeries(t)
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, "nonexistent", "user-1", dtos.CancelTripInput{Reason: "Gone"})
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.TripSeriesNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
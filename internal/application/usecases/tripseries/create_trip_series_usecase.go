@@ -0,0 +1,142 @@
+package tripseries
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type CreateTripSeriesUseCase struct {
+	seriesRepository repositories.TripSeriesRepository
+	driverRepository repositories.DriverRepository
+	carRepository    repositories.CarRepository
+	cityRepository   repositories.CityRepository
+	pricing          entities.PricingRules
+	generator        *GenerateTripOccurrencesUseCase
+}
+
+func NewCreateTripSeriesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	driverRepository repositories.DriverRepository,
+	carRepository repositories.CarRepository,
+	cityRepository repositories.CityRepository,
+	pricing entities.PricingRules,
+	generator *GenerateTripOccurrencesUseCase,
+) *CreateTripSeriesUseCase {
+	return &CreateTripSeriesUseCase{
+		seriesRepository: seriesRepository,
+		driverRepository: driverRepository,
+		carRepository:    carRepository,
+		cityRepository:   cityRepository,
+		pricing:          pricing,
+		generator:        generator,
+	}
+}
+
+// Execute creates a recurring trip and materializes its first occurrences right away
+func (uc *CreateTripSeriesUseCase) Execute(ctx context.Context, userID string, input dtos.CreateTripSeriesInput) (*entities.TripSeries, error) {
+	driver, err := uc.driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, domainerrors.NewDriverNotFoundError(userID)
+	}
+
+	car, err := uc.carRepository.FindByID(ctx, input.CarID)
+	if err != nil {
+		return nil, err
+	}
+	if car == nil {
+		return nil, domainerrors.NewCarNotFoundError(input.CarID)
+	}
+	if err := authorization.EnsureDriverOwnsCar(driver, car); err != nil {
+		return nil, err
+	}
+
+	// Every occurrence is priced like a single trip
+	quote := uc.pricing.Quote(input.Kms, input.Seats, car.FuelConsumption)
+	price := quote.SuggestedPrice
+	if input.PricePerSeat != nil {
+		if !quote.Allows(*input.PricePerSeat) {
+			return nil, domainerrors.NewPriceAboveCapError(entities.FormatPrice(quote.MaxPrice, quote.Currency))
+		}
+		price = *input.PricePerSeat
+	}
+
+	if input.DepartureCity == input.ArrivalCity {
+		return nil, domainerrors.NewInvalidTripStopsError("each city can only appear once on the route")
+	}
+	interval := input.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	pattern := entities.TripSeries{
+		DepartureTime: input.DepartureTime,
+		Weekdays:      input.Weekdays,
+		Interval:      interval,
+		StartDate:     input.StartDate,
+		EndDate:       input.EndDate,
+		Exceptions:    input.Exceptions,
+	}
+	if err := pattern.Validate(); err != nil {
+		return nil, domainerrors.NewInvalidTripSeriesError(err.Error())
+	}
+
+	departure, err := uc.findOrCreateCity(ctx, input.DepartureCity)
+	if err != nil {
+		return nil, err
+	}
+	arrival, err := uc.findOrCreateCity(ctx, input.ArrivalCity)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := uc.seriesRepository.Create(ctx, entities.CreateTripSeriesData{
+		DriverRefID:        driver.RefID,
+		CarRefID:           car.RefID,
+		DepartureCityRefID: departure.RefID,
+		ArrivalCityRefID:   arrival.RefID,
+		Kms:                input.Kms,
+		Seats:              input.Seats,
+		PricePerSeat:       price,
+		Currency:           quote.Currency,
+		// Departure times are read on the departure city's clock
+		TimeZone:      departure.TimeZone,
+		DepartureTime: pattern.DepartureTime,
+		Weekdays:      pattern.Weekdays,
+		Interval:      pattern.Interval,
+		StartDate:     pattern.StartDate,
+		EndDate:       pattern.EndDate,
+		Exceptions:    pattern.Exceptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.generator.ExecuteSeries(ctx, series, time.Now()); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (uc *CreateTripSeriesUseCase) findOrCreateCity(ctx context.Context, cityName string) (*entities.City, error) {
+	city, err := uc.cityRepository.FindByCityName(ctx, cityName)
+	if err != nil {
+		return nil, err
+	}
+	if city != nil {
+		return city, nil
+	}
+
+	return uc.cityRepository.Create(ctx, entities.CreateCityData{
+		CityName: cityName,
+		Zipcode:  "",
+		TimeZone: entities.DefaultTimeZone,
+	})
+}
@@ -0,0 +1,148 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createSeriesDeps struct {
+	seriesRepo *mocks.MockTripSeriesRepository
+	driverRepo *mocks.MockDriverRepository
+	carRepo    *mocks.MockCarRepository
+	cityRepo   *mocks.MockCityRepository
+	uc         *CreateTripSeriesUseCase
+}
+
+func setupCreateSeries(t *testing.T) createSeriesDeps {
+	d := createSeriesDeps{
+		seriesRepo: mocks.NewMockTripSeriesRepository(t),
+		driverRepo: mocks.NewMockDriverRepository(t),
+		carRepo:    mocks.NewMockCarRepository(t),
+		cityRepo:   mocks.NewMockCityRepository(t),
+	}
+	generator := NewGenerateTripOccurrencesUseCase(d.seriesRepo, mocks.NewMockTripRepository(t), mocks.NewMockInscriptionRepository(t), 0)
+	d.uc = NewCreateTripSeriesUseCase(d.seriesRepo, d.driverRepo, d.carRepo, d.cityRepo, entities.PricingRules{Currency: "EUR", CostPerKm: 20}, generator)
+	return d
+}
+
+// pastSeriesInput describes a series that already ended, so creating it generates no occurrence
+func pastSeriesInput() dtos.CreateTripSeriesInput {
+	endDate := "2020-02-01"
+	return dtos.CreateTripSeriesInput{
+		Kms:           160,
+		Seats:         3,
+		CarID:         "car-1",
+		DepartureCity: "Paris",
+		ArrivalCity:   "Lyon",
+		DepartureTime: "07:30",
+		Weekdays:      []string{"MO", "FR"},
+		StartDate:     "2020-01-06",
+		EndDate:       &endDate,
+	}
+}
+
+func TestCreateTripSeries_Success(t *testing.T) {
+	ctx := context.Background()
+	d := setupCreateSeries(t)
+	input := pastSeriesInput()
+
+	paris := &entities.City{ID: "city-1", RefID: 1, CityName: "Paris", TimeZone: "Europe/Paris"}
+	lyon := &entities.City{ID: "city-2", RefID: 2, CityName: "Lyon", TimeZone: "Europe/Paris"}
+	created := &entities.TripSeries{ID: "series-1", RefID: 40, Weekdays: input.Weekdays, Interval: 1, StartDate: input.StartDate, EndDate: input.EndDate, TimeZone: "Europe/Paris", DepartureTime: "07:30"}
+
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 200, DriverRefID: 300}, nil)
+	d.cityRepo.EXPECT().FindByCityName(ctx, "Paris").Return(paris, nil)
+	d.cityRepo.EXPECT().FindByCityName(ctx, "Lyon").Return(lyon, nil)
+	d.seriesRepo.EXPECT().Create(ctx, entities.CreateTripSeriesData{
+		DriverRefID:        300,
+		CarRefID:           200,
+		DepartureCityRefID: 1,
+		ArrivalCityRefID:   2,
+		Kms:                160,
+		Seats:              3,
+		PricePerSeat:       800,
+		Currency:           "EUR",
+		TimeZone:           "Europe/Paris",
+		DepartureTime:      "07:30",
+		Weekdays:           []string{"MO", "FR"},
+		Interval:           1,
+		StartDate:          "2020-01-06",
+		EndDate:            input.EndDate,
+	}).Return(created, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "series-1", result.ID)
+}
+
+func TestCreateTripSeries_InvalidPattern(t *testing.T) {
+	ctx := context.Background()
+	d := setupCreateSeries(t)
+	input := pastSeriesInput()
+	input.DepartureTime = "7h30"
+
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 200, DriverRefID: 300}, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", input)
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.InvalidTripSeriesError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestCreateTripSeries_PriceAboveCap(t *testing.T) {
+	ctx := context.Background()
+	d := setupCreateSeries(t)
+	input := pastSeriesInput()
+	// A full car of three would pay more than the 32.00 EUR the trip costs
+	price := 1100
+	input.PricePerSeat = &price
+
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 200, DriverRefID: 300}, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", input)
+
+	assert.Nil(t, result)
+	var capErr *domainerrors.PriceAboveCapError
+	require.True(t, errors.As(err, &capErr))
+	assert.Contains(t, err.Error(), "10.66 EUR")
+}
+
+func TestCreateTripSeries_CarNotOwned(t *testing.T) {
+	ctx := context.Background()
+	d := setupCreateSeries(t)
+
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.carRepo.EXPECT().FindByID(ctx, "car-1").Return(&entities.Car{ID: "car-1", RefID: 200, DriverRefID: 999}, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", pastSeriesInput())
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
+func TestCreateTripSeries_DriverNotFound(t *testing.T) {
+	ctx := context.Background()
+	d := setupCreateSeries(t)
+
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(nil, nil)
+
+	result, err := d.uc.Execute(ctx, "user-1", pastSeriesInput())
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.DriverNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
@@ -0,0 +1,119 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type GenerateTripOccurrencesUseCase struct {
+	seriesRepository      repositories.TripSeriesRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
+	horizon               time.Duration
+}
+
+// NewGenerateTripOccurrencesUseCase creates occurrences departing up to horizon ahead
+func NewGenerateTripOccurrencesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	tripRepository repositories.TripRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+	horizon time.Duration,
+) *GenerateTripOccurrencesUseCase {
+	return &GenerateTripOccurrencesUseCase{
+		seriesRepository:      seriesRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
+		horizon:               horizon,
+	}
+}
+
+// Execute materializes the upcoming occurrences of every active series. A failing series does not
+// keep the others from being generated.
+func (uc *GenerateTripOccurrencesUseCase) Execute(ctx context.Context, now time.Time) error {
+	series, err := uc.seriesRepository.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range series {
+		if err := uc.ExecuteSeries(ctx, &series[i], now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExecuteSeries creates the occurrences of a series that do not exist yet and books its subscribers
+// on them. Occurrences already created, including cancelled ones, are left untouched.
+func (uc *GenerateTripOccurrencesUseCase) ExecuteSeries(ctx context.Context, series *entities.TripSeries, now time.Time) error {
+	occurrences := series.Occurrences(now, now.Add(uc.horizon))
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	subscribers, err := uc.seriesRepository.FindSubscriberRefIDs(ctx, series.RefID)
+	if err != nil {
+		return err
+	}
+
+	for _, occurrence := range occurrences {
+		date := occurrence.Date
+		trip, err := uc.tripRepository.Create(ctx, entities.CreateTripData{
+			DateTrip:         occurrence.Departure,
+			EstimatedArrival: entities.EstimateArrival(occurrence.Departure, series.Kms),
+			TimeZone:         series.TimeZone,
+			Kms:              series.Kms,
+			Seats:            series.Seats,
+			PricePerSeat:     series.PricePerSeat,
+			Currency:         series.Currency,
+			DriverRefID:      series.DriverRefID,
+			CarRefID:         series.CarRefID,
+			Stops:            []entities.CreateTripStopData{{CityRefID: series.DepartureCityRefID}, {CityRefID: series.ArrivalCityRefID}},
+			SeriesRefID:      &series.RefID,
+			OccurrenceDate:   &date,
+		})
+		if err != nil {
+			return err
+		}
+		if trip == nil {
+			continue
+		}
+
+		for _, userRefID := range subscribers {
+			if _, err := bookSeat(ctx, uc.inscriptionRepository, trip, userRefID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bookSeat books a subscriber on an occurrence for its whole route. Nothing is booked when the
+// subscriber already has a seat or the occurrence cannot be booked, which is checked again with the
+// occurrence locked so a passenger booking it at the same time cannot take the same seat.
+func bookSeat(
+	ctx context.Context,
+	inscriptionRepository repositories.InscriptionRepository,
+	trip *entities.Trip,
+	userRefID int64,
+) (*entities.Inscription, error) {
+	if !trip.IsBookable() {
+		return nil, nil
+	}
+	exists, err := inscriptionRepository.ExistsByUserAndTrip(ctx, userRefID, trip.RefID)
+	if err != nil || exists {
+		return nil, err
+	}
+
+	return inscriptionRepository.Book(ctx, trip, entities.CreateInscriptionData{
+		UserRefID:    userRefID,
+		TripRefID:    trip.RefID,
+		FromPosition: 0,
+		ToPosition:   trip.LastPosition(),
+	})
+}
@@ -0,0 +1,137 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// weeklySeries runs every Monday at 07:30 in Paris from Monday 5 October 2026
+func weeklySeries() *entities.TripSeries {
+	return &entities.TripSeries{
+		ID:                 "series-1",
+		RefID:              40,
+		DriverRefID:        300,
+		CarRefID:           200,
+		DepartureCityRefID: 1,
+		ArrivalCityRefID:   2,
+		DepartureCity:      "Paris",
+		ArrivalCity:        "Lyon",
+		Kms:                160,
+		Seats:              3,
+		TimeZone:           "Europe/Paris",
+		DepartureTime:      "07:30",
+		Weekdays:           []string{"MO"},
+		Interval:           1,
+		StartDate:          "2026-10-05",
+		Exceptions:         []string{},
+		Status:             entities.TripSeriesStatusActive,
+	}
+}
+
+func occurrenceData(series *entities.TripSeries, date string, departure time.Time) entities.CreateTripData {
+	return entities.CreateTripData{
+		DateTrip:         departure,
+		EstimatedArrival: entities.EstimateArrival(departure, series.Kms),
+		TimeZone:         series.TimeZone,
+		Kms:              series.Kms,
+		Seats:            series.Seats,
+		PricePerSeat:     series.PricePerSeat,
+		Currency:         series.Currency,
+		DriverRefID:      series.DriverRefID,
+		CarRefID:         series.CarRefID,
+		Stops:            []entities.CreateTripStopData{{CityRefID: series.DepartureCityRefID}, {CityRefID: series.ArrivalCityRefID}},
+		SeriesRefID:      &series.RefID,
+		OccurrenceDate:   &date,
+	}
+}
+
+type generateDeps struct {
+	seriesRepo      *mocks.MockTripSeriesRepository
+	tripRepo        *mocks.MockTripRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	uc              *GenerateTripOccurrencesUseCase
+}
+
+func setupGenerate(t *testing.T) generateDeps {
+	d := generateDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+	}
+	d.uc = NewGenerateTripOccurrencesUseCase(d.seriesRepo, d.tripRepo, d.inscriptionRepo, 14*24*time.Hour)
+	return d
+}
+
+func TestGenerateOccurrences_CreatesAndBooksSubscribers(t *testing.T) {
+	ctx := context.Background()
+	d := setupGenerate(t)
+	series := weeklySeries()
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	first := time.Date(2026, 10, 5, 5, 30, 0, 0, time.UTC)
+	second := time.Date(2026, 10, 12, 5, 30, 0, 0, time.UTC)
+	created := &entities.Trip{ID: "trip-1", RefID: 500, Seats: 3, Status: entities.TripStatusScheduled}
+	inscription := &entities.Inscription{ID: "insc-1", UserRefID: 10, TripRefID: 500, Status: entities.InscriptionStatusActive, ToPosition: 1}
+
+	d.seriesRepo.EXPECT().FindSubscriberRefIDs(ctx, int64(40)).Return([]int64{10}, nil)
+	d.tripRepo.EXPECT().Create(ctx, occurrenceData(series, "2026-10-05", first)).Return(created, nil)
+	// The second occurrence was generated by an earlier run
+	d.tripRepo.EXPECT().Create(ctx, occurrenceData(series, "2026-10-12", second)).Return(nil, nil)
+	d.inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(10), int64(500)).Return(false, nil)
+	d.inscriptionRepo.EXPECT().Book(ctx, created, entities.CreateInscriptionData{UserRefID: 10, TripRefID: 500, FromPosition: 0, ToPosition: 1}).Return(inscription, nil)
+
+	err := d.uc.ExecuteSeries(ctx, series, now)
+
+	require.NoError(t, err)
+}
+
+func TestGenerateOccurrences_SkipsFullOccurrence(t *testing.T) {
+	ctx := context.Background()
+	d := setupGenerate(t)
+	series := weeklySeries()
+	series.Seats = 1
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	d.uc = NewGenerateTripOccurrencesUseCase(d.seriesRepo, d.tripRepo, d.inscriptionRepo, 7*24*time.Hour)
+
+	departure := time.Date(2026, 10, 5, 5, 30, 0, 0, time.UTC)
+	created := &entities.Trip{ID: "trip-1", RefID: 500, Seats: 1, Status: entities.TripStatusScheduled}
+	inscription := &entities.Inscription{ID: "insc-1", UserRefID: 10, TripRefID: 500, Status: entities.InscriptionStatusActive, ToPosition: 1}
+
+	d.seriesRepo.EXPECT().FindSubscriberRefIDs(ctx, int64(40)).Return([]int64{10, 11}, nil)
+	d.tripRepo.EXPECT().Create(ctx, occurrenceData(series, "2026-10-05", departure)).Return(created, nil)
+	d.inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(10), int64(500)).Return(false, nil)
+	d.inscriptionRepo.EXPECT().Book(ctx, created, entities.CreateInscriptionData{UserRefID: 10, TripRefID: 500, FromPosition: 0, ToPosition: 1}).Return(inscription, nil)
+	// The first subscriber took the last seat, so the occurrence is skipped for the second
+	d.inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(11), int64(500)).Return(false, nil)
+	d.inscriptionRepo.EXPECT().Book(ctx, created, entities.CreateInscriptionData{UserRefID: 11, TripRefID: 500, FromPosition: 0, ToPosition: 1}).Return(nil, nil)
+
+	err := d.uc.ExecuteSeries(ctx, series, now)
+
+	require.NoError(t, err)
+}
+
+func TestGenerateOccurrences_ContinuesAfterFailingSeries(t *testing.T) {
+	ctx := context.Background()
+	d := setupGenerate(t)
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	failing := *weeklySeries()
+	ended := *weeklySeries()
+	ended.RefID = 41
+	endDate := "2026-09-30"
+	ended.EndDate = &endDate
+
+	d.seriesRepo.EXPECT().FindActive(ctx).Return([]entities.TripSeries{failing, ended}, nil)
+	d.seriesRepo.EXPECT().FindSubscriberRefIDs(ctx, int64(40)).Return(nil, errors.New("db down"))
+
+	err := d.uc.Execute(ctx, now)
+
+	assert.ErrorContains(t, err, "db down")
+}
@@ -0,0 +1,30 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type GetTripSeriesUseCase struct {
+	seriesRepository repositories.TripSeriesRepository
+}
+
+func NewGetTripSeriesUseCase(seriesRepository repositories.TripSeriesRepository) *GetTripSeriesUseCase {
+	return &GetTripSeriesUseCase{
+		seriesRepository: seriesRepository,
+	}
+}
+
+func (uc *GetTripSeriesUseCase) Execute(ctx context.Context, id string) (*entities.TripSeries, error) {
+	series, err := uc.seriesRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if series == nil {
+		return nil, domainerrors.NewTripSeriesNotFoundError(id)
+	}
+	return series, nil
+}
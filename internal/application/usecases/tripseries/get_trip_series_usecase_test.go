@@ -0,0 +1,37 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTripSeries_Success(t *testing.T) {
+	ctx := context.Background()
+	seriesRepo := mocks.NewMockTripSeriesRepository(t)
+	seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+
+	uc := NewGetTripSeriesUseCase(seriesRepo)
+	result, err := uc.Execute(ctx, "series-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Paris", result.DepartureCity)
+}
+
+func TestGetTripSeries_NotFound(t *testing.T) {
+	ctx := context.Background()
+	seriesRepo := mocks.NewMockTripSeriesRepository(t)
+	seriesRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	uc := NewGetTripSeriesUseCase(seriesRepo)
+	result, err := uc.Execute(ctx, "nonexistent")
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.TripSeriesNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
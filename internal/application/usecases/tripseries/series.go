@@ -0,0 +1,61 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// findDriverSeries loads an active series the driver behind userID offers, along with the driver.
+// Cancelled series cannot be changed anymore.
+func findDriverSeries(
+	ctx context.Context,
+	seriesRepository repositories.TripSeriesRepository,
+	driverRepository repositories.DriverRepository,
+	id, userID string,
+) (*entities.TripSeries, *entities.Driver, error) {
+	series, err := seriesRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if series == nil {
+		return nil, nil, domainerrors.NewTripSeriesNotFoundError(id)
+	}
+
+	driver, err := driverRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if driver == nil {
+		return nil, nil, domainerrors.NewDriverNotFoundError(userID)
+	}
+	if err := authorization.EnsureDriverOwnsTripSeries(driver, series); err != nil {
+		return nil, nil, err
+	}
+	if series.Status != entities.TripSeriesStatusActive {
+		return nil, nil, domainerrors.NewInvalidTripSeriesError("the series is cancelled")
+	}
+	return series, driver, nil
+}
+
+// refreshStatus moves an occurrence between SCHEDULED and FULL after its seats or bookings changed
+func refreshStatus(
+	ctx context.Context,
+	tripRepository repositories.TripRepository,
+	trip *entities.Trip,
+	bookings []entities.Inscription,
+) error {
+	if next := trip.StatusForBookings(bookings); next != trip.Status {
+		moved, err := tripRepository.UpdateStatus(ctx, trip.ID, trip.Status, next)
+		if err != nil {
+			return err
+		}
+		if moved {
+			trip.Status = next
+		}
+	}
+	return nil
+}
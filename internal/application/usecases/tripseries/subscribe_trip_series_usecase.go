@@ -0,0 +1,80 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type SubscribeTripSeriesUseCase struct {
+	seriesRepository      repositories.TripSeriesRepository
+	userRepository        repositories.UserRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
+}
+
+func NewSubscribeTripSeriesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	userRepository repositories.UserRepository,
+	tripRepository repositories.TripRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+) *SubscribeTripSeriesUseCase {
+	return &SubscribeTripSeriesUseCase{
+		seriesRepository:      seriesRepository,
+		userRepository:        userRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
+	}
+}
+
+// Execute subscribes a passenger to a series and books them on every upcoming occurrence with a
+// seat left. Later occurrences are booked as they are generated. It returns the bookings made.
+func (uc *SubscribeTripSeriesUseCase) Execute(ctx context.Context, id, userID string) ([]entities.Inscription, error) {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewUserNotFoundError(userID)
+	}
+
+	series, err := uc.seriesRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if series == nil {
+		return nil, domainerrors.NewTripSeriesNotFoundError(id)
+	}
+	if series.Status != entities.TripSeriesStatusActive {
+		return nil, domainerrors.NewInvalidTripSeriesError("the series is cancelled")
+	}
+
+	subscribed, err := uc.seriesRepository.IsSubscribed(ctx, series.RefID, user.RefID)
+	if err != nil {
+		return nil, err
+	}
+	if subscribed {
+		return nil, domainerrors.NewAlreadySubscribedError(id)
+	}
+	if err := uc.seriesRepository.Subscribe(ctx, series.RefID, user.RefID); err != nil {
+		return nil, err
+	}
+
+	upcoming, err := uc.tripRepository.FindUpcomingBySeries(ctx, series.RefID)
+	if err != nil {
+		return nil, err
+	}
+	booked := make([]entities.Inscription, 0, len(upcoming))
+	for i := range upcoming {
+		inscription, err := bookSeat(ctx, uc.inscriptionRepository, &upcoming[i], user.RefID)
+		if err != nil {
+			return nil, err
+		}
+		if inscription != nil {
+			booked = append(booked, *inscription)
+		}
+	}
+	return booked, nil
+}
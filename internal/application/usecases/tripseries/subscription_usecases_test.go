@@ -0,0 +1,133 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type subscriptionDeps struct {
+	seriesRepo      *mocks.MockTripSeriesRepository
+	userRepo        *mocks.MockUserRepository
+	tripRepo        *mocks.MockTripRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+}
+
+func setupSubscription(t *testing.T) subscriptionDeps {
+	return subscriptionDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+	}
+}
+
+func passenger() *entities.PublicUser {
+	return &entities.PublicUser{User: entities.User{ID: "user-2", RefID: 10}}
+}
+
+func TestSubscribeTripSeries_BooksUpcomingOccurrences(t *testing.T) {
+	ctx := context.Background()
+	d := setupSubscription(t)
+	uc := NewSubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+
+	open := upcomingOccurrence("trip-1", 500, "2026-10-05", false)
+	full := upcomingOccurrence("trip-2", 501, "2026-10-12", false)
+	full.Status = entities.TripStatusFull
+	inscription := &entities.Inscription{ID: "insc-1", UserRefID: 10, TripRefID: 500, Status: entities.InscriptionStatusActive, ToPosition: 1}
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-2").Return(passenger(), nil)
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.seriesRepo.EXPECT().IsSubscribed(ctx, int64(40), int64(10)).Return(false, nil)
+	d.seriesRepo.EXPECT().Subscribe(ctx, int64(40), int64(10)).Return(nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{open, full}, nil)
+	d.inscriptionRepo.EXPECT().ExistsByUserAndTrip(ctx, int64(10), int64(500)).Return(false, nil)
+	d.inscriptionRepo.EXPECT().Book(ctx, &open, entities.CreateInscriptionData{UserRefID: 10, TripRefID: 500, FromPosition: 0, ToPosition: 1}).Return(inscription, nil)
+
+	result, err := uc.Execute(ctx, "series-1", "user-2")
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "insc-1", result[0].ID)
+}
+
+func TestSubscribeTripSeries_AlreadySubscribed(t *testing.T) {
+	ctx := context.Background()
+	d := setupSubscription(t)
+	uc := NewSubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-2").Return(passenger(), nil)
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.seriesRepo.EXPECT().IsSubscribed(ctx, int64(40), int64(10)).Return(true, nil)
+
+	result, err := uc.Execute(ctx, "series-1", "user-2")
+
+	assert.Nil(t, result)
+	var subscribedErr *domainerrors.AlreadySubscribedError
+	assert.True(t, errors.As(err, &subscribedErr))
+}
+
+func TestSubscribeTripSeries_Cancelled(t *testing.T) {
+	ctx := context.Background()
+	d := setupSubscription(t)
+	uc := NewSubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+
+	cancelled := weeklySeries()
+	cancelled.Status = entities.TripSeriesStatusCancelled
+	d.userRepo.EXPECT().FindByID(ctx, "user-2").Return(passenger(), nil)
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(cancelled, nil)
+
+	result, err := uc.Execute(ctx, "series-1", "user-2")
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.InvalidTripSeriesError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestUnsubscribeTripSeries_FreesSeats(t *testing.T) {
+	ctx := context.Background()
+	d := setupSubscription(t)
+	uc := NewUnsubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+
+	full := upcomingOccurrence("trip-1", 500, "2026-10-05", false)
+	full.Seats = 1
+	full.Status = entities.TripStatusFull
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-2").Return(passenger(), nil)
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.seriesRepo.EXPECT().IsSubscribed(ctx, int64(40), int64(10)).Return(true, nil)
+	d.seriesRepo.EXPECT().Unsubscribe(ctx, int64(40), int64(10)).Return(nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{
+		full,
+		upcomingOccurrence("trip-2", 501, "2026-10-12", false),
+	}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{activeBooking("insc-1", 10)}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-2").Return([]entities.Inscription{activeBooking("insc-2", 11)}, nil)
+	d.inscriptionRepo.EXPECT().Delete(ctx, "insc-1").Return(nil)
+	d.tripRepo.EXPECT().UpdateStatus(ctx, "trip-1", entities.TripStatusFull, entities.TripStatusScheduled).Return(true, nil)
+
+	err := uc.Execute(ctx, "series-1", "user-2")
+
+	require.NoError(t, err)
+}
+
+func TestUnsubscribeTripSeries_NotSubscribed(t *testing.T) {
+	ctx := context.Background()
+	d := setupSubscription(t)
+	uc := NewUnsubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+
+	d.userRepo.EXPECT().FindByID(ctx, "user-2").Return(passenger(), nil)
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.seriesRepo.EXPECT().IsSubscribed(ctx, int64(40), int64(10)).Return(false, nil)
+
+	err := uc.Execute(ctx, "series-1", "user-2")
+
+	var notFoundErr *domainerrors.SubscriptionNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
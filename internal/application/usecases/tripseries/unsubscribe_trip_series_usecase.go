@@ -0,0 +1,91 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type UnsubscribeTripSeriesUseCase struct {
+	seriesRepository      repositories.TripSeriesRepository
+	userRepository        repositories.UserRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
+}
+
+func NewUnsubscribeTripSeriesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	userRepository repositories.UserRepository,
+	tripRepository repositories.TripRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+) *UnsubscribeTripSeriesUseCase {
+	return &UnsubscribeTripSeriesUseCase{
+		seriesRepository:      seriesRepository,
+		userRepository:        userRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
+	}
+}
+
+// Execute ends a passenger's subscription and frees their seat on every upcoming occurrence
+func (uc *UnsubscribeTripSeriesUseCase) Execute(ctx context.Context, id, userID string) error {
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domainerrors.NewUserNotFoundError(userID)
+	}
+
+	series, err := uc.seriesRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if series == nil {
+		return domainerrors.NewTripSeriesNotFoundError(id)
+	}
+
+	subscribed, err := uc.seriesRepository.IsSubscribed(ctx, series.RefID, user.RefID)
+	if err != nil {
+		return err
+	}
+	if !subscribed {
+		return domainerrors.NewSubscriptionNotFoundError(id)
+	}
+	if err := uc.seriesRepository.Unsubscribe(ctx, series.RefID, user.RefID); err != nil {
+		return err
+	}
+
+	upcoming, err := uc.tripRepository.FindUpcomingBySeries(ctx, series.RefID)
+	if err != nil {
+		return err
+	}
+	for i := range upcoming {
+		trip := &upcoming[i]
+		bookings, err := uc.inscriptionRepository.FindByTripID(ctx, trip.ID)
+		if err != nil {
+			return err
+		}
+
+		remaining := make([]entities.Inscription, 0, len(bookings))
+		for _, booking := range bookings {
+			if booking.UserRefID != user.RefID || !booking.HoldsSeat() {
+				remaining = append(remaining, booking)
+				continue
+			}
+			if err := uc.inscriptionRepository.Delete(ctx, booking.ID); err != nil {
+				return err
+			}
+		}
+		if len(remaining) == len(bookings) {
+			continue
+		}
+		// A seat freed on a full occurrence opens it to bookings again
+		if err := refreshStatus(ctx, uc.tripRepository, trip, remaining); err != nil {
+			return err
+		}
+	}
+	return nil
+}
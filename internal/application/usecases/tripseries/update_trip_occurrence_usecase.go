@@ -0,0 +1,59 @@
+package tripseries
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type UpdateTripOccurrenceUseCase struct {
+	seriesRepository repositories.TripSeriesRepository
+	driverRepository repositories.DriverRepository
+	tripRepository   repositories.TripRepository
+	updateTrip       *trip.UpdateTripUseCase
+}
+
+func NewUpdateTripOccurrenceUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	driverRepository repositories.DriverRepository,
+	tripRepository repositories.TripRepository,
+	updateTrip *trip.UpdateTripUseCase,
+) *UpdateTripOccurrenceUseCase {
+	return &UpdateTripOccurrenceUseCase{
+		seriesRepository: seriesRepository,
+		driverRepository: driverRepository,
+		tripRepository:   tripRepository,
+		updateTrip:       updateTrip,
+	}
+}
+
+// Execute moves or resizes a single occurrence under the same rules as any trip: the change is
+// recorded and booked passengers are told, and asked to confirm their seat again when the departure
+// moves. The occurrence then keeps its own departure time and seats when the series changes.
+func (uc *UpdateTripOccurrenceUseCase) Execute(ctx context.Context, seriesID, tripID, userID string, input dtos.UpdateTripOccurrenceInput) (*entities.Trip, error) {
+	series, driver, err := findDriverSeries(ctx, uc.seriesRepository, uc.driverRepository, seriesID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence, err := uc.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if occurrence == nil || occurrence.SeriesRefID == nil || *occurrence.SeriesRefID != series.RefID {
+		return nil, domainerrors.NewTripNotFoundError(tripID)
+	}
+	if occurrence.Status != entities.TripStatusScheduled && occurrence.Status != entities.TripStatusFull {
+		return nil, domainerrors.NewTripNotBookableError(tripID)
+	}
+
+	update, err := uc.updateTrip.Prepare(ctx, occurrence, driver, trip.UpdateTripData{Date: input.Date, Seats: input.Seats})
+	if err != nil {
+		return nil, err
+	}
+	return uc.updateTrip.Save(ctx, update)
+}
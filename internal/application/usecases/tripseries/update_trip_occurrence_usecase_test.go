@@ -0,0 +1,132 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type updateOccurrenceDeps struct {
+	seriesRepo      *mocks.MockTripSeriesRepository
+	driverRepo      *mocks.MockDriverRepository
+	tripRepo        *mocks.MockTripRepository
+	carRepo         *mocks.MockCarRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+	uc              *UpdateTripOccurrenceUseCase
+}
+
+func setupUpdateOccurrence(t *testing.T) updateOccurrenceDeps {
+	d := updateOccurrenceDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		carRepo:         mocks.NewMockCarRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
+	d.uc = NewUpdateTripOccurrenceUseCase(d.seriesRepo, d.driverRepo, d.tripRepo,
+		newUpdateTrip(d.tripRepo, d.driverRepo, d.carRepo, d.inscriptionRepo, d.userRepo, d.emailService))
+	d.seriesRepo.EXPECT().FindByID(context.Background(), "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(context.Background(), "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	return d
+}
+
+func scheduledOccurrence() *entities.Trip {
+	trip := upcomingOccurrence("trip-1", 500, "2026-10-05", false)
+	return &trip
+}
+
+func TestUpdateTripOccurrence_MovesDeparture(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateOccurrence(t)
+	date := "2026-10-05T09:00"
+	overridden := true
+
+	// 09:00 in Paris is 07:00 UTC, and the arrival moves by the same hour and a half
+	departure := time.Date(2026, 10, 5, 7, 0, 0, 0, time.UTC)
+	arrival := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	moved := scheduledOccurrence()
+	moved.DateTrip, moved.EstimatedArrival, moved.SeriesOverridden = departure, arrival, true
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(scheduledOccurrence(), nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{activeBooking("insc-1", 10)}, nil)
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		DateTrip:          &departure,
+		StopShift:         90 * time.Minute,
+		EstimatedArrival:  &arrival,
+		ReconfirmBookings: true,
+		SeriesOverridden:  &overridden,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeDate, OldValue: "2026-10-05 07:30 CEST", NewValue: "2026-10-05 09:00 CEST"},
+			{Field: entities.TripChangeEstimatedArrival, OldValue: "2026-10-05 09:30 CEST", NewValue: "2026-10-05 11:00 CEST"},
+		},
+	}).Return(moved, nil)
+	expectPassenger(ctx, d.userRepo, 10, "alice@example.com", "Alice")
+	d.emailService.EXPECT().SendTripChangedEmail("alice@example.com", "Alice", moved.LocalDateTrip, []string{
+		"date: 2026-10-05 07:30 CEST → 2026-10-05 09:00 CEST",
+		"estimatedArrival: 2026-10-05 09:30 CEST → 2026-10-05 11:00 CEST",
+	}, true).Return(nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "trip-1", "user-1", dtos.UpdateTripOccurrenceInput{Date: &date})
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip-1", result.ID)
+	assert.True(t, result.SeriesOverridden)
+}
+
+func TestUpdateTripOccurrence_SeatsBelowBookings(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateOccurrence(t)
+	seats := 1
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(scheduledOccurrence(), nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{activeBooking("insc-1", 10), activeBooking("insc-2", 11)}, nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "trip-1", "user-1", dtos.UpdateTripOccurrenceInput{Seats: &seats})
+
+	assert.Nil(t, result)
+	var seatsErr *domainerrors.SeatsBelowBookingsError
+	assert.True(t, errors.As(err, &seatsErr))
+}
+
+func TestUpdateTripOccurrence_OtherSeries(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateOccurrence(t)
+	seats := 2
+
+	other := scheduledOccurrence()
+	otherSeries := int64(41)
+	other.SeriesRefID = &otherSeries
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(other, nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "trip-1", "user-1", dtos.UpdateTripOccurrenceInput{Seats: &seats})
+
+	assert.Nil(t, result)
+	var notFoundErr *domainerrors.TripNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestUpdateTripOccurrence_InvalidDate(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateOccurrence(t)
+	date := "next monday"
+
+	d.tripRepo.EXPECT().FindByID(ctx, "trip-1").Return(scheduledOccurrence(), nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "trip-1", "user-1", dtos.UpdateTripOccurrenceInput{Date: &date})
+
+	assert.Nil(t, result)
+	var dateErr *domainerrors.InvalidTripDateError
+	assert.True(t, errors.As(err, &dateErr))
+}
@@ -0,0 +1,168 @@
+package tripseries
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// removedDayReason is the cancellation reason of occurrences on days the series no longer runs
+const removedDayReason = "The driver no longer runs this trip on that day"
+
+type UpdateTripSeriesUseCase struct {
+	seriesRepository      repositories.TripSeriesRepository
+	driverRepository      repositories.DriverRepository
+	tripRepository        repositories.TripRepository
+	inscriptionRepository repositories.InscriptionRepository
+	userRepository        repositories.UserRepository
+	emailService          services.EmailService
+	updateTrip            *trip.UpdateTripUseCase
+	generator             *GenerateTripOccurrencesUseCase
+}
+
+func NewUpdateTripSeriesUseCase(
+	seriesRepository repositories.TripSeriesRepository,
+	driverRepository repositories.DriverRepository,
+	tripRepository repositories.TripRepository,
+	inscriptionRepository repositories.InscriptionRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+	updateTrip *trip.UpdateTripUseCase,
+	generator *GenerateTripOccurrencesUseCase,
+) *UpdateTripSeriesUseCase {
+	return &UpdateTripSeriesUseCase{
+		seriesRepository:      seriesRepository,
+		driverRepository:      driverRepository,
+		tripRepository:        tripRepository,
+		inscriptionRepository: inscriptionRepository,
+		userRepository:        userRepository,
+		emailService:          emailService,
+		updateTrip:            updateTrip,
+		generator:             generator,
+	}
+}
+
+// Execute changes the whole series. Upcoming occurrences the driver has not edited one by one
+// follow the new pattern under the trip editing rules: they are moved to the new departure time and
+// their passengers told, and they are cancelled when the series no longer runs on their day.
+func (uc *UpdateTripSeriesUseCase) Execute(ctx context.Context, id, userID string, input dtos.UpdateTripSeriesInput) (*entities.TripSeries, error) {
+	existing, driver, err := findDriverSeries(ctx, uc.seriesRepository, uc.driverRepository, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *existing
+	if input.Seats != nil {
+		merged.Seats = *input.Seats
+	}
+	if input.DepartureTime != nil {
+		merged.DepartureTime = *input.DepartureTime
+	}
+	if input.Weekdays != nil {
+		merged.Weekdays = input.Weekdays
+	}
+	if input.Interval != nil {
+		merged.Interval = *input.Interval
+	}
+	if input.EndDate != nil {
+		merged.EndDate = input.EndDate
+	}
+	if input.Exceptions != nil {
+		merged.Exceptions = input.Exceptions
+	}
+	if err := merged.Validate(); err != nil {
+		return nil, domainerrors.NewInvalidTripSeriesError(err.Error())
+	}
+
+	upcoming, err := uc.tripRepository.FindUpcomingBySeries(ctx, existing.RefID)
+	if err != nil {
+		return nil, err
+	}
+	// Every kept occurrence is checked under the trip editing rules before anything is saved
+	var removed []entities.Trip
+	var updates []*trip.TripUpdate
+	for i := range upcoming {
+		occurrence := &upcoming[i]
+		if occurrence.SeriesOverridden || occurrence.OccurrenceDate == nil {
+			continue
+		}
+		if !merged.RunsOn(*occurrence.OccurrenceDate) {
+			removed = append(removed, *occurrence)
+			continue
+		}
+		departure := merged.OccurrenceOn(*occurrence.OccurrenceDate).Departure
+		update, err := uc.updateTrip.Prepare(ctx, occurrence, driver, trip.UpdateTripData{
+			Departure:    &departure,
+			Seats:        &merged.Seats,
+			FollowSeries: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+
+	updated, err := uc.seriesRepository.Update(ctx, id, entities.UpdateTripSeriesData{
+		Seats:         input.Seats,
+		DepartureTime: input.DepartureTime,
+		Weekdays:      input.Weekdays,
+		Interval:      input.Interval,
+		EndDate:       input.EndDate,
+		Exceptions:    input.Exceptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range removed {
+		occurrence := &removed[i]
+		cancelled, err := uc.tripRepository.Cancel(ctx, occurrence.ID, removedDayReason)
+		if err != nil {
+			return nil, err
+		}
+		// An occurrence that left meanwhile is no longer cancelled
+		if !cancelled {
+			continue
+		}
+		inscriptions, err := uc.inscriptionRepository.FindByTripID(ctx, occurrence.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, inscription := range inscriptions {
+			if inscription.Status == entities.InscriptionStatusCancelledByDriver {
+				uc.notifyPassenger(ctx, inscription.UserRefID, occurrence)
+			}
+		}
+	}
+	for _, update := range updates {
+		if _, err := uc.updateTrip.Save(ctx, update); err != nil {
+			return nil, err
+		}
+	}
+
+	// Days added to the pattern get their occurrences right away
+	if err := uc.generator.ExecuteSeries(ctx, updated, time.Now()); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// notifyPassenger emails a passenger whose occurrence was cancelled. The series is already changed,
+// so a passenger who cannot be reached does not fail the request.
+func (uc *UpdateTripSeriesUseCase) notifyPassenger(ctx context.Context, userRefID int64, occurrence *entities.Trip) {
+	user, err := uc.userRepository.FindByRefID(ctx, userRefID)
+	if err != nil || user == nil || user.AnonymizedAt != nil {
+		return
+	}
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+	_ = uc.emailService.SendTripCancelledEmail(user.Email, firstName, occurrence.LocalDateTrip, removedDayReason)
+}
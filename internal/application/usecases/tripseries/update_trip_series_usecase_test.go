@@ -0,0 +1,222 @@
+package tripseries
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type updateSeriesDeps struct {
+	seriesRepo      *mocks.MockTripSeriesRepository
+	driverRepo      *mocks.MockDriverRepository
+	tripRepo        *mocks.MockTripRepository
+	carRepo         *mocks.MockCarRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+	uc              *UpdateTripSeriesUseCase
+}
+
+func setupUpdateSeries(t *testing.T) updateSeriesDeps {
+	d := updateSeriesDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		carRepo:         mocks.NewMockCarRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
+	// A zero horizon keeps the generator from creating occurrences in these tests
+	generator := NewGenerateTripOccurrencesUseCase(d.seriesRepo, d.tripRepo, d.inscriptionRepo, 0)
+	d.uc = NewUpdateTripSeriesUseCase(d.seriesRepo, d.driverRepo, d.tripRepo, d.inscriptionRepo, d.userRepo, d.emailService,
+		newUpdateTrip(d.tripRepo, d.driverRepo, d.carRepo, d.inscriptionRepo, d.userRepo, d.emailService), generator)
+	return d
+}
+
+// newUpdateTrip builds the trip editing rules series edits go through. Occurrences never change
+// cities, so no city repository is needed.
+func newUpdateTrip(
+	tripRepo *mocks.MockTripRepository,
+	driverRepo *mocks.MockDriverRepository,
+	carRepo *mocks.MockCarRepository,
+	inscriptionRepo *mocks.MockInscriptionRepository,
+	userRepo *mocks.MockUserRepository,
+	emailService *mocks.MockEmailService,
+) *trip.UpdateTripUseCase {
+	return trip.NewUpdateTripUseCase(tripRepo, driverRepo, carRepo, nil, inscriptionRepo, userRepo, emailService,
+		entities.PricingRules{Currency: "EUR", CostPerKm: 20})
+}
+
+// upcomingOccurrence is an occurrence of weeklySeries, leaving at 07:30 in Paris and arriving two hours later
+func upcomingOccurrence(id string, refID int64, date string, overridden bool) entities.Trip {
+	seriesRefID := int64(40)
+	departure := weeklySeries().OccurrenceOn(date).Departure
+	paris, _ := time.LoadLocation("Europe/Paris")
+	return entities.Trip{
+		ID: id, RefID: refID, Kms: 160, Seats: 3, DriverRefID: 300, CarRefID: 200, Currency: "EUR",
+		DateTrip: departure, LocalDateTrip: departure.In(paris), EstimatedArrival: departure.Add(2 * time.Hour),
+		TimeZone: "Europe/Paris", Status: entities.TripStatusScheduled, SeriesRefID: &seriesRefID,
+		OccurrenceDate: &date, SeriesOverridden: overridden,
+	}
+}
+
+func activeBooking(id string, userRefID int64) entities.Inscription {
+	return entities.Inscription{ID: id, UserRefID: userRefID, Status: entities.InscriptionStatusActive, ToPosition: 1}
+}
+
+func expectPassenger(ctx context.Context, userRepo *mocks.MockUserRepository, userRefID int64, email, firstName string) {
+	userRepo.EXPECT().FindByRefID(ctx, userRefID).Return(&entities.PublicUser{
+		User: entities.User{RefID: userRefID, FirstName: &firstName}, Email: email,
+	}, nil)
+}
+
+func TestUpdateTripSeries_MovesFollowingOccurrences(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+	departureTime := "08:00"
+	seats := 2
+
+	updated := weeklySeries()
+	updated.DepartureTime = departureTime
+	updated.Seats = seats
+	departure := time.Date(2026, 10, 5, 6, 0, 0, 0, time.UTC)
+	arrival := departure.Add(2 * time.Hour)
+	moved := upcomingOccurrence("trip-1", 500, "2026-10-05", false)
+	moved.DateTrip, moved.EstimatedArrival, moved.Seats = departure, arrival, seats
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{
+		upcomingOccurrence("trip-1", 500, "2026-10-05", false),
+		// Edited on its own, so it keeps its departure time and seats
+		upcomingOccurrence("trip-2", 501, "2026-10-12", true),
+	}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{activeBooking("insc-1", 10)}, nil)
+	d.carRepo.EXPECT().FindByRefID(ctx, int64(200)).Return(&entities.Car{ID: "car-1", RefID: 200}, nil)
+	d.seriesRepo.EXPECT().Update(ctx, "series-1", entities.UpdateTripSeriesData{Seats: &seats, DepartureTime: &departureTime}).Return(updated, nil)
+	// The occurrence follows the series, so it is not marked as changed on its own
+	d.tripRepo.EXPECT().Update(ctx, "trip-1", entities.UpdateTripData{
+		DateTrip:          &departure,
+		StopShift:         30 * time.Minute,
+		EstimatedArrival:  &arrival,
+		Seats:             &seats,
+		ReconfirmBookings: true,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeDate, OldValue: "2026-10-05 07:30 CEST", NewValue: "2026-10-05 08:00 CEST"},
+			{Field: entities.TripChangeEstimatedArrival, OldValue: "2026-10-05 09:30 CEST", NewValue: "2026-10-05 10:00 CEST"},
+			{Field: entities.TripChangeSeats, OldValue: "3", NewValue: "2"},
+		},
+	}).Return(&moved, nil)
+	expectPassenger(ctx, d.userRepo, 10, "alice@example.com", "Alice")
+	d.emailService.EXPECT().SendTripChangedEmail("alice@example.com", "Alice", moved.LocalDateTrip, []string{
+		"date: 2026-10-05 07:30 CEST → 2026-10-05 08:00 CEST",
+		"estimatedArrival: 2026-10-05 09:30 CEST → 2026-10-05 10:00 CEST",
+		"seats: 3 → 2",
+	}, true).Return(nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Seats: &seats, DepartureTime: &departureTime})
+
+	require.NoError(t, err)
+	assert.Equal(t, "08:00", result.DepartureTime)
+}
+
+func TestUpdateTripSeries_CancelsRemovedDays(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+
+	updated := weeklySeries()
+	updated.Weekdays = []string{"TU"}
+	removed := upcomingOccurrence("trip-1", 500, "2026-10-05", false)
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{removed}, nil)
+	d.seriesRepo.EXPECT().Update(ctx, "series-1", entities.UpdateTripSeriesData{Weekdays: []string{"TU"}}).Return(updated, nil)
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-1", removedDayReason).Return(true, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{cancelledBooking("insc-1", 10)}, nil)
+	expectPassenger(ctx, d.userRepo, 10, "alice@example.com", "Alice")
+	d.emailService.EXPECT().SendTripCancelledEmail("alice@example.com", "Alice", removed.LocalDateTrip, removedDayReason).Return(nil)
+
+	_, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Weekdays: []string{"TU"}})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateTripSeries_RemovedDayLeftMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+
+	updated := weeklySeries()
+	updated.Weekdays = []string{"TU"}
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{upcomingOccurrence("trip-1", 500, "2026-10-05", false)}, nil)
+	d.seriesRepo.EXPECT().Update(ctx, "series-1", entities.UpdateTripSeriesData{Weekdays: []string{"TU"}}).Return(updated, nil)
+	// The occurrence left before it could be cancelled, so nobody is told it was
+	d.tripRepo.EXPECT().Cancel(ctx, "trip-1", removedDayReason).Return(false, nil)
+
+	_, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Weekdays: []string{"TU"}})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateTripSeries_SeatsBelowBookings(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+	seats := 1
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(ctx, int64(40)).Return([]entities.Trip{upcomingOccurrence("trip-1", 500, "2026-10-05", false)}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(ctx, "trip-1").Return([]entities.Inscription{activeBooking("insc-1", 10), activeBooking("insc-2", 11)}, nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Seats: &seats})
+
+	assert.Nil(t, result)
+	var seatsErr *domainerrors.SeatsBelowBookingsError
+	assert.True(t, errors.As(err, &seatsErr))
+}
+
+func TestUpdateTripSeries_Cancelled(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+	seats := 2
+
+	cancelled := weeklySeries()
+	cancelled.Status = entities.TripSeriesStatusCancelled
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(cancelled, nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-1", RefID: 300}, nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Seats: &seats})
+
+	assert.Nil(t, result)
+	var invalidErr *domainerrors.InvalidTripSeriesError
+	assert.True(t, errors.As(err, &invalidErr))
+}
+
+func TestUpdateTripSeries_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	d := setupUpdateSeries(t)
+	seats := 2
+
+	d.seriesRepo.EXPECT().FindByID(ctx, "series-1").Return(weeklySeries(), nil)
+	d.driverRepo.EXPECT().FindByUserID(ctx, "user-1").Return(&entities.Driver{ID: "driver-2", RefID: 999}, nil)
+
+	result, err := d.uc.Execute(ctx, "series-1", "user-1", dtos.UpdateTripSeriesInput{Seats: &seats})
+
+	assert.Nil(t, result)
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type BanUserUseCase struct {
+	userRepository         repositories.UserRepository
+	authRepository         repositories.AuthRepository
+	tokenVersionRepository repositories.TokenVersionRepository
+}
+
+func NewBanUserUseCase(
+	userRepository repositories.UserRepository,
+	authRepository repositories.AuthRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+) *BanUserUseCase {
+	return &BanUserUseCase{
+		userRepository:         userRepository,
+		authRepository:         authRepository,
+		tokenVersionRepository: tokenVersionRepository,
+	}
+}
+
+// Execute blocks logins and API access until the account is reinstated
+func (uc *BanUserUseCase) Execute(ctx context.Context, actorID, id string, input dtos.BanUserInput) error {
+	user, err := findModeratedUser(ctx, uc.userRepository, actorID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.authRepository.Ban(ctx, user.AuthRefID, input.Reason); err != nil {
+		return err
+	}
+	return uc.tokenVersionRepository.Invalidate(ctx, user.ID)
+}
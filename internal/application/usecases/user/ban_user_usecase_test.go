@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBanUser_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().Ban(ctx, int64(100), "fraud").Return(nil)
+	versions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+
+	uc := NewBanUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1", dtos.BanUserInput{Reason: "fraud"})
+
+	require.NoError(t, err)
+}
+
+func TestBanUser_NotFound(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	userRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	uc := NewBanUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "nonexistent", dtos.BanUserInput{Reason: "fraud"})
+
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// findModeratedUser loads the user an administrator acts on. Administrators cannot change
+// the role or the status of their own account, so they cannot lock themselves out.
+func findModeratedUser(ctx context.Context, userRepository repositories.UserRepository, actorID, id string) (*entities.PublicUser, error) {
+	if actorID == id {
+		return nil, domainerrors.NewForbiddenError("user", id)
+	}
+	user, err := userRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domainerrors.NewUserNotFoundError(id)
+	}
+	return user, nil
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type ReinstateUserUseCase struct {
+	userRepository         repositories.UserRepository
+	authRepository         repositories.AuthRepository
+	tokenVersionRepository repositories.TokenVersionRepository
+}
+
+func NewReinstateUserUseCase(
+	userRepository repositories.UserRepository,
+	authRepository repositories.AuthRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+) *ReinstateUserUseCase {
+	return &ReinstateUserUseCase{
+		userRepository:         userRepository,
+		authRepository:         authRepository,
+		tokenVersionRepository: tokenVersionRepository,
+	}
+}
+
+// Execute lifts any suspension or ban on the user's account
+func (uc *ReinstateUserUseCase) Execute(ctx context.Context, actorID, id string) error {
+	user, err := findModeratedUser(ctx, uc.userRepository, actorID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.authRepository.Reinstate(ctx, user.AuthRefID); err != nil {
+		return err
+	}
+	return uc.tokenVersionRepository.Invalidate(ctx, user.ID)
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReinstateUser_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().Reinstate(ctx, int64(100)).Return(nil)
+	versions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+
+	uc := NewReinstateUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1")
+
+	require.NoError(t, err)
+}
+
+func TestReinstateUser_OwnAccount(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	uc := NewReinstateUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "admin-1")
+
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type SuspendUserUseCase struct {
+	userRepository         repositories.UserRepository
+	authRepository         repositories.AuthRepository
+	tokenVersionRepository repositories.TokenVersionRepository
+}
+
+func NewSuspendUserUseCase(
+	userRepository repositories.UserRepository,
+	authRepository repositories.AuthRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+) *SuspendUserUseCase {
+	return &SuspendUserUseCase{
+		userRepository:         userRepository,
+		authRepository:         authRepository,
+		tokenVersionRepository: tokenVersionRepository,
+	}
+}
+
+// Execute blocks logins and API access for the given number of days. A new suspension
+// replaces any current one.
+func (uc *SuspendUserUseCase) Execute(ctx context.Context, actorID, id string, input dtos.SuspendUserInput) error {
+	user, err := findModeratedUser(ctx, uc.userRepository, actorID, id)
+	if err != nil {
+		return err
+	}
+
+	until := time.Now().AddDate(0, 0, input.Days)
+	if err := uc.authRepository.Suspend(ctx, user.AuthRefID, until, input.Reason); err != nil {
+		return err
+	}
+	return uc.tokenVersionRepository.Invalidate(ctx, user.ID)
+}
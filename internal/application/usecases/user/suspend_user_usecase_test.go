@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendUser_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+	expectedUntil := time.Now().AddDate(0, 0, 7)
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().Suspend(ctx, int64(100), mock.MatchedBy(func(until time.Time) bool {
+		return until.Sub(expectedUntil).Abs() < time.Minute
+	}), "spam").Return(nil)
+	versions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+
+	uc := NewSuspendUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1", dtos.SuspendUserInput{Days: 7, Reason: "spam"})
+
+	require.NoError(t, err)
+}
+
+func TestSuspendUser_OwnAccount(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	uc := NewSuspendUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "admin-1", dtos.SuspendUserInput{Days: 7, Reason: "spam"})
+
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
+func TestSuspendUser_RepoError(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+	repoErr := errors.New("database error")
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().Suspend(ctx, int64(100), mock.Anything, "spam").Return(repoErr)
+
+	uc := NewSuspendUserUseCase(userRepo, authRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1", dtos.SuspendUserInput{Days: 7, Reason: "spam"})
+
+	assert.Equal(t, repoErr, err)
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type UnlockUserUseCase struct {
+	userRepository repositories.UserRepository
+	authRepository repositories.AuthRepository
+}
+
+func NewUnlockUserUseCase(userRepository repositories.UserRepository, authRepository repositories.AuthRepository) *UnlockUserUseCase {
+	return &UnlockUserUseCase{
+		userRepository: userRepository,
+		authRepository: authRepository,
+	}
+}
+
+// Execute clears the failed login counter and any lock on the user's account
+func (uc *UnlockUserUseCase) Execute(ctx context.Context, id string) error {
+	user, err := uc.userRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domainerrors.NewUserNotFoundError(id)
+	}
+
+	return uc.authRepository.ResetFailedLogins(ctx, user.AuthRefID)
+}
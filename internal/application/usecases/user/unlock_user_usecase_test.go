@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnlockUser_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().ResetFailedLogins(ctx, int64(100)).Return(nil)
+
+	uc := NewUnlockUserUseCase(userRepo, authRepo)
+	err := uc.Execute(ctx, "user-1")
+
+	require.NoError(t, err)
+}
+
+func TestUnlockUser_NotFound(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+
+	userRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	uc := NewUnlockUserUseCase(userRepo, authRepo)
+	err := uc.Execute(ctx, "nonexistent")
+
+	require.Error(t, err)
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestUnlockUser_RepoError(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+	repoErr := errors.New("database error")
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	authRepo.EXPECT().ResetFailedLogins(ctx, int64(100)).Return(repoErr)
+
+	uc := NewUnlockUserUseCase(userRepo, authRepo)
+	err := uc.Execute(ctx, "user-1")
+
+	assert.Equal(t, repoErr, err)
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+type UpdateUserRoleUseCase struct {
+	userRepository         repositories.UserRepository
+	authRepository         repositories.AuthRepository
+	roleRepository         repositories.RoleRepository
+	tokenVersionRepository repositories.TokenVersionRepository
+}
+
+func NewUpdateUserRoleUseCase(
+	userRepository repositories.UserRepository,
+	authRepository repositories.AuthRepository,
+	roleRepository repositories.RoleRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+) *UpdateUserRoleUseCase {
+	return &UpdateUserRoleUseCase{
+		userRepository:         userRepository,
+		authRepository:         authRepository,
+		roleRepository:         roleRepository,
+		tokenVersionRepository: tokenVersionRepository,
+	}
+}
+
+// Execute assigns one of the roles defined in the database. Access tokens issued with the
+// previous role stop being accepted.
+func (uc *UpdateUserRoleUseCase) Execute(ctx context.Context, actorID, id string, input dtos.UpdateUserRoleInput) error {
+	user, err := findModeratedUser(ctx, uc.userRepository, actorID, id)
+	if err != nil {
+		return err
+	}
+
+	role, err := uc.roleRepository.FindByName(ctx, input.Role)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return domainerrors.NewRoleNotFoundError(input.Role)
+	}
+
+	if err := uc.authRepository.UpdateRole(ctx, user.AuthRefID, role.Name); err != nil {
+		return err
+	}
+	return uc.tokenVersionRepository.Invalidate(ctx, user.ID)
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUserRole_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	roleRepo.EXPECT().FindByName(ctx, "DRIVER").Return(&entities.Role{Name: "DRIVER"}, nil)
+	authRepo.EXPECT().UpdateRole(ctx, int64(100), "DRIVER").Return(nil)
+	versions.EXPECT().Invalidate(ctx, "user-1").Return(nil)
+
+	uc := NewUpdateUserRoleUseCase(userRepo, authRepo, roleRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1", dtos.UpdateUserRoleInput{Role: "DRIVER"})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateUserRole_UnknownRole(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	user := &entities.PublicUser{User: entities.User{ID: "user-1", RefID: 200, AuthRefID: 100}}
+
+	userRepo.EXPECT().FindByID(ctx, "user-1").Return(user, nil)
+	roleRepo.EXPECT().FindByName(ctx, "SUPERUSER").Return(nil, nil)
+
+	uc := NewUpdateUserRoleUseCase(userRepo, authRepo, roleRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "user-1", dtos.UpdateUserRoleInput{Role: "SUPERUSER"})
+
+	var roleErr *domainerrors.RoleNotFoundError
+	assert.True(t, errors.As(err, &roleErr))
+}
+
+func TestUpdateUserRole_OwnAccount(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	uc := NewUpdateUserRoleUseCase(userRepo, authRepo, roleRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "admin-1", dtos.UpdateUserRoleInput{Role: "USER"})
+
+	var forbiddenErr *domainerrors.ForbiddenError
+	assert.True(t, errors.As(err, &forbiddenErr))
+}
+
+func TestUpdateUserRole_NotFound(t *testing.T) {
+	ctx := context.Background()
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+
+	userRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+	uc := NewUpdateUserRoleUseCase(userRepo, authRepo, roleRepo, versions)
+	err := uc.Execute(ctx, "admin-1", "nonexistent", dtos.UpdateUserRoleInput{Role: "USER"})
+
+	var notFoundErr *domainerrors.UserNotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
@@ -0,0 +1,19 @@
+package authorization
+
+import (
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+)
+
+// EnsureAccountActive refuses banned accounts and accounts suspended at the given time
+func EnsureAccountActive(status entities.AccountStatus, now time.Time) error {
+	if status.IsBanned() {
+		return domainerrors.NewAccountBannedError()
+	}
+	if status.IsSuspended(now) {
+		return domainerrors.NewAccountSuspendedError(*status.SuspendedUntil)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package authorization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureAccountActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	assert.NoError(t, EnsureAccountActive(entities.AccountStatus{}, now))
+	assert.NoError(t, EnsureAccountActive(entities.AccountStatus{SuspendedUntil: &past}, now), "an elapsed suspension no longer applies")
+
+	var suspended *domainerrors.AccountSuspendedError
+	assert.ErrorAs(t, EnsureAccountActive(entities.AccountStatus{SuspendedUntil: &future}, now), &suspended)
+	assert.Equal(t, future, suspended.Until)
+
+	var banned *domainerrors.AccountBannedError
+	assert.ErrorAs(t, EnsureAccountActive(entities.AccountStatus{BannedAt: &past, SuspendedUntil: &future}, now), &banned)
+}
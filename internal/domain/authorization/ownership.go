@@ -0,0 +1,44 @@
+package authorization
+
+import (
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+)
+
+// EnsureDriverOwnsCar refuses access to a car registered by another driver
+func EnsureDriverOwnsCar(driver *entities.Driver, car *entities.Car) error {
+	if car.DriverRefID != driver.RefID {
+		return domainerrors.NewForbiddenError("car", car.ID)
+	}
+	return nil
+}
+
+// EnsureDriverOwnsTrip refuses access to a trip offered by another driver
+func EnsureDriverOwnsTrip(driver *entities.Driver, trip *entities.Trip) error {
+	if trip.DriverRefID != driver.RefID {
+		return domainerrors.NewForbiddenError("trip", trip.ID)
+	}
+	return nil
+}
+
+// DrivesTrip tells whether the trip is offered by the driver, who may be nil when the user is not one
+func DrivesTrip(driver *entities.Driver, trip *entities.Trip) bool {
+	return driver != nil && trip.DriverRefID == driver.RefID
+}
+
+// EnsureCanViewTripChanges refuses the history of a trip to anyone but its driver and the passengers
+// who booked it
+func EnsureCanViewTripChanges(driver *entities.Driver, trip *entities.Trip, booked bool) error {
+	if !DrivesTrip(driver, trip) && !booked {
+		return domainerrors.NewForbiddenError("trip", trip.ID)
+	}
+	return nil
+}
+
+// EnsureDriverOwnsTripSeries refuses access to a trip series offered by another driver
+func EnsureDriverOwnsTripSeries(driver *entities.Driver, series *entities.TripSeries) error {
+	if series.DriverRefID != driver.RefID {
+		return domainerrors.NewForbiddenError("trip series", series.ID)
+	}
+	return nil
+}
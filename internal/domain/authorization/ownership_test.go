@@ -0,0 +1,21 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureCanViewTripChanges(t *testing.T) {
+	trip := &entities.Trip{ID: "trip-1", DriverRefID: 300}
+
+	assert.NoError(t, EnsureCanViewTripChanges(&entities.Driver{RefID: 300}, trip, false))
+	assert.NoError(t, EnsureCanViewTripChanges(nil, trip, true), "a passenger who booked the trip may follow it")
+	assert.NoError(t, EnsureCanViewTripChanges(&entities.Driver{RefID: 301}, trip, true))
+
+	var forbidden *domainerrors.ForbiddenError
+	assert.ErrorAs(t, EnsureCanViewTripChanges(nil, trip, false), &forbidden)
+	assert.ErrorAs(t, EnsureCanViewTripChanges(&entities.Driver{RefID: 301}, trip, false), &forbidden)
+}
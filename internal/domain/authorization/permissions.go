@@ -0,0 +1,107 @@
+package authorization
+
+// Permissions name the actions a role may perform, as resource:action pairs
+const (
+	// Own account: profile, password, sessions and API keys
+	PermissionProfileRead  = "profile:read"
+	PermissionProfileWrite = "profile:write"
+
+	// Other users' accounts
+	PermissionUsersRead      = "users:read"
+	PermissionUsersUnlock    = "users:unlock"
+	PermissionUsersAnonymize = "users:anonymize"
+	PermissionUsersRoles     = "users:roles"
+	PermissionUsersSuspend   = "users:suspend"
+
+	PermissionServiceAccountsManage = "service_accounts:manage"
+
+	// The audit log of authentication and admin actions
+	PermissionAuditRead = "audit:read"
+
+	// Becoming a driver
+	PermissionDriversCreate = "drivers:create"
+
+	// A driver's own cars and trips
+	PermissionCarsRead   = "cars:read"
+	PermissionCarsWrite  = "cars:write"
+	PermissionTripsRead  = "trips:read"
+	PermissionTripsWrite = "trips:write"
+	// Deleting any trip that has no bookings; drivers cancel theirs instead
+	PermissionTripsDelete = "trips:delete"
+
+	PermissionInscriptionsRead  = "inscriptions:read"
+	PermissionInscriptionsWrite = "inscriptions:write"
+
+	PermissionCitiesRead  = "cities:read"
+	PermissionCitiesWrite = "cities:write"
+
+	// Vehicle catalog (brands and colors) and moderation of shared reference data
+	PermissionCatalogRead  = "catalog:read"
+	PermissionCatalogWrite = "catalog:write"
+)
+
+// AllPermissions lists every permission the API checks
+var AllPermissions = []string{
+	PermissionProfileRead,
+	PermissionProfileWrite,
+	PermissionUsersRead,
+	PermissionUsersUnlock,
+	PermissionUsersAnonymize,
+	PermissionUsersRoles,
+	PermissionUsersSuspend,
+	PermissionServiceAccountsManage,
+	PermissionAuditRead,
+	PermissionDriversCreate,
+	PermissionCarsRead,
+	PermissionCarsWrite,
+	PermissionTripsRead,
+	PermissionTripsWrite,
+	PermissionTripsDelete,
+	PermissionInscriptionsRead,
+	PermissionInscriptionsWrite,
+	PermissionCitiesRead,
+	PermissionCitiesWrite,
+	PermissionCatalogRead,
+	PermissionCatalogWrite,
+}
+
+var userPermissions = []string{
+	PermissionProfileRead,
+	PermissionProfileWrite,
+	PermissionDriversCreate,
+	PermissionTripsRead,
+	PermissionInscriptionsRead,
+	PermissionInscriptionsWrite,
+	PermissionCitiesRead,
+	PermissionCitiesWrite,
+}
+
+// DefaultRoles are the permission sets every deployment starts with. Existing roles only get the
+// permissions added here since they were last seeded, so edits made in the database are kept.
+var DefaultRoles = map[string][]string{
+	"USER": userPermissions,
+	"DRIVER": append(append([]string{}, userPermissions...),
+		PermissionCarsRead,
+		PermissionCarsWrite,
+		PermissionTripsWrite,
+		PermissionCatalogRead,
+	),
+	"ADMIN": AllPermissions,
+}
+
+// HasPermissions reports whether the granted permissions include every required one
+func HasPermissions(granted []string, required ...string) bool {
+	for _, r := range required {
+		found := false
+		for _, g := range granted {
+			if g == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
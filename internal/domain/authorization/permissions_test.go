@@ -0,0 +1,62 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPermissions(t *testing.T) {
+	granted := []string{PermissionUsersRead, PermissionCatalogWrite}
+
+	assert.True(t, HasPermissions(granted, PermissionUsersRead))
+	assert.True(t, HasPermissions(granted, PermissionUsersRead, PermissionCatalogWrite))
+	assert.False(t, HasPermissions(granted, PermissionUsersRead, PermissionUsersAnonymize))
+	assert.False(t, HasPermissions(nil, PermissionUsersRead))
+	assert.True(t, HasPermissions(granted), "nothing required is always granted")
+}
+
+func TestDefaultRoles_KeepTheFormerLadder(t *testing.T) {
+	// Every permission of a lower role is also granted to the roles above it
+	assert.Subset(t, DefaultRoles["DRIVER"], DefaultRoles["USER"])
+	assert.Subset(t, DefaultRoles["ADMIN"], DefaultRoles["DRIVER"])
+	assert.ElementsMatch(t, AllPermissions, DefaultRoles["ADMIN"])
+}
+
+func TestDefaultRoles_DriverOnlyPermissions(t *testing.T) {
+	assert.False(t, HasPermissions(DefaultRoles["USER"], PermissionCarsWrite))
+	assert.True(t, HasPermissions(DefaultRoles["DRIVER"], PermissionCarsWrite, PermissionTripsWrite))
+	assert.False(t, HasPermissions(DefaultRoles["DRIVER"], PermissionCatalogWrite))
+}
+
+func TestEnsureDriverOwnsCar(t *testing.T) {
+	driver := &entities.Driver{RefID: 10}
+
+	assert.NoError(t, EnsureDriverOwnsCar(driver, &entities.Car{ID: "car-1", DriverRefID: 10}))
+
+	err := EnsureDriverOwnsCar(driver, &entities.Car{ID: "car-2", DriverRefID: 20})
+	var forbidden *domainerrors.ForbiddenError
+	assert.ErrorAs(t, err, &forbidden)
+}
+
+func TestEnsureDriverOwnsTrip(t *testing.T) {
+	driver := &entities.Driver{RefID: 10}
+
+	assert.NoError(t, EnsureDriverOwnsTrip(driver, &entities.Trip{ID: "trip-1", DriverRefID: 10}))
+
+	err := EnsureDriverOwnsTrip(driver, &entities.Trip{ID: "trip-2", DriverRefID: 20})
+	var forbidden *domainerrors.ForbiddenError
+	assert.ErrorAs(t, err, &forbidden)
+}
+
+func TestEnsureDriverOwnsTripSeries(t *testing.T) {
+	driver := &entities.Driver{RefID: 10}
+
+	assert.NoError(t, EnsureDriverOwnsTripSeries(driver, &entities.TripSeries{ID: "series-1", DriverRefID: 10}))
+
+	err := EnsureDriverOwnsTripSeries(driver, &entities.TripSeries{ID: "series-2", DriverRefID: 20})
+	var forbidden *domainerrors.ForbiddenError
+	assert.ErrorAs(t, err, &forbidden)
+}
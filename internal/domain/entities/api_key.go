@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// ApiKeyPrefix starts every API key, so leaked keys are easy to recognize and scan for
+const ApiKeyPrefix = "cvk_"
+
+// API key scopes. Read-only keys may only make safe (GET/HEAD/OPTIONS) requests.
+const (
+	ApiKeyScopeRead  = "read"
+	ApiKeyScopeWrite = "write"
+)
+
+// ApiKey is a long-lived credential for scripts. It belongs either to a user, acting with
+// the user's current role, or to a service account. Only a hash of the key is stored.
+type ApiKey struct {
+	ID               string
+	Name             string
+	Prefix           string
+	KeyHash          string
+	Scopes           []string
+	UserID           *string
+	ServiceAccountID *string
+	ExpiresAt        *time.Time
+	LastUsedAt       *time.Time
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
+// IsRevoked reports whether the key was revoked
+func (k *ApiKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsExpired reports whether the key is past its expiry at the given time
+func (k *ApiKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// HasScope reports whether the key was granted scope
+func (k *ApiKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// ApiKeyPrincipal is an API key together with the identity it authenticates as
+type ApiKeyPrincipal struct {
+	Key           ApiKey
+	Role          string
+	EmailVerified bool
+	// AccountStatus is empty for service accounts
+	AccountStatus
+}
+
+// CreateApiKeyData contains the data needed to store a new API key
+type CreateApiKeyData struct {
+	Name             string
+	Prefix           string
+	KeyHash          string
+	Scopes           []string
+	UserID           *string
+	ServiceAccountID *string
+	ExpiresAt        *time.Time
+}
+
+// ServiceAccount is a non-human principal for back-office automation, not tied to a user profile
+type ServiceAccount struct {
+	ID        string
+	Name      string
+	Role      string
+	CreatedAt time.Time
+}
+
+// CreateServiceAccountData contains the data needed to create a service account
+type CreateServiceAccountData struct {
+	Name string
+	Role string
+}
+
+// ApiKeyOwner identifies who a key belongs to; exactly one of the fields is set
+type ApiKeyOwner struct {
+	UserID           string
+	ServiceAccountID string
+}
+
+// Owns reports whether the key belongs to this owner
+func (o ApiKeyOwner) Owns(k *ApiKey) bool {
+	if o.UserID != "" {
+		return k.UserID != nil && *k.UserID == o.UserID
+	}
+	return k.ServiceAccountID != nil && *k.ServiceAccountID == o.ServiceAccountID
+}
@@ -0,0 +1,64 @@
+package entities
+
+import "time"
+
+// Audited actions
+const (
+	AuditActionRegister             = "auth.register"
+	AuditActionLogin                = "auth.login"
+	AuditActionPasswordReset        = "auth.password_reset"
+	AuditActionPasswordChange       = "user.password_change"
+	AuditActionAnonymize            = "user.anonymize"
+	AuditActionUnlock               = "user.unlock"
+	AuditActionRoleChange           = "user.role_change"
+	AuditActionSuspend              = "user.suspend"
+	AuditActionBan                  = "user.ban"
+	AuditActionReinstate            = "user.reinstate"
+	AuditActionServiceAccountCreate = "service_account.create"
+	AuditActionApiKeyCreate         = "api_key.create"
+	AuditActionApiKeyRevoke         = "api_key.revoke"
+	AuditActionCatalogCreate        = "catalog.create"
+	AuditActionCatalogUpdate        = "catalog.update"
+	AuditActionCatalogDelete        = "catalog.delete"
+	AuditActionTripDelete           = "trip.delete"
+)
+
+// AuditLog records a security-relevant action. Entries are never changed or deleted.
+type AuditLog struct {
+	ID      string
+	Action  string
+	Success bool
+	// ActorID is the authenticated user or service account; nil for anonymous requests such as logins
+	ActorID *string
+	// TargetID is the user or resource acted on
+	TargetID *string
+	// Email is the account an anonymous request named, so failed logins can be traced
+	Email     *string
+	ErrorCode *string
+	IPAddress string
+	RequestID string
+	CreatedAt time.Time
+}
+
+// CreateAuditLogData contains the data needed to append an audit log entry
+type CreateAuditLogData struct {
+	Action    string
+	Success   bool
+	ActorID   *string
+	TargetID  *string
+	Email     *string
+	ErrorCode *string
+	IPAddress string
+	RequestID string
+}
+
+// AuditLogFilters contains optional filters for querying the audit log
+type AuditLogFilters struct {
+	Action   *string
+	ActorID  *string
+	TargetID *string
+	Email    *string
+	Success  *bool
+	From     *time.Time
+	To       *time.Time
+}
@@ -4,16 +4,72 @@ import "time"
 
 // Auth represents the authentication domain entity
 type Auth struct {
-	ID           string
-	RefID        int64
-	Email        string
-	Password     string
-	Role         string
+	ID              string
+	RefID           int64
+	Email           string
+	Password        string
+	Role            string
+	EmailVerifiedAt *time.Time
+	// FailedLoginAttempts counts consecutive failed logins; LockedUntil blocks logins until then
+	FailedLoginAttempts int
+	LockedUntil         *time.Time
+	// TotpSecret is stored when enrollment starts; two-factor is only enforced once
+	// TotpEnabledAt is set. TotpLastStep is the last accepted time step, so codes cannot be replayed.
+	TotpSecret    *string
+	TotpEnabledAt *time.Time
+	TotpLastStep  int64
+	// TokenVersion is bumped on role changes; access tokens carrying an older version are rejected
+	TokenVersion int64
+	AccountStatus
 	AnonymizedAt *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
 
+// IsEmailVerified reports whether the account holder has confirmed their email address
+func (a *Auth) IsEmailVerified() bool {
+	return a.EmailVerifiedAt != nil
+}
+
+// IsLocked reports whether logins are blocked at the given time
+func (a *Auth) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// IsTwoFactorEnabled reports whether logins require a second factor
+func (a *Auth) IsTwoFactorEnabled() bool {
+	return a.TotpEnabledAt != nil
+}
+
+// HasPendingTotpEnrollment reports whether a TOTP secret was issued but not yet confirmed
+func (a *Auth) HasPendingTotpEnrollment() bool {
+	return a.TotpSecret != nil && a.TotpEnabledAt == nil
+}
+
+// AccountStatus holds the moderation state set by administrators. A suspension ends on its own
+// at SuspendedUntil; a ban lasts until it is lifted.
+type AccountStatus struct {
+	SuspendedUntil   *time.Time
+	SuspensionReason *string
+	BannedAt         *time.Time
+}
+
+// IsBanned reports whether the account was permanently banned
+func (s AccountStatus) IsBanned() bool {
+	return s.BannedAt != nil
+}
+
+// IsSuspended reports whether the account is suspended at the given time
+func (s AccountStatus) IsSuspended(now time.Time) bool {
+	return s.SuspendedUntil != nil && now.Before(*s.SuspendedUntil)
+}
+
+// TokenState is what access tokens are checked against on every request
+type TokenState struct {
+	Version int64
+	AccountStatus
+}
+
 // CreateAuthData contains the data needed to create a new auth record
 type CreateAuthData struct {
 	Email    string
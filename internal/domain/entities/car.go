@@ -7,17 +7,21 @@ type Car struct {
 	LicensePlate string
 	ModelRefID   int64
 	DriverRefID  int64
+	// FuelConsumption is in litres per 100 km, used to price its trips when known
+	FuelConsumption *float64
 }
 
 // CreateCarData contains the data needed to create a new car
 type CreateCarData struct {
-	LicensePlate string
-	ModelRefID   int64
-	DriverRefID  int64
+	LicensePlate    string
+	ModelRefID      int64
+	DriverRefID     int64
+	FuelConsumption *float64
 }
 
 // UpdateCarData contains partial update fields for a car
 type UpdateCarData struct {
-	LicensePlate *string
-	ModelRefID   *int64
+	LicensePlate    *string
+	ModelRefID      *int64
+	FuelConsumption *float64
 }
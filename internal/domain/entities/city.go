@@ -1,15 +1,45 @@
 package entities
 
+// DefaultTimeZone is the time zone of cities created without one
+const DefaultTimeZone = "Europe/Paris"
+
 // City represents a city domain entity
 type City struct {
 	ID       string
 	RefID    int64
 	CityName string
 	Zipcode  string
+	// TimeZone is the IANA name of the city's time zone, such as Europe/Paris
+	TimeZone string
+	// Latitude and Longitude are in decimal degrees, unknown for cities created along with a trip
+	Latitude  *float64
+	Longitude *float64
+}
+
+// Located reports whether the city's coordinates are known
+func (c *City) Located() bool {
+	return c.Latitude != nil && c.Longitude != nil
+}
+
+// GeoArea is the area within RadiusKm kilometres of a point in decimal degrees
+type GeoArea struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
 }
 
 // CreateCityData contains the data needed to create a new city
 type CreateCityData struct {
-	CityName string
-	Zipcode  string
+	CityName  string
+	Zipcode   string
+	TimeZone  string
+	Latitude  *float64
+	Longitude *float64
+}
+
+// UpdateCityData contains partial update fields for a city
+type UpdateCityData struct {
+	TimeZone  *string
+	Latitude  *float64
+	Longitude *float64
 }
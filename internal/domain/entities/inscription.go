@@ -2,6 +2,16 @@ package entities
 
 import "time"
 
+// Inscription statuses
+const (
+	InscriptionStatusActive = "ACTIVE"
+	// InscriptionStatusCancelledByDriver marks bookings of a trip its driver cancelled
+	InscriptionStatusCancelledByDriver = "CANCELLED_BY_DRIVER"
+	// InscriptionStatusPendingConfirmation marks bookings made before the driver changed the trip's
+	// cities or date. The passenger keeps the seat until confirming it again or cancelling.
+	InscriptionStatusPendingConfirmation = "PENDING_CONFIRMATION"
+)
+
 // Inscription represents a passenger booking domain entity
 type Inscription struct {
 	ID        string
@@ -10,10 +20,23 @@ type Inscription struct {
 	UserRefID int64
 	TripRefID int64
 	Status    string
+	// FromPosition and ToPosition are the trip stops the passenger gets on and off at
+	FromPosition int
+	ToPosition   int
+}
+
+// SeatHoldingStatuses are the statuses of bookings that take a seat
+var SeatHoldingStatuses = []string{InscriptionStatusActive, InscriptionStatusPendingConfirmation}
+
+// HoldsSeat reports whether the booking takes a seat on the trip
+func (i *Inscription) HoldsSeat() bool {
+	return i.Status == InscriptionStatusActive || i.Status == InscriptionStatusPendingConfirmation
 }
 
 // CreateInscriptionData contains the data needed to create a new inscription
 type CreateInscriptionData struct {
-	UserRefID int64
-	TripRefID int64
+	UserRefID    int64
+	TripRefID    int64
+	FromPosition int
+	ToPosition   int
 }
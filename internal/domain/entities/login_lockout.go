@@ -0,0 +1,66 @@
+package entities
+
+import "time"
+
+// LoginLockoutPolicy decides how long an account must wait after consecutive failed logins.
+// The first failures are free, the next ones add a short exponential delay, and reaching
+// MaxAttempts locks the account for LockDuration, doubling on every further failure.
+type LoginLockoutPolicy struct {
+	// FreeAttempts is the number of failures tolerated without any delay
+	FreeAttempts int
+	// MaxAttempts is the number of failures after which the account is locked
+	MaxAttempts int
+	// LockDuration is the first lock period once MaxAttempts is reached
+	LockDuration time.Duration
+	// MaxLockDuration caps the lock period
+	MaxLockDuration time.Duration
+}
+
+// DefaultLoginLockoutPolicy returns the policy used when nothing else is configured
+func DefaultLoginLockoutPolicy() LoginLockoutPolicy {
+	return LoginLockoutPolicy{
+		FreeAttempts:    2,
+		MaxAttempts:     5,
+		LockDuration:    15 * time.Minute,
+		MaxLockDuration: 24 * time.Hour,
+	}
+}
+
+// NewLoginLockoutPolicy builds a policy from the configured attempt limit and first lock
+// duration, falling back to the defaults for non-positive values
+func NewLoginLockoutPolicy(maxAttempts int, lockDuration time.Duration) LoginLockoutPolicy {
+	p := DefaultLoginLockoutPolicy()
+	if maxAttempts > 0 {
+		p.MaxAttempts = maxAttempts
+		p.FreeAttempts = min(p.FreeAttempts, maxAttempts-1)
+	}
+	if lockDuration > 0 {
+		p.LockDuration = lockDuration
+		p.MaxLockDuration = max(p.MaxLockDuration, lockDuration)
+	}
+	return p
+}
+
+// Delay returns how long logins are blocked after the given number of consecutive failures
+func (p LoginLockoutPolicy) Delay(failedAttempts int) time.Duration {
+	if failedAttempts <= p.FreeAttempts {
+		return 0
+	}
+	if failedAttempts < p.MaxAttempts {
+		return time.Second << (failedAttempts - p.FreeAttempts)
+	}
+
+	delay := p.LockDuration
+	for i := p.MaxAttempts; i < failedAttempts; i++ {
+		delay *= 2
+		if delay >= p.MaxLockDuration {
+			return p.MaxLockDuration
+		}
+	}
+	return min(delay, p.MaxLockDuration)
+}
+
+// Locks reports whether the given number of failures puts the account in a full lock
+func (p LoginLockoutPolicy) Locks(failedAttempts int) bool {
+	return failedAttempts >= p.MaxAttempts
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLockoutPolicy_Delay(t *testing.T) {
+	p := DefaultLoginLockoutPolicy()
+
+	tests := []struct {
+		name     string
+		attempts int
+		expected time.Duration
+	}{
+		{"no failures", 0, 0},
+		{"free attempt", 1, 0},
+		{"last free attempt", 2, 0},
+		{"first delay", 3, 2 * time.Second},
+		{"second delay", 4, 4 * time.Second},
+		{"lock", 5, 15 * time.Minute},
+		{"lock doubles", 6, 30 * time.Minute},
+		{"lock doubles again", 7, time.Hour},
+		{"lock is capped", 20, 24 * time.Hour},
+		{"lock stays capped", 1000, 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, p.Delay(tt.attempts))
+		})
+	}
+}
+
+func TestLoginLockoutPolicy_Locks(t *testing.T) {
+	p := DefaultLoginLockoutPolicy()
+	assert.False(t, p.Locks(4))
+	assert.True(t, p.Locks(5))
+	assert.True(t, p.Locks(6))
+}
+
+func TestNewLoginLockoutPolicy(t *testing.T) {
+	p := NewLoginLockoutPolicy(3, 30*time.Minute)
+	assert.Equal(t, 3, p.MaxAttempts)
+	assert.Equal(t, 2, p.FreeAttempts)
+	assert.Equal(t, 30*time.Minute, p.LockDuration)
+	assert.Equal(t, 30*time.Minute, p.Delay(3))
+
+	p = NewLoginLockoutPolicy(1, 0)
+	assert.Equal(t, 0, p.FreeAttempts)
+	assert.Equal(t, 15*time.Minute, p.Delay(1))
+
+	assert.Equal(t, DefaultLoginLockoutPolicy(), NewLoginLockoutPolicy(0, 0))
+}
+
+func TestAuth_IsLocked(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Minute)
+	past := now.Add(-time.Minute)
+
+	assert.False(t, (&Auth{}).IsLocked(now))
+	assert.True(t, (&Auth{LockedUntil: &future}).IsLocked(now))
+	assert.False(t, (&Auth{LockedUntil: &past}).IsLocked(now))
+}
@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// OidcLoginState is a pending OpenID Connect login, kept from the redirect to the provider
+// until its callback. Only the hash of the state parameter is persisted.
+type OidcLoginState struct {
+	ID           string
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// IsExpired reports whether the login is past its expiry at the given time
+func (s *OidcLoginState) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// CreateOidcLoginStateData contains the data needed to persist a pending login
+type CreateOidcLoginStateData struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// ExternalIdentity links an identity provider account (issuer and subject) to an auth record
+type ExternalIdentity struct {
+	ID        string
+	Issuer    string
+	Subject   string
+	AuthRefID int64
+	CreatedAt time.Time
+}
+
+// CreateExternalIdentityData contains the data needed to link an external identity
+type CreateExternalIdentityData struct {
+	Issuer    string
+	Subject   string
+	AuthRefID int64
+}
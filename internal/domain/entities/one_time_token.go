@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+// One-time token purposes
+const (
+	OneTimeTokenPasswordReset     = "PASSWORD_RESET"
+	OneTimeTokenEmailVerification = "EMAIL_VERIFICATION"
+	OneTimeTokenMfaChallenge      = "MFA_CHALLENGE"
+	OneTimeTokenMagicLink         = "MAGIC_LINK"
+)
+
+// OneTimeToken is a single-use, time-limited token emailed to an account
+// holder (e.g. a password reset link). Only its hash is persisted.
+type OneTimeToken struct {
+	ID        string
+	AuthRefID int64
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsExpired reports whether the token is past its expiry at the given time
+func (t *OneTimeToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsUsed reports whether the token has already been consumed or invalidated
+func (t *OneTimeToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// CreateOneTimeTokenData contains the data needed to persist a one-time token
+type CreateOneTimeTokenData struct {
+	AuthRefID int64
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+}
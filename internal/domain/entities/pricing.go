@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultCurrency is the currency of prices when none is configured
+const DefaultCurrency = "EUR"
+
+// PricingRules turn the distance of a trip into the cost its driver shares with passengers.
+// Amounts are in the currency's minor unit, cents for EUR.
+type PricingRules struct {
+	Currency string
+	// CostPerKm is the cost of driving a kilometre in a car whose fuel consumption is unknown
+	CostPerKm int
+	// FuelPricePerLitre and WearPerKm make up the cost of a car with a known consumption
+	FuelPricePerLitre int
+	WearPerKm         int
+}
+
+// PriceQuote is the price per seat suggested for a trip and the most a driver may ask
+type PriceQuote struct {
+	Currency       string
+	SuggestedPrice int
+	MaxPrice       int
+}
+
+// TripCost returns the cost of driving kms, from the car's fuel consumption in litres per 100 km when known
+func (p PricingRules) TripCost(kms int, fuelConsumption *float64) int {
+	if fuelConsumption == nil {
+		return kms * p.CostPerKm
+	}
+	fuel := float64(kms) * *fuelConsumption / 100 * float64(p.FuelPricePerLitre)
+	return int(math.Round(fuel)) + kms*p.WearPerKm
+}
+
+// Quote suggests sharing the trip cost evenly between the driver and a full car. Drivers may ask
+// more, up to the price at which a full car pays the whole cost, but never make a profit.
+func (p PricingRules) Quote(kms, seats int, fuelConsumption *float64) PriceQuote {
+	cost := p.TripCost(kms, fuelConsumption)
+	quote := PriceQuote{Currency: p.Currency}
+	if seats > 0 {
+		quote.SuggestedPrice = int(math.Round(float64(cost) / float64(seats+1)))
+		quote.MaxPrice = cost / seats
+	}
+	return quote
+}
+
+// Allows reports whether a driver may ask the given price per seat
+func (q PriceQuote) Allows(price int) bool {
+	return price <= q.MaxPrice
+}
+
+// FormatPrice renders an amount in minor units with its currency, like "12.50 EUR"
+func FormatPrice(amount int, currency string) string {
+	return fmt.Sprintf("%d.%02d %s", amount/100, amount%100, currency)
+}
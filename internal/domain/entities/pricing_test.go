@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testPricing = PricingRules{Currency: "EUR", CostPerKm: 20, FuelPricePerLitre: 185, WearPerKm: 8}
+
+func TestPricingRules_TripCost(t *testing.T) {
+	assert.Equal(t, 9000, testPricing.TripCost(450, nil))
+
+	// 27 litres at 1.85 and 450 km of wear at 0.08
+	consumption := 6.0
+	assert.Equal(t, 4995+3600, testPricing.TripCost(450, &consumption))
+}
+
+func TestPricingRules_Quote(t *testing.T) {
+	quote := testPricing.Quote(450, 3, nil)
+
+	assert.Equal(t, PriceQuote{Currency: "EUR", SuggestedPrice: 2250, MaxPrice: 3000}, quote)
+	assert.True(t, quote.Allows(0))
+	assert.True(t, quote.Allows(3000))
+	assert.False(t, quote.Allows(3001))
+}
+
+func TestPricingRules_QuoteWithoutSeats(t *testing.T) {
+	assert.Equal(t, PriceQuote{Currency: "EUR"}, testPricing.Quote(450, 0, nil))
+}
+
+func TestFormatPrice(t *testing.T) {
+	assert.Equal(t, "12.50 EUR", FormatPrice(1250, "EUR"))
+	assert.Equal(t, "0.05 EUR", FormatPrice(5, "EUR"))
+}
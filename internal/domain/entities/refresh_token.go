@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// RefreshToken represents a persisted, rotating refresh token.
+// Tokens issued from the same login share a FamilyID so that a replayed
+// (already rotated) token can revoke the whole chain.
+type RefreshToken struct {
+	ID         string
+	AuthRefID  int64
+	FamilyID   string
+	TokenHash  string
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *string
+	CreatedAt  time.Time
+}
+
+// IsExpired reports whether the token is past its expiry at the given time
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsRevoked reports whether the token has been revoked or rotated
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// CreateRefreshTokenData contains the data needed to persist a refresh token.
+// An empty FamilyID starts a new token family.
+type CreateRefreshTokenData struct {
+	AuthRefID int64
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,8 @@
+package entities
+
+// Role is a named set of permissions granted to the accounts and service accounts that hold it
+type Role struct {
+	Name        string
+	Description string
+	Permissions []string
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// Session is a signed-in device. Every login starts one; its ID is the family ID of the
+// refresh tokens issued to it and the sid claim of its access tokens.
+type Session struct {
+	ID         string
+	AuthRefID  int64
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// IsRevoked reports whether the session was signed out
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// SessionClient describes the device a session is started from
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+// CreateSessionData contains the data needed to start a session
+type CreateSessionData struct {
+	AuthRefID int64
+	Client    SessionClient
+	ExpiresAt time.Time
+}
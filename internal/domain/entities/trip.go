@@ -2,30 +2,250 @@ package entities
 
 import "time"
 
+// Trip lifecycle statuses
+const (
+	TripStatusScheduled  = "SCHEDULED"
+	TripStatusFull       = "FULL"
+	TripStatusInProgress = "IN_PROGRESS"
+	TripStatusCompleted  = "COMPLETED"
+	TripStatusCancelled  = "CANCELLED"
+)
+
+// Trip search orders
+const (
+	TripSortDeparture = "departure"
+	TripSortPrice     = "price"
+	TripSortPriceDesc = "-price"
+	TripSortDistance  = "distance"
+)
+
+// tripTransitions lists the statuses each status may move to. COMPLETED and CANCELLED are final.
+var tripTransitions = map[string][]string{
+	TripStatusScheduled:  {TripStatusFull, TripStatusInProgress, TripStatusCancelled},
+	TripStatusFull:       {TripStatusScheduled, TripStatusInProgress, TripStatusCancelled},
+	TripStatusInProgress: {TripStatusCompleted},
+}
+
+// Trip stop types
+const (
+	TripStopDeparture = "DEPARTURE"
+	TripStopWaypoint  = "STOP"
+	TripStopArrival   = "ARRIVAL"
+)
+
+// AverageSpeedKmh is the speed used to estimate arrival times from the trip distance
+const AverageSpeedKmh = 80
+
+// TripStop is a city the trip passes through. Positions start at 0 for the departure and
+// increase along the route up to the arrival.
+type TripStop struct {
+	CityRefID int64
+	CityName  string
+	Position  int
+	Type      string
+	// Time is the estimated time the driver passes by, when given, in UTC and in the trip's time zone
+	Time      *time.Time
+	LocalTime *time.Time
+}
+
 // Trip represents a carpooling trip domain entity
 type Trip struct {
-	ID          string
-	RefID       int64
-	DateTrip    time.Time
-	Kms         int
-	Seats       int
-	DriverRefID int64
-	CarRefID    int64
+	ID    string
+	RefID int64
+	// DateTrip and EstimatedArrival are in UTC, their Local counterparts in TimeZone
+	DateTrip              time.Time
+	LocalDateTrip         time.Time
+	EstimatedArrival      time.Time
+	LocalEstimatedArrival time.Time
+	// TimeZone is the IANA time zone of the departure city
+	TimeZone string
+	Kms      int
+	Seats    int
+	// PricePerSeat is in the minor unit of Currency
+	PricePerSeat int
+	Currency     string
+	DriverRefID  int64
+	CarRefID     int64
+	Status       string
+	// CancellationReason is the driver's explanation, set once the trip is cancelled
+	CancellationReason *string
+	// Stops are ordered by position, from departure to arrival
+	Stops []TripStop
+	// SeriesRefID and OccurrenceDate are set on the occurrences of a recurring series.
+	// SeriesOverridden marks an occurrence the driver changed alone, which series edits leave be.
+	SeriesRefID      *int64
+	OccurrenceDate   *string
+	SeriesOverridden bool
+}
+
+// Localize sets the trip's times in UTC and fills in their local counterparts. Trips in a zone
+// unknown to the server are rendered in UTC.
+func (t *Trip) Localize() {
+	loc, err := time.LoadLocation(t.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	t.DateTrip = t.DateTrip.UTC()
+	t.LocalDateTrip = t.DateTrip.In(loc)
+	t.EstimatedArrival = t.EstimatedArrival.UTC()
+	t.LocalEstimatedArrival = t.EstimatedArrival.In(loc)
+	for i := range t.Stops {
+		if t.Stops[i].Time != nil {
+			utc, local := t.Stops[i].Time.UTC(), t.Stops[i].Time.In(loc)
+			t.Stops[i].Time, t.Stops[i].LocalTime = &utc, &local
+		}
+	}
+}
+
+// ParseDepartureTime reads a departure time given in RFC 3339, or without an offset
+// ("2006-01-02T15:04") on the clock of the time zone
+func ParseDepartureTime(value, timeZone string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.ParseInLocation("2006-01-02T15:04", value, loc)
+}
+
+// EstimateArrival returns the arrival time of a trip of the given distance driven at AverageSpeedKmh
+func EstimateArrival(departure time.Time, kms int) time.Time {
+	return departure.Add(time.Duration(kms) * time.Hour / AverageSpeedKmh)
+}
+
+// CanTransitionTo reports whether the trip may move from its current status to next
+func (t *Trip) CanTransitionTo(next string) bool {
+	for _, allowed := range tripTransitions[t.Status] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBookable reports whether passengers may still book a seat
+func (t *Trip) IsBookable() bool {
+	return t.Status == TripStatusScheduled
+}
+
+// LastPosition is the position of the arrival stop
+func (t *Trip) LastPosition() int {
+	if len(t.Stops) < 2 {
+		return 1
+	}
+	return t.Stops[len(t.Stops)-1].Position
+}
+
+// FindStop returns the stop in the named city, or nil when the trip does not pass through it
+func (t *Trip) FindStop(cityName string) *TripStop {
+	for i := range t.Stops {
+		if t.Stops[i].CityName == cityName {
+			return &t.Stops[i]
+		}
+	}
+	return nil
+}
+
+// SeatsLeft returns the seats still free on every leg between the from and to positions, given the
+// trip's bookings. A booking only takes its seat on the legs it rides.
+func (t *Trip) SeatsLeft(bookings []Inscription, from, to int) int {
+	left := t.Seats
+	for leg := from; leg < to; leg++ {
+		free := t.Seats
+		for _, b := range bookings {
+			if b.HoldsSeat() && b.FromPosition <= leg && leg < b.ToPosition {
+				free--
+			}
+		}
+		if free < left {
+			left = free
+		}
+	}
+	return left
+}
+
+// SeatsTaken returns the most seats the trip's bookings take on any single leg
+func (t *Trip) SeatsTaken(bookings []Inscription) int {
+	return t.Seats - t.SeatsLeft(bookings, 0, t.LastPosition())
+}
+
+// StatusForBookings returns the status a trip open for booking should have given its bookings,
+// FULL when no leg has a seat left and SCHEDULED otherwise. Trips that are no longer open keep their status.
+func (t *Trip) StatusForBookings(bookings []Inscription) string {
+	if t.Status != TripStatusScheduled && t.Status != TripStatusFull {
+		return t.Status
+	}
+	for leg := 0; leg < t.LastPosition(); leg++ {
+		if t.SeatsLeft(bookings, leg, leg+1) > 0 {
+			return TripStatusScheduled
+		}
+	}
+	return TripStatusFull
 }
 
 // CreateTripData contains the data needed to create a new trip
 type CreateTripData struct {
-	DateTrip    time.Time
-	Kms         int
-	Seats       int
-	DriverRefID int64
-	CarRefID    int64
-	CityRefIDs  []int64 // [departureRefID, arrivalRefID]
+	DateTrip         time.Time
+	EstimatedArrival time.Time
+	TimeZone         string
+	Kms              int
+	Seats            int
+	PricePerSeat     int
+	Currency         string
+	DriverRefID      int64
+	CarRefID         int64
+	Stops            []CreateTripStopData // ordered from departure to arrival
+	// SeriesRefID and OccurrenceDate identify an occurrence of a recurring series
+	SeriesRefID    *int64
+	OccurrenceDate *string
+}
+
+// UpdateTripData contains partial update fields for a trip and the changes they make
+type UpdateTripData struct {
+	DateTrip         *time.Time
+	EstimatedArrival *time.Time
+	TimeZone         *string
+	Kms              *int
+	Seats            *int
+	PricePerSeat     *int
+	CarRefID         *int64
+	// DepartureCityRefID and ArrivalCityRefID replace the first and last stops of the route
+	DepartureCityRefID *int64
+	ArrivalCityRefID   *int64
+	// StopShift moves the times of the stops along with the departure
+	StopShift        time.Duration
+	SeriesOverridden *bool
+	// Changes are recorded in the trip's history
+	Changes []CreateTripChangeData
+	// ReconfirmBookings asks every passenger holding a seat to confirm it again
+	ReconfirmBookings bool
+}
+
+// CreateTripStopData is a city on the route of a new trip
+type CreateTripStopData struct {
+	CityRefID int64
+	Time      *time.Time
 }
 
 // TripFilters contains optional filters for searching trips
 type TripFilters struct {
 	DepartureCity *string
 	ArrivalCity   *string
-	Date          *time.Time
+	// DepartureArea and ArrivalArea match the stops within a radius instead of by city name
+	DepartureArea *GeoArea
+	ArrivalArea   *GeoArea
+	// Date is a calendar day and the departure window a time of day ("15:04"), all in each trip's
+	// own time zone. A window whose start is after its end spans midnight.
+	Date            *time.Time
+	DepartureAfter  *string
+	DepartureBefore *string
+	Statuses        []string // only trips in one of these statuses; all when empty
+	// MinPrice and MaxPrice bound the price per seat, in minor units
+	MinPrice *int
+	MaxPrice *int
+	// Sort is one of the TripSort values, by departure when empty. Sorting by distance lists first the
+	// trips whose stops are closest to the searched areas.
+	Sort string
 }
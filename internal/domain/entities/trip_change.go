@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+// Trip fields whose changes are recorded
+const (
+	TripChangeDate             = "date"
+	TripChangeEstimatedArrival = "estimatedArrival"
+	TripChangeDepartureCity    = "departureCity"
+	TripChangeArrivalCity      = "arrivalCity"
+	TripChangeKms              = "kms"
+	TripChangeSeats            = "seats"
+	TripChangePricePerSeat     = "pricePerSeat"
+	TripChangeCar              = "car"
+)
+
+// TripChange is a change a driver made to a trip, shown to its booked passengers. Values are
+// rendered for reading: times in the trip's time zone, cities by name, prices with their currency.
+type TripChange struct {
+	ID        string
+	TripRefID int64
+	Field     string
+	OldValue  string
+	NewValue  string
+	CreatedAt time.Time
+}
+
+// CreateTripChangeData describes a change to record
+type CreateTripChangeData struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// RequiresReconfirmation reports whether passengers booked before the change must confirm their
+// seat again. Moving the departure or the route changes the ride they agreed to.
+func (c CreateTripChangeData) RequiresReconfirmation() bool {
+	return c.Field == TripChangeDate || c.Field == TripChangeDepartureCity || c.Field == TripChangeArrivalCity
+}
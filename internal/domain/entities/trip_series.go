@@ -0,0 +1,200 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// Trip series statuses
+const (
+	TripSeriesStatusActive    = "ACTIVE"
+	TripSeriesStatusCancelled = "CANCELLED"
+)
+
+// SeriesWeekdays maps the RRULE BYDAY codes to weekdays
+var SeriesWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// TripSeries is a trip a driver repeats every Interval weeks on the same weekdays, like a weekly
+// RRULE with BYDAY, INTERVAL, UNTIL and EXDATE. Its occurrences are materialized as trips ahead of
+// time. Calendar days are "2006-01-02" and the departure time "15:04", both in TimeZone.
+type TripSeries struct {
+	ID                 string
+	RefID              int64
+	DriverRefID        int64
+	CarRefID           int64
+	DepartureCityRefID int64
+	ArrivalCityRefID   int64
+	DepartureCity      string
+	ArrivalCity        string
+	Kms                int
+	Seats              int
+	PricePerSeat       int
+	Currency           string
+	TimeZone           string
+	DepartureTime      string
+	Weekdays           []string
+	Interval           int
+	StartDate          string
+	EndDate            *string
+	// Exceptions are the days the series skips
+	Exceptions []string
+	Status     string
+	// CancellationReason is the driver's explanation, set once the series is cancelled
+	CancellationReason *string
+}
+
+// SeriesOccurrence is a day a series runs and the departure time on that day
+type SeriesOccurrence struct {
+	Date      string
+	Departure time.Time
+}
+
+// Validate checks the recurrence pattern
+func (s *TripSeries) Validate() error {
+	if len(s.Weekdays) == 0 {
+		return fmt.Errorf("at least one weekday is required")
+	}
+	for _, day := range s.Weekdays {
+		if _, ok := SeriesWeekdays[day]; !ok {
+			return fmt.Errorf("unknown weekday %q, expected one of MO TU WE TH FR SA SU", day)
+		}
+	}
+	if s.Interval < 1 {
+		return fmt.Errorf("interval must be at least one week")
+	}
+	if _, err := time.Parse("15:04", s.DepartureTime); err != nil {
+		return fmt.Errorf("departure time must be formatted as 15:04")
+	}
+	start, err := time.Parse(time.DateOnly, s.StartDate)
+	if err != nil {
+		return fmt.Errorf("start date must be formatted as 2006-01-02")
+	}
+	if s.EndDate != nil {
+		end, err := time.Parse(time.DateOnly, *s.EndDate)
+		if err != nil {
+			return fmt.Errorf("end date must be formatted as 2006-01-02")
+		}
+		if end.Before(start) {
+			return fmt.Errorf("end date must not be before the start date")
+		}
+	}
+	for _, day := range s.Exceptions {
+		if _, err := time.Parse(time.DateOnly, day); err != nil {
+			return fmt.Errorf("exceptions must be formatted as 2006-01-02")
+		}
+	}
+	return nil
+}
+
+// RunsOn reports whether the series has an occurrence on the given day
+func (s *TripSeries) RunsOn(date string) bool {
+	day, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return false
+	}
+	start, err := time.Parse(time.DateOnly, s.StartDate)
+	if err != nil || day.Before(start) {
+		return false
+	}
+	if s.EndDate != nil && date > *s.EndDate {
+		return false
+	}
+	for _, exception := range s.Exceptions {
+		if exception == date {
+			return false
+		}
+	}
+
+	matches := false
+	for _, code := range s.Weekdays {
+		if SeriesWeekdays[code] == day.Weekday() {
+			matches = true
+		}
+	}
+	if !matches {
+		return false
+	}
+
+	// Weeks start on Monday, and the series runs every Interval weeks from the week it starts
+	weeks := int(startOfWeek(day).Sub(startOfWeek(start)).Hours() / 24 / 7)
+	return s.Interval <= 1 || weeks%s.Interval == 0
+}
+
+// OccurrenceOn returns the occurrence of the series on the given day, which must be a day it runs
+func (s *TripSeries) OccurrenceOn(date string) SeriesOccurrence {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	day, _ := time.Parse(time.DateOnly, date)
+	clock, _ := time.Parse("15:04", s.DepartureTime)
+	departure := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	return SeriesOccurrence{Date: date, Departure: departure.UTC()}
+}
+
+// Occurrences returns the occurrences departing after from and no later than to
+func (s *TripSeries) Occurrences(from, to time.Time) []SeriesOccurrence {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	first := from.In(loc)
+	last := to.In(loc)
+
+	var occurrences []SeriesOccurrence
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	for ; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		if !s.RunsOn(date) {
+			continue
+		}
+		occurrence := s.OccurrenceOn(date)
+		if occurrence.Departure.After(from) && !occurrence.Departure.After(to) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	return occurrences
+}
+
+func startOfWeek(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// CreateTripSeriesData contains the data needed to create a new trip series
+type CreateTripSeriesData struct {
+	DriverRefID        int64
+	CarRefID           int64
+	DepartureCityRefID int64
+	ArrivalCityRefID   int64
+	Kms                int
+	Seats              int
+	PricePerSeat       int
+	Currency           string
+	TimeZone           string
+	DepartureTime      string
+	Weekdays           []string
+	Interval           int
+	StartDate          string
+	EndDate            *string
+	Exceptions         []string
+}
+
+// UpdateTripSeriesData contains partial update fields for a trip series
+type UpdateTripSeriesData struct {
+	Seats         *int
+	DepartureTime *string
+	Weekdays      []string
+	Interval      *int
+	EndDate       *string
+	Exceptions    []string
+}
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commuteSeries() *TripSeries {
+	endDate := "2026-11-02"
+	return &TripSeries{
+		TimeZone:      "Europe/Paris",
+		DepartureTime: "07:30",
+		Weekdays:      []string{"MO", "WE"},
+		Interval:      2,
+		StartDate:     "2026-10-05",
+		EndDate:       &endDate,
+		Exceptions:    []string{"2026-10-07"},
+	}
+}
+
+func TestTripSeries_Validate(t *testing.T) {
+	require.NoError(t, commuteSeries().Validate())
+
+	noDays := commuteSeries()
+	noDays.Weekdays = nil
+	assert.Error(t, noDays.Validate())
+
+	badDay := commuteSeries()
+	badDay.Weekdays = []string{"MONDAY"}
+	assert.Error(t, badDay.Validate())
+
+	badTime := commuteSeries()
+	badTime.DepartureTime = "7h30"
+	assert.Error(t, badTime.Validate())
+
+	endsBeforeStart := commuteSeries()
+	endDate := "2026-10-01"
+	endsBeforeStart.EndDate = &endDate
+	assert.Error(t, endsBeforeStart.Validate())
+
+	noInterval := commuteSeries()
+	noInterval.Interval = 0
+	assert.Error(t, noInterval.Validate())
+}
+
+func TestTripSeries_RunsOn(t *testing.T) {
+	series := commuteSeries()
+
+	assert.True(t, series.RunsOn("2026-10-05"))
+	assert.False(t, series.RunsOn("2026-10-06"), "not a series weekday")
+	assert.False(t, series.RunsOn("2026-10-07"), "exception")
+	assert.False(t, series.RunsOn("2026-10-12"), "off week")
+	assert.True(t, series.RunsOn("2026-10-21"))
+	assert.False(t, series.RunsOn("2026-09-28"), "before the start")
+	assert.False(t, series.RunsOn("2026-11-04"), "after the end")
+}
+
+func TestTripSeries_Occurrences(t *testing.T) {
+	series := commuteSeries()
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	occurrences := series.Occurrences(from, to)
+
+	require.Len(t, occurrences, 4)
+	assert.Equal(t, "2026-10-05", occurrences[0].Date)
+	assert.Equal(t, "2026-10-19", occurrences[1].Date)
+	assert.Equal(t, "2026-10-21", occurrences[2].Date)
+	assert.Equal(t, "2026-11-02", occurrences[3].Date)
+	// 07:30 in Paris is 05:30 UTC in summer time and 06:30 UTC once clocks go back
+	assert.Equal(t, time.Date(2026, 10, 5, 5, 30, 0, 0, time.UTC), occurrences[0].Departure)
+	assert.Equal(t, time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC), occurrences[3].Departure)
+}
+
+func TestTripSeries_Occurrences_SkipsDepartedToday(t *testing.T) {
+	series := commuteSeries()
+
+	from := time.Date(2026, 10, 5, 6, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	occurrences := series.Occurrences(from, to)
+
+	require.Len(t, occurrences, 1)
+	assert.Equal(t, "2026-10-19", occurrences[0].Date)
+}
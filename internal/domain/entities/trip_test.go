@@ -0,0 +1,142 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrip_CanTransitionTo(t *testing.T) {
+	scheduled := &Trip{Status: TripStatusScheduled}
+	assert.True(t, scheduled.CanTransitionTo(TripStatusFull))
+	assert.True(t, scheduled.CanTransitionTo(TripStatusInProgress))
+	assert.True(t, scheduled.CanTransitionTo(TripStatusCancelled))
+	assert.False(t, scheduled.CanTransitionTo(TripStatusCompleted))
+
+	inProgress := &Trip{Status: TripStatusInProgress}
+	assert.True(t, inProgress.CanTransitionTo(TripStatusCompleted))
+	assert.False(t, inProgress.CanTransitionTo(TripStatusCancelled))
+
+	for _, final := range []string{TripStatusCompleted, TripStatusCancelled} {
+		trip := &Trip{Status: final}
+		assert.False(t, trip.CanTransitionTo(TripStatusScheduled), final)
+		assert.False(t, trip.CanTransitionTo(TripStatusInProgress), final)
+	}
+}
+
+func TestTrip_IsBookable(t *testing.T) {
+	assert.True(t, (&Trip{Status: TripStatusScheduled}).IsBookable())
+	assert.False(t, (&Trip{Status: TripStatusFull}).IsBookable())
+	assert.False(t, (&Trip{Status: TripStatusCancelled}).IsBookable())
+}
+
+func TestTrip_StatusForBookings(t *testing.T) {
+	booking := func(from, to int) Inscription {
+		return Inscription{Status: InscriptionStatusActive, FromPosition: from, ToPosition: to}
+	}
+
+	scheduled := &Trip{Seats: 2, Status: TripStatusScheduled}
+	assert.Equal(t, TripStatusScheduled, scheduled.StatusForBookings([]Inscription{booking(0, 1)}))
+	assert.Equal(t, TripStatusFull, scheduled.StatusForBookings([]Inscription{booking(0, 1), booking(0, 1)}))
+
+	full := &Trip{Seats: 2, Status: TripStatusFull}
+	cancelled := booking(0, 1)
+	cancelled.Status = InscriptionStatusCancelledByDriver
+	assert.Equal(t, TripStatusScheduled, full.StatusForBookings([]Inscription{booking(0, 1), cancelled}))
+
+	started := &Trip{Seats: 2, Status: TripStatusInProgress}
+	assert.Equal(t, TripStatusInProgress, started.StatusForBookings(nil))
+
+	// Paris (0) -> Macon (1) -> Lyon (2): full only once both legs are taken
+	multiStop := &Trip{Seats: 1, Status: TripStatusScheduled, Stops: []TripStop{
+		{CityName: "Paris", Position: 0}, {CityName: "Macon", Position: 1}, {CityName: "Lyon", Position: 2},
+	}}
+	assert.Equal(t, TripStatusScheduled, multiStop.StatusForBookings([]Inscription{booking(0, 1)}))
+	assert.Equal(t, TripStatusFull, multiStop.StatusForBookings([]Inscription{booking(0, 1), booking(1, 2)}))
+}
+
+func TestTrip_SeatsLeft(t *testing.T) {
+	trip := &Trip{Seats: 2, Stops: []TripStop{
+		{CityName: "Paris", Position: 0}, {CityName: "Dijon", Position: 1},
+		{CityName: "Macon", Position: 2}, {CityName: "Lyon", Position: 3},
+	}}
+	bookings := []Inscription{
+		{Status: InscriptionStatusActive, FromPosition: 0, ToPosition: 2},
+		{Status: InscriptionStatusActive, FromPosition: 1, ToPosition: 3},
+	}
+
+	assert.Equal(t, 1, trip.SeatsLeft(bookings, 0, 1))
+	assert.Equal(t, 0, trip.SeatsLeft(bookings, 1, 2), "both bookings ride Dijon -> Macon")
+	assert.Equal(t, 0, trip.SeatsLeft(bookings, 0, 3))
+	assert.Equal(t, 1, trip.SeatsLeft(bookings, 2, 3))
+}
+
+func TestTrip_FindStop(t *testing.T) {
+	trip := &Trip{Stops: []TripStop{{CityName: "Paris", Position: 0}, {CityName: "Lyon", Position: 1}}}
+
+	stop := trip.FindStop("Lyon")
+	if assert.NotNil(t, stop) {
+		assert.Equal(t, 1, stop.Position)
+	}
+	assert.Nil(t, trip.FindStop("Marseille"))
+	assert.Equal(t, 1, trip.LastPosition())
+	assert.Equal(t, 1, (&Trip{}).LastPosition(), "trips without loaded stops go from 0 to 1")
+}
+
+func TestTrip_Localize(t *testing.T) {
+	departure := time.Date(2026, 1, 15, 7, 0, 0, 0, time.UTC)
+	stop := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+	trip := Trip{
+		DateTrip:         departure,
+		EstimatedArrival: EstimateArrival(departure, 400),
+		TimeZone:         "Europe/Paris",
+		Stops:            []TripStop{{Position: 0}, {Position: 1, Time: &stop}, {Position: 2}},
+	}
+
+	trip.Localize()
+
+	assert.Equal(t, "2026-01-15T08:00:00+01:00", trip.LocalDateTrip.Format(time.RFC3339))
+	assert.Equal(t, "2026-01-15T12:00:00Z", trip.EstimatedArrival.Format(time.RFC3339))
+	assert.Equal(t, "2026-01-15T13:00:00+01:00", trip.LocalEstimatedArrival.Format(time.RFC3339))
+	assert.Equal(t, "2026-01-15T10:00:00+01:00", trip.Stops[1].LocalTime.Format(time.RFC3339))
+	assert.Nil(t, trip.Stops[0].LocalTime)
+}
+
+func TestTrip_Localize_UnknownZone(t *testing.T) {
+	departure := time.Date(2026, 1, 15, 7, 0, 0, 0, time.UTC)
+	trip := Trip{DateTrip: departure, TimeZone: "Mars/Olympus"}
+
+	trip.Localize()
+
+	assert.Equal(t, departure, trip.LocalDateTrip)
+}
+
+func TestTrip_SeatsTaken(t *testing.T) {
+	trip := &Trip{Seats: 3, Stops: []TripStop{{Position: 0}, {Position: 1}, {Position: 2}}}
+	bookings := []Inscription{
+		{Status: InscriptionStatusActive, FromPosition: 0, ToPosition: 1},
+		{Status: InscriptionStatusActive, FromPosition: 0, ToPosition: 2},
+		{Status: InscriptionStatusCancelledByDriver, FromPosition: 0, ToPosition: 2},
+	}
+
+	assert.Equal(t, 2, trip.SeatsTaken(bookings))
+}
+
+func TestTrip_SeatsLeft_PendingConfirmation(t *testing.T) {
+	trip := &Trip{Seats: 2, Stops: []TripStop{{Position: 0}, {Position: 1}}}
+	bookings := []Inscription{
+		{Status: InscriptionStatusPendingConfirmation, FromPosition: 0, ToPosition: 1},
+		{Status: InscriptionStatusCancelledByDriver, FromPosition: 0, ToPosition: 1},
+	}
+
+	assert.Equal(t, 1, trip.SeatsLeft(bookings, 0, 1), "a booking waiting for confirmation keeps its seat")
+}
+
+func TestCreateTripChangeData_RequiresReconfirmation(t *testing.T) {
+	assert.True(t, CreateTripChangeData{Field: TripChangeDate}.RequiresReconfirmation())
+	assert.True(t, CreateTripChangeData{Field: TripChangeDepartureCity}.RequiresReconfirmation())
+	assert.True(t, CreateTripChangeData{Field: TripChangeArrivalCity}.RequiresReconfirmation())
+	assert.False(t, CreateTripChangeData{Field: TripChangeSeats}.RequiresReconfirmation())
+	assert.False(t, CreateTripChangeData{Field: TripChangePricePerSeat}.RequiresReconfirmation())
+}
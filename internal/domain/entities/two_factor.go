@@ -0,0 +1,13 @@
+package entities
+
+import "slices"
+
+// TwoFactorPolicy lists the roles that cannot log in without two-factor authentication
+type TwoFactorPolicy struct {
+	RequiredRoles []string
+}
+
+// Requires reports whether accounts with the given role must use two-factor authentication
+func (p TwoFactorPolicy) Requires(role string) bool {
+	return slices.Contains(p.RequiredRoles, role)
+}
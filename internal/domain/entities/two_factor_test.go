@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactorPolicy_Requires(t *testing.T) {
+	policy := TwoFactorPolicy{RequiredRoles: []string{"ADMIN"}}
+
+	assert.True(t, policy.Requires("ADMIN"))
+	assert.False(t, policy.Requires("USER"))
+	assert.False(t, TwoFactorPolicy{}.Requires("ADMIN"))
+}
+
+func TestAuth_TwoFactorState(t *testing.T) {
+	secret := "SECRET"
+	now := time.Now()
+
+	none := &Auth{}
+	assert.False(t, none.IsTwoFactorEnabled())
+	assert.False(t, none.HasPendingTotpEnrollment())
+
+	pending := &Auth{TotpSecret: &secret}
+	assert.False(t, pending.IsTwoFactorEnabled())
+	assert.True(t, pending.HasPendingTotpEnrollment())
+
+	enabled := &Auth{TotpSecret: &secret, TotpEnabledAt: &now}
+	assert.True(t, enabled.IsTwoFactorEnabled())
+	assert.False(t, enabled.HasPendingTotpEnrollment())
+}
@@ -1,6 +1,11 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+	"math"
+	"time"
+)
 
 // DomainError is the base error type for domain-level errors
 type DomainError struct {
@@ -12,6 +17,21 @@ func (e *DomainError) Error() string {
 	return e.Message
 }
 
+func (e *DomainError) domainError() *DomainError {
+	return e
+}
+
+// AsDomainError finds the DomainError carried by err, including the one
+// embedded in typed errors such as *UserNotFoundError which errors.As
+// cannot match against *DomainError directly.
+func AsDomainError(err error) (*DomainError, bool) {
+	var carrier interface{ domainError() *DomainError }
+	if stderrors.As(err, &carrier) {
+		return carrier.domainError(), true
+	}
+	return nil, false
+}
+
 // --- Error registry: maps error codes to HTTP status codes ---
 
 var errorHTTPStatus = map[string]int{
@@ -35,6 +55,41 @@ var errorHTTPStatus = map[string]int{
 	"TOKEN_EXPIRED":         401,
 	"TOKEN_INVALID":         401,
 	"TOKEN_MALFORMED":       400,
+	"REFRESH_TOKEN_REUSED":  401,
+	"EMAIL_NOT_VERIFIED":    403,
+	"EMAIL_ALREADY_VERIFIED": 409,
+	"ACCOUNT_LOCKED":        429,
+	"TWO_FACTOR_ALREADY_ENABLED": 409,
+	"TWO_FACTOR_NOT_ENROLLED": 400,
+	"INVALID_TWO_FACTOR_CODE": 401,
+	"INVALID_CURRENT_PASSWORD": 400,
+	"PASSWORD_REUSED": 400,
+	"OIDC_PROVIDER_NOT_FOUND": 404,
+	"OIDC_LOGIN_FAILED": 401,
+	"OIDC_EMAIL_NOT_VERIFIED": 403,
+	"API_KEY_NOT_FOUND": 404,
+	"SERVICE_ACCOUNT_NOT_FOUND": 404,
+	"SESSION_NOT_FOUND": 404,
+	"ROLE_NOT_FOUND": 404,
+	"ACCOUNT_SUSPENDED": 403,
+	"ACCOUNT_BANNED": 403,
+	"TRIP_NOT_BOOKABLE": 409,
+	"INVALID_TRIP_TRANSITION": 409,
+	"TRIP_HAS_BOOKINGS": 409,
+	"INVALID_TRIP_STOPS": 400,
+	"INVALID_TRIP_SEGMENT": 400,
+	"INVALID_TRIP_DATE": 400,
+	"TRIP_SERIES_NOT_FOUND": 404,
+	"INVALID_TRIP_SERIES": 400,
+	"ALREADY_SUBSCRIBED": 409,
+	"SUBSCRIPTION_NOT_FOUND": 404,
+	"SEATS_BELOW_BOOKINGS": 409,
+	"PRICE_ABOVE_CAP": 400,
+	"TRIP_NOT_EDITABLE": 409,
+	"INSCRIPTION_NOT_PENDING": 409,
+	"CITY_NOT_LOCATED": 400,
+	"INVALID_SEARCH_AREA": 400,
+	"TWO_FACTOR_REQUIRED": 403,
 	"VALIDATION_ERROR":      400,
 	"RELATION_CONSTRAINT":   409,
 	"INTERNAL_ERROR":        500,
@@ -193,3 +248,350 @@ func NewForbiddenError(resource, id string) *ForbiddenError {
 		Code:    "FORBIDDEN",
 	}}
 }
+
+type TokenInvalidError struct{ DomainError }
+
+func NewTokenInvalidError() *TokenInvalidError {
+	return &TokenInvalidError{DomainError{
+		Message: "Invalid token",
+		Code:    "TOKEN_INVALID",
+	}}
+}
+
+type TokenExpiredError struct{ DomainError }
+
+func NewTokenExpiredError() *TokenExpiredError {
+	return &TokenExpiredError{DomainError{
+		Message: "Token has expired",
+		Code:    "TOKEN_EXPIRED",
+	}}
+}
+
+type RefreshTokenReusedError struct{ DomainError }
+
+func NewRefreshTokenReusedError() *RefreshTokenReusedError {
+	return &RefreshTokenReusedError{DomainError{
+		Message: "Refresh token has already been used; all sessions from this login have been revoked",
+		Code:    "REFRESH_TOKEN_REUSED",
+	}}
+}
+
+type EmailAlreadyVerifiedError struct{ DomainError }
+
+func NewEmailAlreadyVerifiedError() *EmailAlreadyVerifiedError {
+	return &EmailAlreadyVerifiedError{DomainError{
+		Message: "Email address is already verified",
+		Code:    "EMAIL_ALREADY_VERIFIED",
+	}}
+}
+
+// AccountLockedError is returned while an account is locked after repeated failed logins
+type AccountLockedError struct {
+	DomainError
+	RetryAfter time.Duration
+}
+
+func NewAccountLockedError(retryAfter time.Duration) *AccountLockedError {
+	return &AccountLockedError{
+		DomainError: DomainError{
+			Message: fmt.Sprintf("Too many failed login attempts, try again in %d seconds", retryAfterSeconds(retryAfter)),
+			Code:    "ACCOUNT_LOCKED",
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// RetryAfterSeconds returns the lock remainder rounded up to whole seconds, as sent in Retry-After
+func (e *AccountLockedError) RetryAfterSeconds() int {
+	return retryAfterSeconds(e.RetryAfter)
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+type TwoFactorAlreadyEnabledError struct{ DomainError }
+
+func NewTwoFactorAlreadyEnabledError() *TwoFactorAlreadyEnabledError {
+	return &TwoFactorAlreadyEnabledError{DomainError{
+		Message: "Two-factor authentication is already enabled",
+		Code:    "TWO_FACTOR_ALREADY_ENABLED",
+	}}
+}
+
+type TwoFactorNotEnrolledError struct{ DomainError }
+
+func NewTwoFactorNotEnrolledError() *TwoFactorNotEnrolledError {
+	return &TwoFactorNotEnrolledError{DomainError{
+		Message: "Start two-factor enrollment first",
+		Code:    "TWO_FACTOR_NOT_ENROLLED",
+	}}
+}
+
+type InvalidTwoFactorCodeError struct{ DomainError }
+
+func NewInvalidTwoFactorCodeError() *InvalidTwoFactorCodeError {
+	return &InvalidTwoFactorCodeError{DomainError{
+		Message: "Invalid two-factor code",
+		Code:    "INVALID_TWO_FACTOR_CODE",
+	}}
+}
+
+type InvalidCurrentPasswordError struct{ DomainError }
+
+func NewInvalidCurrentPasswordError() *InvalidCurrentPasswordError {
+	return &InvalidCurrentPasswordError{DomainError{
+		Message: "Current password is incorrect",
+		Code:    "INVALID_CURRENT_PASSWORD",
+	}}
+}
+
+type PasswordReusedError struct{ DomainError }
+
+func NewPasswordReusedError() *PasswordReusedError {
+	return &PasswordReusedError{DomainError{
+		Message: "Password was used recently, choose a different one",
+		Code:    "PASSWORD_REUSED",
+	}}
+}
+
+type OidcProviderNotFoundError struct{ DomainError }
+
+func NewOidcProviderNotFoundError() *OidcProviderNotFoundError {
+	return &OidcProviderNotFoundError{DomainError{
+		Message: "Identity provider not found",
+		Code:    "OIDC_PROVIDER_NOT_FOUND",
+	}}
+}
+
+type OidcLoginFailedError struct{ DomainError }
+
+func NewOidcLoginFailedError() *OidcLoginFailedError {
+	return &OidcLoginFailedError{DomainError{
+		Message: "Sign-in with the identity provider failed",
+		Code:    "OIDC_LOGIN_FAILED",
+	}}
+}
+
+type OidcEmailNotVerifiedError struct{ DomainError }
+
+func NewOidcEmailNotVerifiedError() *OidcEmailNotVerifiedError {
+	return &OidcEmailNotVerifiedError{DomainError{
+		Message: "The identity provider did not confirm an email address",
+		Code:    "OIDC_EMAIL_NOT_VERIFIED",
+	}}
+}
+
+type ApiKeyNotFoundError struct{ DomainError }
+
+func NewApiKeyNotFoundError(identifier string) *ApiKeyNotFoundError {
+	return &ApiKeyNotFoundError{DomainError{
+		Message: fmt.Sprintf("API key not found: %s", identifier),
+		Code:    "API_KEY_NOT_FOUND",
+	}}
+}
+
+type ServiceAccountNotFoundError struct{ DomainError }
+
+func NewServiceAccountNotFoundError(identifier string) *ServiceAccountNotFoundError {
+	return &ServiceAccountNotFoundError{DomainError{
+		Message: fmt.Sprintf("Service account not found: %s", identifier),
+		Code:    "SERVICE_ACCOUNT_NOT_FOUND",
+	}}
+}
+
+type SessionNotFoundError struct{ DomainError }
+
+func NewSessionNotFoundError(identifier string) *SessionNotFoundError {
+	return &SessionNotFoundError{DomainError{
+		Message: fmt.Sprintf("Session not found: %s", identifier),
+		Code:    "SESSION_NOT_FOUND",
+	}}
+}
+
+type RoleNotFoundError struct{ DomainError }
+
+func NewRoleNotFoundError(identifier string) *RoleNotFoundError {
+	return &RoleNotFoundError{DomainError{
+		Message: fmt.Sprintf("Role not found: %s", identifier),
+		Code:    "ROLE_NOT_FOUND",
+	}}
+}
+
+type AccountBannedError struct{ DomainError }
+
+func NewAccountBannedError() *AccountBannedError {
+	return &AccountBannedError{DomainError{
+		Message: "Account has been banned",
+		Code:    "ACCOUNT_BANNED",
+	}}
+}
+
+// AccountSuspendedError is returned while an administrator has suspended the account
+type AccountSuspendedError struct {
+	DomainError
+	Until time.Time
+}
+
+func NewAccountSuspendedError(until time.Time) *AccountSuspendedError {
+	return &AccountSuspendedError{
+		DomainError: DomainError{
+			Message: fmt.Sprintf("Account suspended until %s", until.UTC().Format(time.RFC3339)),
+			Code:    "ACCOUNT_SUSPENDED",
+		},
+		Until: until,
+	}
+}
+
+type TripNotBookableError struct{ DomainError }
+
+func NewTripNotBookableError(identifier string) *TripNotBookableError {
+	return &TripNotBookableError{DomainError{
+		Message: fmt.Sprintf("Trip is not open for booking: %s", identifier),
+		Code:    "TRIP_NOT_BOOKABLE",
+	}}
+}
+
+type InvalidTripTransitionError struct{ DomainError }
+
+func NewInvalidTripTransitionError(tripId, from, to string) *InvalidTripTransitionError {
+	return &InvalidTripTransitionError{DomainError{
+		Message: fmt.Sprintf("Trip %s cannot go from %s to %s", tripId, from, to),
+		Code:    "INVALID_TRIP_TRANSITION",
+	}}
+}
+
+type TripHasBookingsError struct{ DomainError }
+
+func NewTripHasBookingsError(identifier string) *TripHasBookingsError {
+	return &TripHasBookingsError{DomainError{
+		Message: fmt.Sprintf("Trip has bookings: %s", identifier),
+		Code:    "TRIP_HAS_BOOKINGS",
+	}}
+}
+
+type InvalidTripStopsError struct{ DomainError }
+
+func NewInvalidTripStopsError(identifier string) *InvalidTripStopsError {
+	return &InvalidTripStopsError{DomainError{
+		Message: fmt.Sprintf("Invalid trip stops: %s", identifier),
+		Code:    "INVALID_TRIP_STOPS",
+	}}
+}
+
+type InvalidTripSegmentError struct{ DomainError }
+
+func NewInvalidTripSegmentError(identifier string) *InvalidTripSegmentError {
+	return &InvalidTripSegmentError{DomainError{
+		Message: fmt.Sprintf("Trip does not stop at the requested cities in this order: %s", identifier),
+		Code:    "INVALID_TRIP_SEGMENT",
+	}}
+}
+
+type InvalidTripDateError struct{ DomainError }
+
+func NewInvalidTripDateError(identifier string) *InvalidTripDateError {
+	return &InvalidTripDateError{DomainError{
+		Message: fmt.Sprintf("Invalid trip date: %s", identifier),
+		Code:    "INVALID_TRIP_DATE",
+	}}
+}
+
+type TripSeriesNotFoundError struct{ DomainError }
+
+func NewTripSeriesNotFoundError(identifier string) *TripSeriesNotFoundError {
+	return &TripSeriesNotFoundError{DomainError{
+		Message: fmt.Sprintf("Trip series not found: %s", identifier),
+		Code:    "TRIP_SERIES_NOT_FOUND",
+	}}
+}
+
+type InvalidTripSeriesError struct{ DomainError }
+
+func NewInvalidTripSeriesError(identifier string) *InvalidTripSeriesError {
+	return &InvalidTripSeriesError{DomainError{
+		Message: fmt.Sprintf("Invalid trip series: %s", identifier),
+		Code:    "INVALID_TRIP_SERIES",
+	}}
+}
+
+type AlreadySubscribedError struct{ DomainError }
+
+func NewAlreadySubscribedError(identifier string) *AlreadySubscribedError {
+	return &AlreadySubscribedError{DomainError{
+		Message: fmt.Sprintf("Already subscribed to trip series: %s", identifier),
+		Code:    "ALREADY_SUBSCRIBED",
+	}}
+}
+
+type SubscriptionNotFoundError struct{ DomainError }
+
+func NewSubscriptionNotFoundError(identifier string) *SubscriptionNotFoundError {
+	return &SubscriptionNotFoundError{DomainError{
+		Message: fmt.Sprintf("Not subscribed to trip series: %s", identifier),
+		Code:    "SUBSCRIPTION_NOT_FOUND",
+	}}
+}
+
+type SeatsBelowBookingsError struct{ DomainError }
+
+func NewSeatsBelowBookingsError(identifier string) *SeatsBelowBookingsError {
+	return &SeatsBelowBookingsError{DomainError{
+		Message: fmt.Sprintf("Trip has more bookings than the requested seats: %s", identifier),
+		Code:    "SEATS_BELOW_BOOKINGS",
+	}}
+}
+
+type PriceAboveCapError struct{ DomainError }
+
+func NewPriceAboveCapError(identifier string) *PriceAboveCapError {
+	return &PriceAboveCapError{DomainError{
+		Message: fmt.Sprintf("Price per seat is above the cost-sharing cap of: %s", identifier),
+		Code:    "PRICE_ABOVE_CAP",
+	}}
+}
+
+type TripNotEditableError struct{ DomainError }
+
+func NewTripNotEditableError(identifier string) *TripNotEditableError {
+	return &TripNotEditableError{DomainError{
+		Message: fmt.Sprintf("Trip can no longer be changed: %s", identifier),
+		Code:    "TRIP_NOT_EDITABLE",
+	}}
+}
+
+type InscriptionNotPendingError struct{ DomainError }
+
+func NewInscriptionNotPendingError(identifier string) *InscriptionNotPendingError {
+	return &InscriptionNotPendingError{DomainError{
+		Message: fmt.Sprintf("Booking has no change to confirm: %s", identifier),
+		Code:    "INSCRIPTION_NOT_PENDING",
+	}}
+}
+
+type CityNotLocatedError struct{ DomainError }
+
+func NewCityNotLocatedError(identifier string) *CityNotLocatedError {
+	return &CityNotLocatedError{DomainError{
+		Message: fmt.Sprintf("City has no coordinates: %s", identifier),
+		Code:    "CITY_NOT_LOCATED",
+	}}
+}
+
+type InvalidSearchAreaError struct{ DomainError }
+
+func NewInvalidSearchAreaError(identifier string) *InvalidSearchAreaError {
+	return &InvalidSearchAreaError{DomainError{
+		Message: fmt.Sprintf("Invalid search area: %s", identifier),
+		Code:    "INVALID_SEARCH_AREA",
+	}}
+}
+
+type TwoFactorRequiredError struct{ DomainError }
+
+func NewTwoFactorRequiredError() *TwoFactorRequiredError {
+	return &TwoFactorRequiredError{DomainError{
+		Message: "Two-factor authentication is required for this account; log in again to set it up",
+		Code:    "TWO_FACTOR_REQUIRED",
+	}}
+}
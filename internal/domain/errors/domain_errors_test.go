@@ -2,8 +2,10 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,6 +32,41 @@ func TestGetHTTPStatus_AllKnownCodes(t *testing.T) {
 		"TOKEN_EXPIRED":         401,
 		"TOKEN_INVALID":         401,
 		"TOKEN_MALFORMED":       400,
+		"REFRESH_TOKEN_REUSED":  401,
+		"EMAIL_NOT_VERIFIED":    403,
+		"EMAIL_ALREADY_VERIFIED": 409,
+		"ACCOUNT_LOCKED":        429,
+		"TWO_FACTOR_ALREADY_ENABLED": 409,
+		"TWO_FACTOR_NOT_ENROLLED": 400,
+		"INVALID_TWO_FACTOR_CODE": 401,
+		"INVALID_CURRENT_PASSWORD": 400,
+		"PASSWORD_REUSED": 400,
+		"OIDC_PROVIDER_NOT_FOUND": 404,
+		"OIDC_LOGIN_FAILED": 401,
+		"OIDC_EMAIL_NOT_VERIFIED": 403,
+		"API_KEY_NOT_FOUND": 404,
+		"SERVICE_ACCOUNT_NOT_FOUND": 404,
+		"SESSION_NOT_FOUND": 404,
+		"ROLE_NOT_FOUND": 404,
+		"ACCOUNT_SUSPENDED": 403,
+		"ACCOUNT_BANNED": 403,
+		"TRIP_NOT_BOOKABLE": 409,
+		"INVALID_TRIP_TRANSITION": 409,
+		"TRIP_HAS_BOOKINGS": 409,
+		"INVALID_TRIP_STOPS": 400,
+		"INVALID_TRIP_SEGMENT": 400,
+		"INVALID_TRIP_DATE": 400,
+		"TRIP_SERIES_NOT_FOUND": 404,
+		"INVALID_TRIP_SERIES": 400,
+		"ALREADY_SUBSCRIBED": 409,
+		"SUBSCRIPTION_NOT_FOUND": 404,
+		"SEATS_BELOW_BOOKINGS": 409,
+		"PRICE_ABOVE_CAP": 400,
+		"TRIP_NOT_EDITABLE": 409,
+		"INSCRIPTION_NOT_PENDING": 409,
+		"CITY_NOT_LOCATED": 400,
+		"INVALID_SEARCH_AREA": 400,
+		"TWO_FACTOR_REQUIRED": 403,
 		"VALIDATION_ERROR":      400,
 		"RELATION_CONSTRAINT":   409,
 		"INTERNAL_ERROR":        500,
@@ -149,6 +186,228 @@ func TestNewForbiddenError(t *testing.T) {
 	assert.Contains(t, err.Message, "trip-1")
 }
 
+func TestNewTokenInvalidError(t *testing.T) {
+	err := NewTokenInvalidError()
+	assert.Equal(t, "TOKEN_INVALID", err.Code)
+	assert.Equal(t, "Invalid token", err.Message)
+}
+
+func TestNewTokenExpiredError(t *testing.T) {
+	err := NewTokenExpiredError()
+	assert.Equal(t, "TOKEN_EXPIRED", err.Code)
+	assert.Equal(t, "Token has expired", err.Message)
+}
+
+func TestNewRefreshTokenReusedError(t *testing.T) {
+	err := NewRefreshTokenReusedError()
+	assert.Equal(t, "REFRESH_TOKEN_REUSED", err.Code)
+	assert.Contains(t, err.Message, "revoked")
+}
+
+func TestNewEmailAlreadyVerifiedError(t *testing.T) {
+	err := NewEmailAlreadyVerifiedError()
+	assert.Equal(t, "EMAIL_ALREADY_VERIFIED", err.Code)
+	assert.Equal(t, "Email address is already verified", err.Message)
+}
+
+func TestNewAccountLockedError(t *testing.T) {
+	err := NewAccountLockedError(1500 * time.Millisecond)
+	assert.Equal(t, "ACCOUNT_LOCKED", err.Code)
+	assert.Equal(t, 1500*time.Millisecond, err.RetryAfter)
+	assert.Equal(t, 2, err.RetryAfterSeconds(), "partial seconds round up")
+	assert.Contains(t, err.Message, "2 seconds")
+}
+
+func TestNewTwoFactorAlreadyEnabledError(t *testing.T) {
+	err := NewTwoFactorAlreadyEnabledError()
+	assert.Equal(t, "TWO_FACTOR_ALREADY_ENABLED", err.Code)
+	assert.Equal(t, "Two-factor authentication is already enabled", err.Message)
+}
+
+func TestNewTwoFactorNotEnrolledError(t *testing.T) {
+	err := NewTwoFactorNotEnrolledError()
+	assert.Equal(t, "TWO_FACTOR_NOT_ENROLLED", err.Code)
+	assert.Equal(t, "Start two-factor enrollment first", err.Message)
+}
+
+func TestNewInvalidTwoFactorCodeError(t *testing.T) {
+	err := NewInvalidTwoFactorCodeError()
+	assert.Equal(t, "INVALID_TWO_FACTOR_CODE", err.Code)
+	assert.Equal(t, "Invalid two-factor code", err.Message)
+}
+
+func TestNewInvalidCurrentPasswordError(t *testing.T) {
+	err := NewInvalidCurrentPasswordError()
+	assert.Equal(t, "INVALID_CURRENT_PASSWORD", err.Code)
+	assert.Equal(t, "Current password is incorrect", err.Message)
+}
+
+func TestNewPasswordReusedError(t *testing.T) {
+	err := NewPasswordReusedError()
+	assert.Equal(t, "PASSWORD_REUSED", err.Code)
+	assert.Equal(t, "Password was used recently, choose a different one", err.Message)
+}
+
+func TestNewOidcProviderNotFoundError(t *testing.T) {
+	err := NewOidcProviderNotFoundError()
+	assert.Equal(t, "OIDC_PROVIDER_NOT_FOUND", err.Code)
+	assert.Equal(t, "Identity provider not found", err.Message)
+}
+
+func TestNewOidcLoginFailedError(t *testing.T) {
+	err := NewOidcLoginFailedError()
+	assert.Equal(t, "OIDC_LOGIN_FAILED", err.Code)
+	assert.Equal(t, "Sign-in with the identity provider failed", err.Message)
+}
+
+func TestNewOidcEmailNotVerifiedError(t *testing.T) {
+	err := NewOidcEmailNotVerifiedError()
+	assert.Equal(t, "OIDC_EMAIL_NOT_VERIFIED", err.Code)
+	assert.Equal(t, "The identity provider did not confirm an email address", err.Message)
+}
+
+func TestNewApiKeyNotFoundError(t *testing.T) {
+	err := NewApiKeyNotFoundError("key-1")
+	assert.Equal(t, "API_KEY_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "key-1")
+}
+
+func TestNewServiceAccountNotFoundError(t *testing.T) {
+	err := NewServiceAccountNotFoundError("sa-1")
+	assert.Equal(t, "SERVICE_ACCOUNT_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "sa-1")
+}
+
+func TestNewSessionNotFoundError(t *testing.T) {
+	err := NewSessionNotFoundError("session-1")
+	assert.Equal(t, "SESSION_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "session-1")
+}
+
+func TestNewRoleNotFoundError(t *testing.T) {
+	err := NewRoleNotFoundError("SUPPORT")
+	assert.Equal(t, "ROLE_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "SUPPORT")
+}
+
+func TestNewAccountBannedError(t *testing.T) {
+	err := NewAccountBannedError()
+	assert.Equal(t, "ACCOUNT_BANNED", err.Code)
+	assert.Equal(t, "Account has been banned", err.Message)
+}
+
+func TestNewAccountSuspendedError(t *testing.T) {
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	err := NewAccountSuspendedError(until)
+	assert.Equal(t, "ACCOUNT_SUSPENDED", err.Code)
+	assert.Equal(t, until, err.Until)
+	assert.Equal(t, "Account suspended until 2030-01-02T03:04:05Z", err.Message)
+}
+
+func TestNewTripNotBookableError(t *testing.T) {
+	err := NewTripNotBookableError("trip-1")
+	assert.Equal(t, "TRIP_NOT_BOOKABLE", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+}
+
+func TestNewInvalidTripTransitionError(t *testing.T) {
+	err := NewInvalidTripTransitionError("trip-1", "COMPLETED", "IN_PROGRESS")
+	assert.Equal(t, "INVALID_TRIP_TRANSITION", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+	assert.Contains(t, err.Message, "COMPLETED")
+	assert.Contains(t, err.Message, "IN_PROGRESS")
+}
+
+func TestNewTripHasBookingsError(t *testing.T) {
+	err := NewTripHasBookingsError("trip-1")
+	assert.Equal(t, "TRIP_HAS_BOOKINGS", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+}
+
+func TestNewInvalidTripStopsError(t *testing.T) {
+	err := NewInvalidTripStopsError("stop times must increase along the route")
+	assert.Equal(t, "INVALID_TRIP_STOPS", err.Code)
+	assert.Contains(t, err.Message, "stop times must increase along the route")
+}
+
+func TestNewInvalidTripSegmentError(t *testing.T) {
+	err := NewInvalidTripSegmentError("trip-1")
+	assert.Equal(t, "INVALID_TRIP_SEGMENT", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+}
+
+func TestNewInvalidTripDateError(t *testing.T) {
+	err := NewInvalidTripDateError("2026-03-15")
+	assert.Equal(t, "INVALID_TRIP_DATE", err.Code)
+	assert.Contains(t, err.Message, "2026-03-15")
+}
+
+func TestNewTripSeriesNotFoundError(t *testing.T) {
+	err := NewTripSeriesNotFoundError("series-1")
+	assert.Equal(t, "TRIP_SERIES_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "series-1")
+}
+
+func TestNewInvalidTripSeriesError(t *testing.T) {
+	err := NewInvalidTripSeriesError("interval must be at least one week")
+	assert.Equal(t, "INVALID_TRIP_SERIES", err.Code)
+	assert.Contains(t, err.Message, "interval must be at least one week")
+}
+
+func TestNewAlreadySubscribedError(t *testing.T) {
+	err := NewAlreadySubscribedError("series-1")
+	assert.Equal(t, "ALREADY_SUBSCRIBED", err.Code)
+	assert.Contains(t, err.Message, "series-1")
+}
+
+func TestNewSubscriptionNotFoundError(t *testing.T) {
+	err := NewSubscriptionNotFoundError("series-1")
+	assert.Equal(t, "SUBSCRIPTION_NOT_FOUND", err.Code)
+	assert.Contains(t, err.Message, "series-1")
+}
+
+func TestNewSeatsBelowBookingsError(t *testing.T) {
+	err := NewSeatsBelowBookingsError("trip-1")
+	assert.Equal(t, "SEATS_BELOW_BOOKINGS", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+}
+
+func TestNewPriceAboveCapError(t *testing.T) {
+	err := NewPriceAboveCapError("30.00 EUR")
+	assert.Equal(t, "PRICE_ABOVE_CAP", err.Code)
+	assert.Contains(t, err.Message, "30.00 EUR")
+}
+
+func TestNewTripNotEditableError(t *testing.T) {
+	err := NewTripNotEditableError("trip-1")
+	assert.Equal(t, "TRIP_NOT_EDITABLE", err.Code)
+	assert.Contains(t, err.Message, "trip-1")
+}
+
+func TestNewInscriptionNotPendingError(t *testing.T) {
+	err := NewInscriptionNotPendingError("inscription-1")
+	assert.Equal(t, "INSCRIPTION_NOT_PENDING", err.Code)
+	assert.Contains(t, err.Message, "inscription-1")
+}
+
+func TestNewCityNotLocatedError(t *testing.T) {
+	err := NewCityNotLocatedError("Villeurbanne")
+	assert.Equal(t, "CITY_NOT_LOCATED", err.Code)
+	assert.Contains(t, err.Message, "Villeurbanne")
+}
+
+func TestNewInvalidSearchAreaError(t *testing.T) {
+	err := NewInvalidSearchAreaError("a radius needs a point or a city")
+	assert.Equal(t, "INVALID_SEARCH_AREA", err.Code)
+	assert.Contains(t, err.Message, "a radius needs a point or a city")
+}
+
+func TestNewTwoFactorRequiredError(t *testing.T) {
+	err := NewTwoFactorRequiredError()
+	assert.Equal(t, "TWO_FACTOR_REQUIRED", err.Code)
+	assert.Equal(t, "Two-factor authentication is required for this account; log in again to set it up", err.Message)
+}
+
 func TestDomainErrors_ImplementErrorInterface(t *testing.T) {
 	tests := []struct {
 		name string
@@ -170,6 +429,42 @@ func TestDomainErrors_ImplementErrorInterface(t *testing.T) {
 		{"ColorNotFoundError", NewColorNotFoundError("1")},
 		{"ColorAlreadyExistsError", NewColorAlreadyExistsError("Red")},
 		{"ForbiddenError", NewForbiddenError("res", "1")},
+		{"TokenInvalidError", NewTokenInvalidError()},
+		{"TokenExpiredError", NewTokenExpiredError()},
+		{"RefreshTokenReusedError", NewRefreshTokenReusedError()},
+		{"EmailAlreadyVerifiedError", NewEmailAlreadyVerifiedError()},
+		{"AccountLockedError", NewAccountLockedError(time.Minute)},
+		{"TwoFactorAlreadyEnabledError", NewTwoFactorAlreadyEnabledError()},
+		{"TwoFactorNotEnrolledError", NewTwoFactorNotEnrolledError()},
+		{"InvalidTwoFactorCodeError", NewInvalidTwoFactorCodeError()},
+		{"InvalidCurrentPasswordError", NewInvalidCurrentPasswordError()},
+		{"PasswordReusedError", NewPasswordReusedError()},
+		{"OidcProviderNotFoundError", NewOidcProviderNotFoundError()},
+		{"OidcLoginFailedError", NewOidcLoginFailedError()},
+		{"OidcEmailNotVerifiedError", NewOidcEmailNotVerifiedError()},
+		{"ApiKeyNotFoundError", NewApiKeyNotFoundError("1")},
+		{"ServiceAccountNotFoundError", NewServiceAccountNotFoundError("1")},
+		{"SessionNotFoundError", NewSessionNotFoundError("1")},
+		{"RoleNotFoundError", NewRoleNotFoundError("1")},
+		{"AccountBannedError", NewAccountBannedError()},
+		{"AccountSuspendedError", NewAccountSuspendedError(time.Now())},
+		{"TripNotBookableError", NewTripNotBookableError("1")},
+		{"InvalidTripTransitionError", NewInvalidTripTransitionError("1", "A", "B")},
+		{"TripHasBookingsError", NewTripHasBookingsError("1")},
+		{"InvalidTripStopsError", NewInvalidTripStopsError("1")},
+		{"InvalidTripSegmentError", NewInvalidTripSegmentError("1")},
+		{"InvalidTripDateError", NewInvalidTripDateError("1")},
+		{"TripSeriesNotFoundError", NewTripSeriesNotFoundError("1")},
+		{"InvalidTripSeriesError", NewInvalidTripSeriesError("1")},
+		{"AlreadySubscribedError", NewAlreadySubscribedError("1")},
+		{"SubscriptionNotFoundError", NewSubscriptionNotFoundError("1")},
+		{"SeatsBelowBookingsError", NewSeatsBelowBookingsError("1")},
+		{"PriceAboveCapError", NewPriceAboveCapError("1")},
+		{"TripNotEditableError", NewTripNotEditableError("1")},
+		{"InscriptionNotPendingError", NewInscriptionNotPendingError("1")},
+		{"CityNotLocatedError", NewCityNotLocatedError("1")},
+		{"InvalidSearchAreaError", NewInvalidSearchAreaError("1")},
+		{"TwoFactorRequiredError", NewTwoFactorRequiredError()},
 	}
 
 	for _, tt := range tests {
@@ -206,6 +501,42 @@ func TestDomainErrors_EmbedDomainError(t *testing.T) {
 		{"ColorNotFoundError", NewColorNotFoundError("1"), "COLOR_NOT_FOUND"},
 		{"ColorAlreadyExistsError", NewColorAlreadyExistsError("Red"), "COLOR_ALREADY_EXISTS"},
 		{"ForbiddenError", NewForbiddenError("res", "1"), "FORBIDDEN"},
+		{"TokenInvalidError", NewTokenInvalidError(), "TOKEN_INVALID"},
+		{"TokenExpiredError", NewTokenExpiredError(), "TOKEN_EXPIRED"},
+		{"RefreshTokenReusedError", NewRefreshTokenReusedError(), "REFRESH_TOKEN_REUSED"},
+		{"EmailAlreadyVerifiedError", NewEmailAlreadyVerifiedError(), "EMAIL_ALREADY_VERIFIED"},
+		{"AccountLockedError", NewAccountLockedError(time.Minute), "ACCOUNT_LOCKED"},
+		{"TwoFactorAlreadyEnabledError", NewTwoFactorAlreadyEnabledError(), "TWO_FACTOR_ALREADY_ENABLED"},
+		{"TwoFactorNotEnrolledError", NewTwoFactorNotEnrolledError(), "TWO_FACTOR_NOT_ENROLLED"},
+		{"InvalidTwoFactorCodeError", NewInvalidTwoFactorCodeError(), "INVALID_TWO_FACTOR_CODE"},
+		{"InvalidCurrentPasswordError", NewInvalidCurrentPasswordError(), "INVALID_CURRENT_PASSWORD"},
+		{"PasswordReusedError", NewPasswordReusedError(), "PASSWORD_REUSED"},
+		{"OidcProviderNotFoundError", NewOidcProviderNotFoundError(), "OIDC_PROVIDER_NOT_FOUND"},
+		{"OidcLoginFailedError", NewOidcLoginFailedError(), "OIDC_LOGIN_FAILED"},
+		{"OidcEmailNotVerifiedError", NewOidcEmailNotVerifiedError(), "OIDC_EMAIL_NOT_VERIFIED"},
+		{"ApiKeyNotFoundError", NewApiKeyNotFoundError("1"), "API_KEY_NOT_FOUND"},
+		{"ServiceAccountNotFoundError", NewServiceAccountNotFoundError("1"), "SERVICE_ACCOUNT_NOT_FOUND"},
+		{"SessionNotFoundError", NewSessionNotFoundError("1"), "SESSION_NOT_FOUND"},
+		{"RoleNotFoundError", NewRoleNotFoundError("1"), "ROLE_NOT_FOUND"},
+		{"AccountBannedError", NewAccountBannedError(), "ACCOUNT_BANNED"},
+		{"AccountSuspendedError", NewAccountSuspendedError(time.Now()), "ACCOUNT_SUSPENDED"},
+		{"TripNotBookableError", NewTripNotBookableError("1"), "TRIP_NOT_BOOKABLE"},
+		{"InvalidTripTransitionError", NewInvalidTripTransitionError("1", "A", "B"), "INVALID_TRIP_TRANSITION"},
+		{"TripHasBookingsError", NewTripHasBookingsError("1"), "TRIP_HAS_BOOKINGS"},
+		{"InvalidTripStopsError", NewInvalidTripStopsError("1"), "INVALID_TRIP_STOPS"},
+		{"InvalidTripSegmentError", NewInvalidTripSegmentError("1"), "INVALID_TRIP_SEGMENT"},
+		{"InvalidTripDateError", NewInvalidTripDateError("1"), "INVALID_TRIP_DATE"},
+		{"TripSeriesNotFoundError", NewTripSeriesNotFoundError("1"), "TRIP_SERIES_NOT_FOUND"},
+		{"InvalidTripSeriesError", NewInvalidTripSeriesError("1"), "INVALID_TRIP_SERIES"},
+		{"AlreadySubscribedError", NewAlreadySubscribedError("1"), "ALREADY_SUBSCRIBED"},
+		{"SubscriptionNotFoundError", NewSubscriptionNotFoundError("1"), "SUBSCRIPTION_NOT_FOUND"},
+		{"SeatsBelowBookingsError", NewSeatsBelowBookingsError("1"), "SEATS_BELOW_BOOKINGS"},
+		{"PriceAboveCapError", NewPriceAboveCapError("1"), "PRICE_ABOVE_CAP"},
+		{"TripNotEditableError", NewTripNotEditableError("1"), "TRIP_NOT_EDITABLE"},
+		{"InscriptionNotPendingError", NewInscriptionNotPendingError("1"), "INSCRIPTION_NOT_PENDING"},
+		{"CityNotLocatedError", NewCityNotLocatedError("1"), "CITY_NOT_LOCATED"},
+		{"InvalidSearchAreaError", NewInvalidSearchAreaError("1"), "INVALID_SEARCH_AREA"},
+		{"TwoFactorRequiredError", NewTwoFactorRequiredError(), "TWO_FACTOR_REQUIRED"},
 	}
 
 	for _, tt := range tests {
@@ -235,8 +566,8 @@ func TestDomainError_WorksWithErrorsAs(t *testing.T) {
 func TestConcreteErrors_ErrorsAs_DoesNotMatchDomainError(t *testing.T) {
 	// Concrete typed errors embed DomainError by VALUE, so errors.As
 	// with a *DomainError target does NOT match them. This is important
-	// to document as expected behavior since the error handler relies on
-	// receiving plain *DomainError values.
+	// to document as expected behavior; use AsDomainError to reach the
+	// embedded value.
 	concreteErrors := []error{
 		NewUserAlreadyExistsError("a@b.com"),
 		NewInvalidCredentialsError(),
@@ -285,3 +616,29 @@ func TestConcreteErrors_ErrorsAs_MatchOwnType(t *testing.T) {
 		assert.Equal(t, "COLOR_ALREADY_EXISTS", target.Code)
 	})
 }
+
+func TestAsDomainError(t *testing.T) {
+	t.Run("typed error", func(t *testing.T) {
+		domainErr, ok := AsDomainError(NewUserNotFoundError("1"))
+		assert.True(t, ok)
+		assert.Equal(t, "USER_NOT_FOUND", domainErr.Code)
+	})
+
+	t.Run("wrapped typed error", func(t *testing.T) {
+		domainErr, ok := AsDomainError(fmt.Errorf("login: %w", NewAccountLockedError(time.Minute)))
+		assert.True(t, ok)
+		assert.Equal(t, "ACCOUNT_LOCKED", domainErr.Code)
+	})
+
+	t.Run("plain domain error", func(t *testing.T) {
+		domainErr, ok := AsDomainError(&DomainError{Code: "FORBIDDEN", Message: "no"})
+		assert.True(t, ok)
+		assert.Equal(t, "FORBIDDEN", domainErr.Code)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		domainErr, ok := AsDomainError(errors.New("boom"))
+		assert.False(t, ok)
+		assert.Nil(t, domainErr)
+	})
+}
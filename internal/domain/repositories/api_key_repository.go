@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// ApiKeyRepository defines the interface for API key persistence operations
+type ApiKeyRepository interface {
+	Create(ctx context.Context, data entities.CreateApiKeyData) (*entities.ApiKey, error)
+	FindByID(ctx context.Context, id string) (*entities.ApiKey, error)
+	// FindPrincipalByHash resolves a key and the role it acts with. It returns nil when the
+	// key is unknown or its owner's account was anonymized.
+	FindPrincipalByHash(ctx context.Context, keyHash string) (*entities.ApiKeyPrincipal, error)
+	FindByUserID(ctx context.Context, userID string) ([]entities.ApiKey, error)
+	FindByServiceAccountID(ctx context.Context, serviceAccountID string) ([]entities.ApiKey, error)
+	// Revoke returns false when the key was already revoked
+	Revoke(ctx context.Context, id string) (bool, error)
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
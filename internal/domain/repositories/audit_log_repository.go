@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// AuditLogRepository appends to and queries the audit log. It cannot change past entries.
+type AuditLogRepository interface {
+	Create(ctx context.Context, data entities.CreateAuditLogData) error
+	// FindByFilters returns matching entries, newest first, along with their total count
+	FindByFilters(ctx context.Context, filters entities.AuditLogFilters, skip, take int) ([]entities.AuditLog, int, error)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/domain/entities"
 )
@@ -9,7 +10,32 @@ import (
 // AuthRepository defines the interface for auth persistence operations
 type AuthRepository interface {
 	FindByEmail(ctx context.Context, email string) (*entities.Auth, error)
+	FindByRefID(ctx context.Context, refID int64) (*entities.Auth, error)
 	CreateWithUser(ctx context.Context, authData entities.CreateAuthData, userData entities.CreateUserData) (*entities.Auth, *entities.PublicUser, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	// UpdateRole also bumps the token version, invalidating access tokens issued with the old role
 	UpdateRole(ctx context.Context, refID int64, role string) error
+	// UpdatePassword also bumps the token version, invalidating access tokens issued before the change
+	UpdatePassword(ctx context.Context, refID int64, passwordHash string) error
+	// ReplacePasswordHash swaps the stored hash for an equivalent one (same password, new parameters).
+	// It returns false if the password changed meanwhile, and leaves the token version alone.
+	ReplacePasswordHash(ctx context.Context, refID int64, oldHash, newHash string) (bool, error)
+	MarkEmailVerified(ctx context.Context, refID int64) error
+	// IncrementFailedLogins atomically bumps the consecutive failure counter and returns its new value
+	IncrementFailedLogins(ctx context.Context, refID int64) (int, error)
+	LockUntil(ctx context.Context, refID int64, until time.Time) error
+	// ResetFailedLogins clears the failure counter and any lock
+	ResetFailedLogins(ctx context.Context, refID int64) error
+	// StartTotpEnrollment stores a new, unconfirmed TOTP secret. It has no effect once two-factor is enabled.
+	StartTotpEnrollment(ctx context.Context, refID int64, secret string) error
+	EnableTotp(ctx context.Context, refID int64) error
+	// UseTotpStep records the time step of an accepted code. It returns false if that step
+	// (or a later one) was already used, so each code is accepted at most once.
+	UseTotpStep(ctx context.Context, refID int64, step int64) (bool, error)
+	// Suspend blocks the account until the given time. The reason is shown to administrators only.
+	Suspend(ctx context.Context, refID int64, until time.Time, reason string) error
+	// Ban blocks the account until it is reinstated
+	Ban(ctx context.Context, refID int64, reason string) error
+	// Reinstate lifts any suspension or ban
+	Reinstate(ctx context.Context, refID int64) error
 }
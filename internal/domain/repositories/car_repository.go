@@ -10,6 +10,7 @@ import (
 type CarRepository interface {
 	FindAll(ctx context.Context, skip, take int) ([]entities.Car, int, error)
 	FindByID(ctx context.Context, id string) (*entities.Car, error)
+	FindByRefID(ctx context.Context, refID int64) (*entities.Car, error)
 	Create(ctx context.Context, data entities.CreateCarData) (*entities.Car, error)
 	Update(ctx context.Context, id string, data entities.UpdateCarData) (*entities.Car, error)
 	Delete(ctx context.Context, id string) error
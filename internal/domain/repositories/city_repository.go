@@ -12,5 +12,6 @@ type CityRepository interface {
 	FindByID(ctx context.Context, id string) (*entities.City, error)
 	FindByCityName(ctx context.Context, name string) (*entities.City, error)
 	Create(ctx context.Context, data entities.CreateCityData) (*entities.City, error)
+	Update(ctx context.Context, id string, data entities.UpdateCityData) (*entities.City, error)
 	Delete(ctx context.Context, id string) error
 }
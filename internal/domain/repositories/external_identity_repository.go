@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// ExternalIdentityRepository defines the interface for identity provider account links
+type ExternalIdentityRepository interface {
+	FindBySubject(ctx context.Context, issuer, subject string) (*entities.ExternalIdentity, error)
+	Create(ctx context.Context, data entities.CreateExternalIdentityData) (*entities.ExternalIdentity, error)
+}
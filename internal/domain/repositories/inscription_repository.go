@@ -13,7 +13,13 @@ type InscriptionRepository interface {
 	FindByUserID(ctx context.Context, userID string) ([]entities.Inscription, error)
 	FindByTripID(ctx context.Context, tripID string) ([]entities.Inscription, error)
 	FindByIDAndUserID(ctx context.Context, id string, userID string) (*entities.Inscription, error)
-	Create(ctx context.Context, data entities.CreateInscriptionData) (*entities.Inscription, error)
+	// Book creates the inscription on the trip and moves the trip to FULL when it took the last seat,
+	// with the trip row locked so that concurrent bookings cannot share a seat. It returns nil when the
+	// trip is no longer open or the segment has no seat left.
+	Book(ctx context.Context, trip *entities.Trip, data entities.CreateInscriptionData) (*entities.Inscription, error)
+	// UpdateStatus moves the inscription from one status to another and returns false, changing
+	// nothing, when it no longer has the status expected
+	UpdateStatus(ctx context.Context, id string, from, to string) (bool, error)
 	Delete(ctx context.Context, id string) error
 	ExistsByUserAndTrip(ctx context.Context, userRefID, tripRefID int64) (bool, error)
 	CountByTripRefID(ctx context.Context, tripRefID int64) (int, error)
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// OidcLoginStateRepository stores pending OpenID Connect logins between redirect and callback
+type OidcLoginStateRepository interface {
+	Create(ctx context.Context, data entities.CreateOidcLoginStateData) error
+	// Consume deletes and returns the login with the given state hash, or nil if there is none.
+	// Each state can therefore only be redeemed once.
+	Consume(ctx context.Context, stateHash string) (*entities.OidcLoginState, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// OneTimeTokenRepository defines the interface for single-use token persistence operations
+type OneTimeTokenRepository interface {
+	Create(ctx context.Context, data entities.CreateOneTimeTokenData) (*entities.OneTimeToken, error)
+	FindByTokenHash(ctx context.Context, purpose, tokenHash string) (*entities.OneTimeToken, error)
+	// Consume marks the token as used. It returns false when the token had
+	// already been used, so only one caller can ever redeem it.
+	Consume(ctx context.Context, id string) (bool, error)
+	// InvalidateAll marks every unused token of the given purpose for the account as used
+	InvalidateAll(ctx context.Context, authRefID int64, purpose string) error
+	// CountCreatedSince counts the tokens of the given purpose issued to the account since the given time
+	CountCreatedSince(ctx context.Context, authRefID int64, purpose string, since time.Time) (int64, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "context"
+
+// PasswordHistoryRepository stores hashes of an account's previous passwords so they cannot be reused
+type PasswordHistoryRepository interface {
+	// Add records a previous password hash, keeping only the newest keep entries for the account
+	Add(ctx context.Context, authRefID int64, passwordHash string, keep int) error
+	// FindRecent returns up to limit previous password hashes, newest first
+	FindRecent(ctx context.Context, authRefID int64, limit int) ([]string, error)
+}
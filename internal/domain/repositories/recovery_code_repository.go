@@ -0,0 +1,12 @@
+package repositories
+
+import "context"
+
+// RecoveryCodeRepository defines the interface for two-factor recovery code persistence.
+// Only hashes of the codes are stored.
+type RecoveryCodeRepository interface {
+	// ReplaceAll deletes the account's existing codes and stores the given ones
+	ReplaceAll(ctx context.Context, authRefID int64, codeHashes []string) error
+	// Consume marks a matching unused code as used. It returns false if none matched.
+	Consume(ctx context.Context, authRefID int64, codeHash string) (bool, error)
+}
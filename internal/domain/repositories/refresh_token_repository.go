@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// RefreshTokenRepository defines the interface for refresh token persistence operations
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, data entities.CreateRefreshTokenData) (*entities.RefreshToken, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	// Rotate revokes the token identified by id and creates its successor in the same family.
+	// It returns nil without error when the token had already been revoked concurrently.
+	Rotate(ctx context.Context, id string, data entities.CreateRefreshTokenData) (*entities.RefreshToken, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllForAuth(ctx context.Context, authRefID int64) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// RevokedTokenRepository defines the interface for the access token revocation list.
+// Entries only need to live until the revoked token would have expired anyway.
+type RevokedTokenRepository interface {
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
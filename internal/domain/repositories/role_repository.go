@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// RoleRepository reads role definitions, so permissions can be resolved on every request
+type RoleRepository interface {
+	// FindByName returns nil when no such role exists
+	FindByName(ctx context.Context, name string) (*entities.Role, error)
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// ServiceAccountRepository defines the interface for service account persistence operations
+type ServiceAccountRepository interface {
+	Create(ctx context.Context, data entities.CreateServiceAccountData) (*entities.ServiceAccount, error)
+	FindByID(ctx context.Context, id string) (*entities.ServiceAccount, error)
+	FindAll(ctx context.Context) ([]entities.ServiceAccount, error)
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// SessionRepository defines the interface for login session persistence operations
+type SessionRepository interface {
+	Create(ctx context.Context, data entities.CreateSessionData) (*entities.Session, error)
+	FindByID(ctx context.Context, id string) (*entities.Session, error)
+	// FindActiveByAuthRefID lists the account's sessions that are neither revoked nor expired,
+	// most recently seen first
+	FindActiveByAuthRefID(ctx context.Context, authRefID int64) ([]entities.Session, error)
+	// Touch records activity on a session and extends it until expiresAt
+	Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error
+	// Revoke returns false when the session was already revoked
+	Revoke(ctx context.Context, id string) (bool, error)
+	// RevokeAllForAuth revokes every session of the account and returns the IDs it revoked
+	RevokeAllForAuth(ctx context.Context, authRefID int64) ([]string, error)
+}
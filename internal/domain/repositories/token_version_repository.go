@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// TokenVersionRepository reads the token version and moderation state of an account by user ID,
+// so access tokens can be checked against them on every request.
+type TokenVersionRepository interface {
+	// CurrentState returns nil when no account exists for the user
+	CurrentState(ctx context.Context, userID string) (*entities.TokenState, error)
+	// Invalidate drops any cached state after the auth record changed
+	Invalidate(ctx context.Context, userID string) error
+}
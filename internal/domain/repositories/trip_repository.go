@@ -10,7 +10,24 @@ import (
 type TripRepository interface {
 	FindAll(ctx context.Context, skip, take int) ([]entities.Trip, int, error)
 	FindByID(ctx context.Context, id string) (*entities.Trip, error)
+	FindByRefID(ctx context.Context, refID int64) (*entities.Trip, error)
 	FindByFilters(ctx context.Context, filters entities.TripFilters) ([]entities.Trip, error)
+	// Create returns nil when the data is an occurrence of a series that already exists
 	Create(ctx context.Context, data entities.CreateTripData) (*entities.Trip, error)
+	// FindUpcomingBySeries returns the series' occurrences still open for booking, earliest first
+	FindUpcomingBySeries(ctx context.Context, seriesRefID int64) ([]entities.Trip, error)
+	// Update applies the changes, records them and asks booked passengers to reconfirm when required.
+	// A change of seats also moves the trip between SCHEDULED and FULL. It returns nil, changing
+	// nothing, when fewer seats would be left than are booked, counted with the trip locked against
+	// new bookings.
+	Update(ctx context.Context, id string, data entities.UpdateTripData) (*entities.Trip, error)
+	// FindChanges returns the recorded changes of a trip, oldest first
+	FindChanges(ctx context.Context, tripRefID int64) ([]entities.TripChange, error)
+	// UpdateStatus moves the trip from one status to another and returns false, changing nothing,
+	// when it no longer has the status expected
+	UpdateStatus(ctx context.Context, id string, from, to string) (bool, error)
+	// Cancel marks the trip cancelled with the driver's reason and cancels its active inscriptions. It
+	// returns false, changing nothing, when the trip is missing or no longer scheduled or full.
+	Cancel(ctx context.Context, id string, reason string) (bool, error)
 	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// TripSeriesRepository defines the interface for recurring trip persistence operations
+type TripSeriesRepository interface {
+	FindByID(ctx context.Context, id string) (*entities.TripSeries, error)
+	FindActive(ctx context.Context) ([]entities.TripSeries, error)
+	Create(ctx context.Context, data entities.CreateTripSeriesData) (*entities.TripSeries, error)
+	Update(ctx context.Context, id string, data entities.UpdateTripSeriesData) (*entities.TripSeries, error)
+	// Cancel marks the series cancelled with the driver's reason; its occurrences are cancelled separately
+	Cancel(ctx context.Context, id string, reason string) error
+	Subscribe(ctx context.Context, seriesRefID, userRefID int64) error
+	Unsubscribe(ctx context.Context, seriesRefID, userRefID int64) error
+	IsSubscribed(ctx context.Context, seriesRefID, userRefID int64) (bool, error)
+	// FindSubscriberRefIDs returns the user RefIDs of the series' subscribers, earliest first
+	FindSubscriberRefIDs(ctx context.Context, seriesRefID int64) ([]int64, error)
+}
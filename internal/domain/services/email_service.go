@@ -1,5 +1,7 @@
 package services
 
+import "time"
+
 // SendEmailOptions contains options for sending an email
 type SendEmailOptions struct {
 	To      string
@@ -10,5 +12,11 @@ type SendEmailOptions struct {
 // EmailService defines the interface for email operations
 type EmailService interface {
 	SendWelcomeEmail(to string, firstName string) error
+	SendPasswordResetEmail(to string, token string) error
+	SendVerificationEmail(to string, token string) error
+	SendMagicLinkEmail(to string, token string) error
+	SendTripCancelledEmail(to string, firstName string, dateTrip time.Time, reason string) error
+	SendTripChangedEmail(to string, firstName string, dateTrip time.Time, changes []string, reconfirm bool) error
+	SendTripSeriesCancelledEmail(to string, firstName string, departureCity string, arrivalCity string, reason string) error
 	Send(options SendEmailOptions) error
 }
@@ -1,13 +1,43 @@
 package services
 
-// JwtPayload represents the JWT token payload
+import "time"
+
+// JwtPayload represents the JWT token payload.
+// TokenID (the jti claim) and ExpiresAt are assigned by Sign and populated by Verify.
+// TokenVersion is the account's token version when the token was signed.
+// SessionID is the login session the token belongs to; it is empty for tokens issued outside one.
 type JwtPayload struct {
-	UserID string
-	Role   string
+	UserID        string
+	Role          string
+	EmailVerified bool
+	TokenVersion  int64
+	SessionID     string
+	TokenID       string
+	ExpiresAt     time.Time
+}
+
+// JSONWebKey is the public half of a token verification key, as published in a JWKS document (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet lists every key tokens may currently be verified with
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
 }
 
 // JwtService defines the interface for JWT operations
 type JwtService interface {
 	Sign(payload JwtPayload) (string, error)
 	Verify(token string) (*JwtPayload, error)
+	AccessTokenTTL() time.Duration
+	RefreshTokenTTL() time.Duration
+	PublicKeys() JSONWebKeySet
 }
@@ -0,0 +1,24 @@
+package services
+
+import "context"
+
+// OidcClaims is the identity asserted by a validated ID token
+type OidcClaims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+}
+
+// OidcService runs the OpenID Connect authorization code flow with PKCE against configured providers
+type OidcService interface {
+	HasProvider(provider string) bool
+	// AuthorizationURL builds the provider URL the user is sent to. The S256 code challenge
+	// is derived from codeVerifier, which must be kept to redeem the code.
+	AuthorizationURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems an authorization code and returns the claims of the validated ID token.
+	// The token must be signed by the provider, issued for this client and carry nonce.
+	Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*OidcClaims, error)
+}
@@ -4,4 +4,6 @@ package services
 type PasswordService interface {
 	Hash(password string) (string, error)
 	Verify(password string, hash string) (bool, error)
+	// NeedsRehash reports whether the hash was made with outdated cost parameters
+	NeedsRehash(hash string) bool
 }
@@ -0,0 +1,9 @@
+package services
+
+// SecretCipher encrypts secrets the server has to read back, such as TOTP shared secrets,
+// before they are stored
+type SecretCipher interface {
+	Encrypt(plaintext string) (string, error)
+	// Decrypt fails on values that were not encrypted with the same key
+	Decrypt(ciphertext string) (string, error)
+}
@@ -0,0 +1,8 @@
+package services
+
+// TokenService defines the interface for opaque token operations.
+// Opaque tokens are handed to clients as-is and only ever stored hashed.
+type TokenService interface {
+	Generate() (string, error)
+	Hash(token string) string
+}
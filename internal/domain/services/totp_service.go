@@ -0,0 +1,16 @@
+package services
+
+import "time"
+
+// TotpService defines the interface for RFC 6238 time-based one-time passwords
+type TotpService interface {
+	// GenerateSecret returns a new random base32-encoded shared secret
+	GenerateSecret() (string, error)
+	// ProvisioningURI returns the otpauth:// URI that authenticator apps import
+	ProvisioningURI(secret string, accountName string) string
+	// Validate checks a code against the secret, allowing one step of clock drift,
+	// and returns the time step it matched
+	Validate(secret string, code string, now time.Time) (int64, bool)
+	// GenerateRecoveryCode returns a new random, human-typeable recovery code
+	GenerateRecoveryCode() (string, error)
+}
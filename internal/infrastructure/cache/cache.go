@@ -53,6 +53,11 @@ func NewCacheService() *CacheService {
 	}
 }
 
+// Enabled reports whether the cache is connected and in use
+func (c *CacheService) Enabled() bool {
+	return c.enabled
+}
+
 // Get retrieves a cached value by key
 func (c *CacheService) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
 	if !c.enabled {
@@ -83,6 +88,18 @@ func (c *CacheService) Set(ctx context.Context, key string, value interface{}) e
 	return c.client.Set(ctx, c.prefix+key, data, c.ttl).Err()
 }
 
+// SetWithTTL stores a value in the cache with an explicit time-to-live
+func (c *CacheService) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if !c.enabled {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, c.prefix+key, data, ttl).Err()
+}
+
 // Delete removes a cached value by key
 func (c *CacheService) Delete(ctx context.Context, key string) error {
 	if !c.enabled {
@@ -185,3 +185,18 @@ func TestCache_GetMiss_Integration(t *testing.T) {
 	assert.False(t, found)
 	assert.Equal(t, "", output)
 }
+
+func TestCache_SetWithTTL_Integration(t *testing.T) {
+	flushRedis(t)
+	t.Cleanup(func() { flushRedis(t) })
+
+	ctx := context.Background()
+
+	err := testCache.SetWithTTL(ctx, "ttlkey", "short-lived", time.Minute)
+	require.NoError(t, err)
+
+	ttl, err := testCache.client.TTL(ctx, "test:ttlkey").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, time.Duration(0))
+	assert.LessOrEqual(t, ttl, time.Minute)
+}
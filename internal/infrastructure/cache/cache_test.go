@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -25,6 +26,17 @@ func TestCacheDisabled_SetIsNoop(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCacheDisabled_SetWithTTLIsNoop(t *testing.T) {
+	c := newDisabledCache()
+	err := c.SetWithTTL(context.Background(), "some-key", "some-value", time.Minute)
+	assert.NoError(t, err)
+}
+
+func TestCacheDisabled_Enabled(t *testing.T) {
+	c := newDisabledCache()
+	assert.False(t, c.Enabled())
+}
+
 func TestCacheDisabled_DeleteIsNoop(t *testing.T) {
 	c := newDisabledCache()
 	err := c.Delete(context.Background(), "some-key")
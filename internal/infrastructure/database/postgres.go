@@ -2,29 +2,197 @@ package database
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/lgxju/gogretago/config"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/lib/shared"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
 // AuthModel represents the authentication credentials table
 type AuthModel struct {
-	ID           string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	RefID        int64      `gorm:"column:ref_id;autoIncrement;uniqueIndex"`
-	Email        string     `gorm:"uniqueIndex;not null"`
-	Password     string     `gorm:"not null"`
-	Role         string     `gorm:"not null;default:'USER'"`
-	AnonymizedAt *time.Time `gorm:"column:anonymized_at"`
-	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	ID                  string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RefID               int64      `gorm:"column:ref_id;autoIncrement;uniqueIndex"`
+	Email               string     `gorm:"uniqueIndex;not null"`
+	Password            string     `gorm:"not null"`
+	Role                string     `gorm:"not null;default:'USER'"`
+	EmailVerifiedAt     *time.Time `gorm:"column:email_verified_at"`
+	FailedLoginAttempts int        `gorm:"column:failed_login_attempts;not null;default:0"`
+	LockedUntil         *time.Time `gorm:"column:locked_until"`
+	TotpSecret          *string    `gorm:"column:totp_secret"`
+	TotpEnabledAt       *time.Time `gorm:"column:totp_enabled_at"`
+	TotpLastStep        int64      `gorm:"column:totp_last_step;not null;default:0"`
+	TokenVersion        int64      `gorm:"column:token_version;not null;default:0"`
+	SuspendedUntil      *time.Time `gorm:"column:suspended_until"`
+	SuspensionReason    *string    `gorm:"column:suspension_reason"`
+	BannedAt            *time.Time `gorm:"column:banned_at"`
+	AnonymizedAt        *time.Time `gorm:"column:anonymized_at"`
+	CreatedAt           time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (AuthModel) TableName() string { return "auths" }
 
+// RefreshTokenModel represents a hashed, rotating refresh token
+type RefreshTokenModel struct {
+	ID         string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AuthRefID  int64      `gorm:"column:auth_ref_id;not null;index"`
+	FamilyID   string     `gorm:"column:family_id;type:uuid;not null;index"`
+	TokenHash  string     `gorm:"column:token_hash;uniqueIndex;not null"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+	ReplacedBy *string    `gorm:"column:replaced_by;type:uuid"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (RefreshTokenModel) TableName() string { return "refresh_tokens" }
+
+// SessionModel represents a login session on one device
+type SessionModel struct {
+	ID         string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AuthRefID  int64      `gorm:"column:auth_ref_id;not null;index"`
+	UserAgent  string     `gorm:"column:user_agent;type:text;not null;default:''"`
+	IPAddress  string     `gorm:"column:ip_address;not null;default:''"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+	LastSeenAt time.Time  `gorm:"column:last_seen_at;not null"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+}
+
+func (SessionModel) TableName() string { return "sessions" }
+
+// AuditLogModel is an append-only record of authentication and admin actions
+type AuditLogModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Action    string    `gorm:"column:action;not null;index"`
+	Success   bool      `gorm:"column:success;not null"`
+	ActorID   *string   `gorm:"column:actor_id;index"`
+	TargetID  *string   `gorm:"column:target_id;index"`
+	Email     *string   `gorm:"column:email;index"`
+	ErrorCode *string   `gorm:"column:error_code"`
+	IPAddress string    `gorm:"column:ip_address;not null;default:''"`
+	RequestID string    `gorm:"column:request_id;not null;default:''"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (AuditLogModel) TableName() string { return "audit_logs" }
+
+// RevokedTokenModel is the Postgres fallback for the access token revocation list
+type RevokedTokenModel struct {
+	TokenID   string    `gorm:"column:token_id;primaryKey"`
+	ExpiresAt time.Time `gorm:"column:expires_at;not null;index"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (RevokedTokenModel) TableName() string { return "revoked_tokens" }
+
+// OneTimeTokenModel stores hashed single-use tokens such as password reset links
+type OneTimeTokenModel struct {
+	ID        string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AuthRefID int64      `gorm:"column:auth_ref_id;not null;index"`
+	Purpose   string     `gorm:"column:purpose;not null"`
+	TokenHash string     `gorm:"column:token_hash;uniqueIndex;not null"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (OneTimeTokenModel) TableName() string { return "one_time_tokens" }
+
+// RecoveryCodeModel represents a hashed, single-use two-factor recovery code
+type RecoveryCodeModel struct {
+	ID        string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AuthRefID int64      `gorm:"column:auth_ref_id;not null;index"`
+	CodeHash  string     `gorm:"column:code_hash;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (RecoveryCodeModel) TableName() string { return "recovery_codes" }
+
+// PasswordHistoryModel represents the hash of a password an account used before
+type PasswordHistoryModel struct {
+	ID           string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AuthRefID    int64     `gorm:"column:auth_ref_id;not null;index"`
+	PasswordHash string    `gorm:"column:password_hash;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PasswordHistoryModel) TableName() string { return "password_history" }
+
+// OidcLoginStateModel represents an OpenID Connect login awaiting its callback
+type OidcLoginStateModel struct {
+	ID           string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StateHash    string    `gorm:"column:state_hash;uniqueIndex;not null"`
+	Provider     string    `gorm:"column:provider;not null"`
+	Nonce        string    `gorm:"column:nonce;not null"`
+	CodeVerifier string    `gorm:"column:code_verifier;not null"`
+	ExpiresAt    time.Time `gorm:"column:expires_at;not null;index"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (OidcLoginStateModel) TableName() string { return "oidc_login_states" }
+
+// ExternalIdentityModel links an identity provider subject to an auth record
+type ExternalIdentityModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Issuer    string    `gorm:"column:issuer;not null;uniqueIndex:idx_external_identity_subject"`
+	Subject   string    `gorm:"column:subject;not null;uniqueIndex:idx_external_identity_subject"`
+	AuthRefID int64     `gorm:"column:auth_ref_id;not null;index"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ExternalIdentityModel) TableName() string { return "external_identities" }
+
+// RoleModel represents a named permission set. Permissions are stored comma-separated.
+type RoleModel struct {
+	Name        string `gorm:"column:name;primaryKey"`
+	Description string `gorm:"column:description;not null;default:''"`
+	Permissions string `gorm:"column:permissions;not null"`
+	// SeededPermissions are the default permissions last granted by seeding, so that later
+	// defaults can be added without bringing back those an administrator removed
+	SeededPermissions string    `gorm:"column:seeded_permissions;not null;default:''"`
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (RoleModel) TableName() string { return "roles" }
+
+// ServiceAccountModel represents a non-human principal that authenticates with API keys
+type ServiceAccountModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name      string    `gorm:"column:name;not null"`
+	Role      string    `gorm:"column:role;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ServiceAccountModel) TableName() string { return "service_accounts" }
+
+// ApiKeyModel represents a hashed API key owned by a user or a service account
+type ApiKeyModel struct {
+	ID               string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name             string     `gorm:"column:name;not null"`
+	Prefix           string     `gorm:"column:prefix;not null"`
+	KeyHash          string     `gorm:"column:key_hash;uniqueIndex;not null"`
+	Scopes           string     `gorm:"column:scopes;not null"`
+	UserID           *string    `gorm:"column:user_id;type:uuid;index"`
+	ServiceAccountID *string    `gorm:"column:service_account_id;type:uuid;index"`
+	ExpiresAt        *time.Time `gorm:"column:expires_at"`
+	LastUsedAt       *time.Time `gorm:"column:last_used_at"`
+	RevokedAt        *time.Time `gorm:"column:revoked_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ApiKeyModel) TableName() string { return "api_keys" }
+
 // UserModel represents the user profile table
 type UserModel struct {
 	ID           string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
@@ -95,6 +263,8 @@ type CarModel struct {
 	LicensePlate string `gorm:"column:license_plate;uniqueIndex;not null"`
 	ModelRefID   int64  `gorm:"column:model_ref_id;not null"`
 	DriverRefID  int64  `gorm:"column:driver_ref_id;not null"`
+	// Litres per 100 km, when the driver gave it
+	FuelConsumption *float64 `gorm:"column:fuel_consumption"`
 }
 
 func (CarModel) TableName() string { return "cars" }
@@ -105,6 +275,10 @@ type CityModel struct {
 	RefID    int64  `gorm:"column:ref_id;autoIncrement;uniqueIndex"`
 	CityName string `gorm:"column:city_name;not null"`
 	Zipcode  string `gorm:"column:zipcode;not null;default:''"`
+	TimeZone string `gorm:"column:time_zone;not null;default:'Europe/Paris'"`
+	// Coordinates in decimal degrees, for searches by distance
+	Latitude  *float64 `gorm:"column:latitude"`
+	Longitude *float64 `gorm:"column:longitude"`
 }
 
 func (CityModel) TableName() string { return "cities" }
@@ -118,15 +292,65 @@ type TripModel struct {
 	Seats       int       `gorm:"not null"`
 	DriverRefID int64     `gorm:"column:driver_ref_id;not null"`
 	CarRefID    int64     `gorm:"column:car_ref_id;not null"`
+	Status      string    `gorm:"not null;default:'SCHEDULED';index"`
+	// Time zone of the departure city, in which the trip's times are shown
+	TimeZone         string     `gorm:"column:time_zone;not null;default:'Europe/Paris'"`
+	EstimatedArrival *time.Time `gorm:"column:estimated_arrival"`
+	// Driver's explanation, set once the trip is cancelled
+	CancellationReason *string `gorm:"column:cancellation_reason"`
+	// Occurrences of a series are unique per day, so the generator can run more than once
+	SeriesRefID      *int64  `gorm:"column:series_ref_id;uniqueIndex:idx_trips_series_occurrence"`
+	OccurrenceDate   *string `gorm:"column:occurrence_date;type:varchar(10);uniqueIndex:idx_trips_series_occurrence"`
+	SeriesOverridden bool    `gorm:"column:series_overridden;not null;default:false"`
+	// Price per seat in the currency's minor unit; trips offered before pricing are free
+	PricePerSeat int    `gorm:"column:price_per_seat;not null;default:0;index"`
+	Currency     string `gorm:"column:currency;type:varchar(3);not null;default:'EUR'"`
 }
 
 func (TripModel) TableName() string { return "trips" }
 
-// CityTripModel represents the many-to-many between cities and trips
+// TripSeriesModel represents a recurring trip. Weekdays and exceptions are comma-separated.
+type TripSeriesModel struct {
+	ID                 string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RefID              int64     `gorm:"column:ref_id;autoIncrement;uniqueIndex"`
+	DriverRefID        int64     `gorm:"column:driver_ref_id;not null;index"`
+	CarRefID           int64     `gorm:"column:car_ref_id;not null"`
+	DepartureCityRefID int64     `gorm:"column:departure_city_ref_id;not null"`
+	ArrivalCityRefID   int64     `gorm:"column:arrival_city_ref_id;not null"`
+	Kms                int       `gorm:"not null"`
+	Seats              int       `gorm:"not null"`
+	PricePerSeat       int       `gorm:"column:price_per_seat;not null;default:0"`
+	Currency           string    `gorm:"column:currency;type:varchar(3);not null;default:'EUR'"`
+	TimeZone           string    `gorm:"column:time_zone;not null"`
+	DepartureTime      string    `gorm:"column:departure_time;type:varchar(5);not null"`
+	Weekdays           string    `gorm:"column:weekdays;not null"`
+	Interval           int       `gorm:"column:interval_weeks;not null;default:1"`
+	StartDate          string    `gorm:"column:start_date;type:varchar(10);not null"`
+	EndDate            *string   `gorm:"column:end_date;type:varchar(10)"`
+	Exceptions         string    `gorm:"column:exceptions;type:text;not null;default:''"`
+	Status             string    `gorm:"not null;default:'ACTIVE';index"`
+	CancellationReason *string   `gorm:"column:cancellation_reason"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (TripSeriesModel) TableName() string { return "trip_series" }
+
+// TripSeriesSubscriptionModel is a passenger booked on every occurrence of a series
+type TripSeriesSubscriptionModel struct {
+	SeriesRefID int64     `gorm:"column:series_ref_id;primaryKey"`
+	UserRefID   int64     `gorm:"column:user_ref_id;primaryKey"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (TripSeriesSubscriptionModel) TableName() string { return "trip_series_subscriptions" }
+
+// CityTripModel represents a stop of a trip: its departure, arrival or a city along the way
 type CityTripModel struct {
-	TripRefID int64  `gorm:"column:trip_ref_id;primaryKey"`
-	CityRefID int64  `gorm:"column:city_ref_id;primaryKey"`
-	Type      string `gorm:"column:type;not null"`
+	TripRefID int64      `gorm:"column:trip_ref_id;primaryKey"`
+	CityRefID int64      `gorm:"column:city_ref_id;primaryKey"`
+	Type      string     `gorm:"column:type;not null"`
+	Position  int        `gorm:"column:position;not null;default:0"`
+	StopTime  *time.Time `gorm:"column:stop_time"`
 }
 
 func (CityTripModel) TableName() string { return "city_trips" }
@@ -139,10 +363,25 @@ type InscriptionModel struct {
 	UserRefID int64     `gorm:"column:user_ref_id;not null"`
 	TripRefID int64     `gorm:"column:trip_ref_id;not null"`
 	Status    string    `gorm:"not null;default:'ACTIVE'"`
+	// Positions of the trip stops the passenger gets on and off at
+	FromPosition int `gorm:"column:from_position;not null;default:0"`
+	ToPosition   int `gorm:"column:to_position;not null;default:0"`
 }
 
 func (InscriptionModel) TableName() string { return "inscriptions" }
 
+// TripChangeModel records a change a driver made to a trip
+type TripChangeModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TripRefID int64     `gorm:"column:trip_ref_id;not null;index"`
+	Field     string    `gorm:"column:field;not null"`
+	OldValue  string    `gorm:"column:old_value;not null"`
+	NewValue  string    `gorm:"column:new_value;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (TripChangeModel) TableName() string { return "trip_changes" }
+
 var db *gorm.DB
 
 // Connect establishes a connection to the PostgreSQL database
@@ -176,10 +415,26 @@ func GetDB() *gorm.DB {
 	return db
 }
 
-// AutoMigrate runs database migrations
+// AutoMigrate runs database migrations and seeds the default roles
 func AutoMigrate() error {
-	return db.AutoMigrate(
+	// Accounts created before email verification existed are taken as verified, once, when the
+	// column is added
+	verifyExistingAccounts := db.Migrator().HasTable(&AuthModel{}) && !db.Migrator().HasColumn(&AuthModel{}, "EmailVerifiedAt")
+
+	err := db.AutoMigrate(
 		&AuthModel{},
+		&RefreshTokenModel{},
+		&SessionModel{},
+		&AuditLogModel{},
+		&RevokedTokenModel{},
+		&OneTimeTokenModel{},
+		&RecoveryCodeModel{},
+		&PasswordHistoryModel{},
+		&OidcLoginStateModel{},
+		&ExternalIdentityModel{},
+		&RoleModel{},
+		&ServiceAccountModel{},
+		&ApiKeyModel{},
 		&UserModel{},
 		&DriverModel{},
 		&BrandModel{},
@@ -191,5 +446,103 @@ func AutoMigrate() error {
 		&TripModel{},
 		&CityTripModel{},
 		&InscriptionModel{},
+		&TripChangeModel{},
+		&TripSeriesModel{},
+		&TripSeriesSubscriptionModel{},
 	)
+	if err != nil {
+		return err
+	}
+	if verifyExistingAccounts {
+		if err := backfillEmailVerification(); err != nil {
+			return err
+		}
+	}
+	if err := backfillTripStops(); err != nil {
+		return err
+	}
+	if err := backfillTripArrivals(); err != nil {
+		return err
+	}
+	return seedRoles()
+}
+
+// backfillEmailVerification marks the existing accounts verified as of their creation, so that they
+// can keep booking trips and becoming drivers
+func backfillEmailVerification() error {
+	return db.Exec("UPDATE auths SET email_verified_at = created_at WHERE email_verified_at IS NULL").Error
+}
+
+// backfillTripStops numbers the stops of trips created before multi-stop trips, which only had a
+// departure and an arrival, and books their passengers for the whole route
+func backfillTripStops() error {
+	if err := db.Exec("UPDATE city_trips SET position = 1 WHERE type = 'ARRIVAL' AND position = 0").Error; err != nil {
+		return err
+	}
+	return db.Exec(`UPDATE inscriptions SET to_position = COALESCE(
+		(SELECT MAX(ct.position) FROM city_trips ct WHERE ct.trip_ref_id = inscriptions.trip_ref_id), 1)
+		WHERE to_position = 0`).Error
+}
+
+// backfillTripArrivals estimates the arrival of trips created before arrival times were kept, at
+// the average speed used for new trips
+func backfillTripArrivals() error {
+	return db.Exec("UPDATE trips SET estimated_arrival = date_trip + kms * (INTERVAL '1 hour' / ?) WHERE estimated_arrival IS NULL",
+		entities.AverageSpeedKmh).Error
+}
+
+// seedRoles creates the default roles that do not exist yet and grants existing ones the default
+// permissions added since they were last seeded. Other edits to the roles are kept.
+func seedRoles() error {
+	names := make([]string, 0, len(authorization.DefaultRoles))
+	for name := range authorization.DefaultRoles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	roles := make([]RoleModel, 0, len(names))
+	for _, name := range names {
+		permissions := strings.Join(authorization.DefaultRoles[name], ",")
+		roles = append(roles, RoleModel{Name: name, Permissions: permissions, SeededPermissions: permissions})
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&roles).Error; err != nil {
+		return err
+	}
+
+	var existing []RoleModel
+	if err := db.Where("name IN ?", names).Find(&existing).Error; err != nil {
+		return err
+	}
+	for _, role := range existing {
+		defaults := authorization.DefaultRoles[role.Name]
+		permissions := mergeSeededPermissions(splitPermissions(role.Permissions), splitPermissions(role.SeededPermissions), defaults)
+		seeded := strings.Join(defaults, ",")
+		if permissions == role.Permissions && seeded == role.SeededPermissions {
+			continue
+		}
+		if err := db.Model(&RoleModel{}).Where("name = ?", role.Name).
+			Updates(map[string]interface{}{"permissions": permissions, "seeded_permissions": seeded}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeSeededPermissions adds to a role the defaults that were not part of its last seeding.
+// Roles seeded before that was recorded get every default they lack.
+func mergeSeededPermissions(current, seeded, defaults []string) string {
+	merged := append([]string{}, current...)
+	for _, permission := range defaults {
+		if !slices.Contains(seeded, permission) && !slices.Contains(merged, permission) {
+			merged = append(merged, permission)
+		}
+	}
+	return strings.Join(merged, ",")
+}
+
+func splitPermissions(permissions string) []string {
+	if permissions == "" {
+		return nil
+	}
+	return strings.Split(permissions, ",")
 }
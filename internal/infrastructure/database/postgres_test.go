@@ -0,0 +1,35 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSeededPermissions_AddsNewDefaults(t *testing.T) {
+	merged := mergeSeededPermissions(
+		[]string{"profile:read", "users:read"},
+		[]string{"profile:read", "users:read"},
+		[]string{"profile:read", "users:read", "audit:read"},
+	)
+	assert.Equal(t, "profile:read,users:read,audit:read", merged)
+}
+
+func TestMergeSeededPermissions_KeepsRemovedDefaultsOut(t *testing.T) {
+	// users:read was seeded and later removed by an administrator
+	merged := mergeSeededPermissions(
+		[]string{"profile:read", "support:tickets"},
+		[]string{"profile:read", "users:read"},
+		[]string{"profile:read", "users:read"},
+	)
+	assert.Equal(t, "profile:read,support:tickets", merged)
+}
+
+func TestMergeSeededPermissions_RoleSeededBeforeTracking(t *testing.T) {
+	merged := mergeSeededPermissions(
+		[]string{"profile:read"},
+		nil,
+		[]string{"profile:read", "users:read"},
+	)
+	assert.Equal(t, "profile:read,users:read", merged)
+}
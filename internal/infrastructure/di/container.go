@@ -1,6 +1,12 @@
 package di
 
 import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/config"
+	"github.com/lgxju/gogretago/internal/application/usecases/apikey"
+	"github.com/lgxju/gogretago/internal/application/usecases/audit"
 	"github.com/lgxju/gogretago/internal/application/usecases/auth"
 	"github.com/lgxju/gogretago/internal/application/usecases/brand"
 	"github.com/lgxju/gogretago/internal/application/usecases/car"
@@ -9,10 +15,14 @@ import (
 	"github.com/lgxju/gogretago/internal/application/usecases/driver"
 	"github.com/lgxju/gogretago/internal/application/usecases/inscription"
 	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/application/usecases/tripseries"
 	"github.com/lgxju/gogretago/internal/application/usecases/user"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/infrastructure/cache"
 	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"github.com/lgxju/gogretago/internal/infrastructure/jobs"
 	infrarepos "github.com/lgxju/gogretago/internal/infrastructure/repositories"
 	infraservices "github.com/lgxju/gogretago/internal/infrastructure/services"
 	"gorm.io/gorm"
@@ -24,31 +34,78 @@ type Container struct {
 	DB *gorm.DB
 
 	// Repositories
-	AuthRepository        repositories.AuthRepository
-	UserRepository        repositories.UserRepository
-	DriverRepository      repositories.DriverRepository
-	BrandRepository       repositories.BrandRepository
-	ModelRepository       repositories.ModelRepository
-	ColorRepository       repositories.ColorRepository
-	CarRepository         repositories.CarRepository
-	CityRepository        repositories.CityRepository
-	TripRepository        repositories.TripRepository
-	InscriptionRepository repositories.InscriptionRepository
+	AuthRepository             repositories.AuthRepository
+	RefreshTokenRepository     repositories.RefreshTokenRepository
+	SessionRepository          repositories.SessionRepository
+	RevokedTokenRepository     repositories.RevokedTokenRepository
+	TokenVersionRepository     repositories.TokenVersionRepository
+	RoleRepository             repositories.RoleRepository
+	OneTimeTokenRepository     repositories.OneTimeTokenRepository
+	RecoveryCodeRepository     repositories.RecoveryCodeRepository
+	PasswordHistoryRepository  repositories.PasswordHistoryRepository
+	OidcLoginStateRepository   repositories.OidcLoginStateRepository
+	ExternalIdentityRepository repositories.ExternalIdentityRepository
+	ApiKeyRepository           repositories.ApiKeyRepository
+	ServiceAccountRepository   repositories.ServiceAccountRepository
+	UserRepository             repositories.UserRepository
+	DriverRepository           repositories.DriverRepository
+	BrandRepository            repositories.BrandRepository
+	ModelRepository            repositories.ModelRepository
+	ColorRepository            repositories.ColorRepository
+	CarRepository              repositories.CarRepository
+	CityRepository             repositories.CityRepository
+	TripRepository             repositories.TripRepository
+	TripSeriesRepository       repositories.TripSeriesRepository
+	InscriptionRepository      repositories.InscriptionRepository
+	AuditLogRepository         repositories.AuditLogRepository
 
 	// Services
 	PasswordService services.PasswordService
 	JwtService      services.JwtService
 	EmailService    services.EmailService
+	TokenService    services.TokenService
+	TotpService     services.TotpService
+	OidcService     services.OidcService
 
 	// Auth Use Cases
-	RegisterUseCase *auth.RegisterUseCase
-	LoginUseCase    *auth.LoginUseCase
+	RegisterUseCase           *auth.RegisterUseCase
+	LoginUseCase              *auth.LoginUseCase
+	RefreshTokenUseCase       *auth.RefreshTokenUseCase
+	LogoutUseCase             *auth.LogoutUseCase
+	ForgotPasswordUseCase     *auth.ForgotPasswordUseCase
+	ResetPasswordUseCase      *auth.ResetPasswordUseCase
+	VerifyEmailUseCase        *auth.VerifyEmailUseCase
+	ResendVerificationUseCase *auth.ResendVerificationUseCase
+	TwoFactorLoginUseCase     *auth.TwoFactorLoginUseCase
+	RequestMagicLinkUseCase   *auth.RequestMagicLinkUseCase
+	MagicLinkLoginUseCase     *auth.MagicLinkLoginUseCase
+	EnrollTotpUseCase         *auth.EnrollTotpUseCase
+	ConfirmTotpUseCase        *auth.ConfirmTotpUseCase
+	GetJwksUseCase            *auth.GetJwksUseCase
+	ChangePasswordUseCase     *auth.ChangePasswordUseCase
+	StartOidcLoginUseCase     *auth.StartOidcLoginUseCase
+	OidcCallbackUseCase       *auth.OidcCallbackUseCase
+	ListSessionsUseCase       *auth.ListSessionsUseCase
+	RevokeSessionUseCase      *auth.RevokeSessionUseCase
+	RevokeAllSessionsUseCase  *auth.RevokeAllSessionsUseCase
+
+	// API Key Use Cases
+	CreateApiKeyUseCase         *apikey.CreateApiKeyUseCase
+	ListApiKeysUseCase          *apikey.ListApiKeysUseCase
+	RevokeApiKeyUseCase         *apikey.RevokeApiKeyUseCase
+	CreateServiceAccountUseCase *apikey.CreateServiceAccountUseCase
+	ListServiceAccountsUseCase  *apikey.ListServiceAccountsUseCase
 
 	// User Use Cases
-	ListUsersUseCase     *user.ListUsersUseCase
-	GetUserUseCase       *user.GetUserUseCase
-	UpdateUserUseCase    *user.UpdateUserUseCase
-	AnonymizeUserUseCase *user.AnonymizeUserUseCase
+	ListUsersUseCase      *user.ListUsersUseCase
+	GetUserUseCase        *user.GetUserUseCase
+	UpdateUserUseCase     *user.UpdateUserUseCase
+	AnonymizeUserUseCase  *user.AnonymizeUserUseCase
+	UnlockUserUseCase     *user.UnlockUserUseCase
+	UpdateUserRoleUseCase *user.UpdateUserRoleUseCase
+	SuspendUserUseCase    *user.SuspendUserUseCase
+	BanUserUseCase        *user.BanUserUseCase
+	ReinstateUserUseCase  *user.ReinstateUserUseCase
 
 	// Driver Use Cases
 	CreateDriverUseCase *driver.CreateDriverUseCase
@@ -67,6 +124,7 @@ type Container struct {
 	// City Use Cases
 	ListCitiesUseCase *city.ListCitiesUseCase
 	CreateCityUseCase *city.CreateCityUseCase
+	UpdateCityUseCase *city.UpdateCityUseCase
 	DeleteCityUseCase *city.DeleteCityUseCase
 
 	// Car Use Cases
@@ -76,11 +134,25 @@ type Container struct {
 	DeleteCarUseCase *car.DeleteCarUseCase
 
 	// Trip Use Cases
-	ListTripsUseCase  *trip.ListTripsUseCase
-	GetTripUseCase    *trip.GetTripUseCase
-	FindTripsUseCase  *trip.FindTripsUseCase
-	CreateTripUseCase *trip.CreateTripUseCase
-	DeleteTripUseCase *trip.DeleteTripUseCase
+	ListTripsUseCase        *trip.ListTripsUseCase
+	GetTripUseCase          *trip.GetTripUseCase
+	FindTripsUseCase        *trip.FindTripsUseCase
+	CreateTripUseCase       *trip.CreateTripUseCase
+	DeleteTripUseCase       *trip.DeleteTripUseCase
+	ChangeTripStatusUseCase *trip.ChangeTripStatusUseCase
+	CancelTripUseCase       *trip.CancelTripUseCase
+	QuoteTripPriceUseCase   *trip.QuoteTripPriceUseCase
+	UpdateTripUseCase       *trip.UpdateTripUseCase
+	ListTripChangesUseCase  *trip.ListTripChangesUseCase
+
+	// Trip Series Use Cases
+	CreateTripSeriesUseCase      *tripseries.CreateTripSeriesUseCase
+	GetTripSeriesUseCase         *tripseries.GetTripSeriesUseCase
+	UpdateTripSeriesUseCase      *tripseries.UpdateTripSeriesUseCase
+	CancelTripSeriesUseCase      *tripseries.CancelTripSeriesUseCase
+	UpdateTripOccurrenceUseCase  *tripseries.UpdateTripOccurrenceUseCase
+	SubscribeTripSeriesUseCase   *tripseries.SubscribeTripSeriesUseCase
+	UnsubscribeTripSeriesUseCase *tripseries.UnsubscribeTripSeriesUseCase
 
 	// Inscription Use Cases
 	ListInscriptionsUseCase     *inscription.ListInscriptionsUseCase
@@ -88,6 +160,13 @@ type Container struct {
 	DeleteInscriptionUseCase    *inscription.DeleteInscriptionUseCase
 	ListUserInscriptionsUseCase *inscription.ListUserInscriptionsUseCase
 	ListTripPassengersUseCase   *inscription.ListTripPassengersUseCase
+	ConfirmInscriptionUseCase   *inscription.ConfirmInscriptionUseCase
+
+	// Audit Use Cases
+	ListAuditLogsUseCase *audit.ListAuditLogsUseCase
+
+	// Background jobs
+	TripSeriesJob *jobs.TripSeriesJob
 }
 
 // NewContainer creates and wires all dependencies
@@ -103,8 +182,43 @@ func NewContainer() (*Container, error) {
 		return nil, err
 	}
 
+	// Cache (no-op when CACHE_ENABLED=false)
+	cacheService := cache.NewCacheService()
+
+	cfg := config.Get()
+
+	// TOTP secrets are encrypted at rest; encrypt those stored before that
+	secretCipher, err := infraservices.NewAesSecretCipher(cfg.TotpEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := infrarepos.EncryptTotpSecrets(context.Background(), db, secretCipher); err != nil {
+		return nil, err
+	}
+
 	// Create repositories
-	authRepository := infrarepos.NewGormAuthRepository(db)
+	authRepository := infrarepos.NewGormAuthRepository(db, secretCipher)
+	refreshTokenRepository := infrarepos.NewGormRefreshTokenRepository(db)
+	sessionRepository := infrarepos.NewGormSessionRepository(db)
+	revokedTokenRepository := infrarepos.NewGormRevokedTokenRepository(db)
+	if cacheService.Enabled() {
+		revokedTokenRepository = infrarepos.NewCacheRevokedTokenRepository(cacheService)
+	}
+	tokenVersionRepository := infrarepos.NewGormTokenVersionRepository(db)
+	if cacheService.Enabled() {
+		tokenVersionRepository = infrarepos.NewCacheTokenVersionRepository(cacheService, tokenVersionRepository)
+	}
+	roleRepository := infrarepos.NewGormRoleRepository(db)
+	if cacheService.Enabled() {
+		roleRepository = infrarepos.NewCacheRoleRepository(cacheService, roleRepository)
+	}
+	oneTimeTokenRepository := infrarepos.NewGormOneTimeTokenRepository(db)
+	recoveryCodeRepository := infrarepos.NewGormRecoveryCodeRepository(db)
+	passwordHistoryRepository := infrarepos.NewGormPasswordHistoryRepository(db)
+	oidcLoginStateRepository := infrarepos.NewGormOidcLoginStateRepository(db)
+	externalIdentityRepository := infrarepos.NewGormExternalIdentityRepository(db)
+	apiKeyRepository := infrarepos.NewGormApiKeyRepository(db)
+	serviceAccountRepository := infrarepos.NewGormServiceAccountRepository(db)
 	userRepository := infrarepos.NewGormUserRepository(db)
 	driverRepository := infrarepos.NewGormDriverRepository(db)
 	brandRepository := infrarepos.NewGormBrandRepository(db)
@@ -113,25 +227,75 @@ func NewContainer() (*Container, error) {
 	carRepository := infrarepos.NewGormCarRepository(db)
 	cityRepository := infrarepos.NewGormCityRepository(db)
 	tripRepository := infrarepos.NewGormTripRepository(db)
+	tripSeriesRepository := infrarepos.NewGormTripSeriesRepository(db)
 	inscriptionRepository := infrarepos.NewGormInscriptionRepository(db)
+	auditLogRepository := infrarepos.NewGormAuditLogRepository(db)
 
 	// Create services
-	passwordService := infraservices.NewArgonPasswordService()
-	jwtService := infraservices.NewJwtService()
+	passwordService := infraservices.NewArgonPasswordService(infraservices.ArgonParams{
+		Time:      uint32(max(cfg.Argon2Time, 0)),
+		MemoryKiB: uint32(max(cfg.Argon2MemoryKiB, 0)),
+		Threads:   uint8(min(max(cfg.Argon2Parallelism, 0), 255)),
+	})
+	jwtService, err := infraservices.NewJwtService()
+	if err != nil {
+		return nil, err
+	}
 	emailService := infraservices.NewResendEmailService()
+	tokenService := infraservices.NewOpaqueTokenService()
+	totpService := infraservices.NewTotpService()
+	oidcService := infraservices.NewOidcService()
 
 	// Auth use cases
-	registerUseCase := auth.NewRegisterUseCase(authRepository, passwordService, emailService, jwtService)
-	loginUseCase := auth.NewLoginUseCase(authRepository, userRepository, passwordService, jwtService)
+	registerUseCase := auth.NewRegisterUseCase(authRepository, refreshTokenRepository, sessionRepository, oneTimeTokenRepository, passwordService, emailService, jwtService, tokenService)
+	lockoutPolicy := entities.NewLoginLockoutPolicy(cfg.LoginMaxAttempts, time.Duration(cfg.LoginLockoutMinutes)*time.Minute)
+	pricing := entities.PricingRules{
+		Currency:          cfg.PricingCurrency,
+		CostPerKm:         cfg.PricingCostPerKm,
+		FuelPricePerLitre: cfg.PricingFuelPricePerLitre,
+		WearPerKm:         cfg.PricingWearPerKm,
+	}
+	twoFactorPolicy := entities.TwoFactorPolicy{RequiredRoles: cfg.TwoFactorRequiredRoles}
+	loginUseCase := auth.NewLoginUseCase(authRepository, userRepository, refreshTokenRepository, sessionRepository, oneTimeTokenRepository, passwordService, jwtService, tokenService, totpService, lockoutPolicy, twoFactorPolicy)
+	refreshTokenUseCase := auth.NewRefreshTokenUseCase(authRepository, userRepository, refreshTokenRepository, sessionRepository, jwtService, tokenService, twoFactorPolicy)
+	logoutUseCase := auth.NewLogoutUseCase(userRepository, refreshTokenRepository, sessionRepository, revokedTokenRepository, tokenService)
+	forgotPasswordUseCase := auth.NewForgotPasswordUseCase(authRepository, oneTimeTokenRepository, emailService, tokenService)
+	resetPasswordUseCase := auth.NewResetPasswordUseCase(authRepository, userRepository, oneTimeTokenRepository, refreshTokenRepository, sessionRepository, revokedTokenRepository, tokenVersionRepository, passwordService, tokenService, jwtService)
+	verifyEmailUseCase := auth.NewVerifyEmailUseCase(authRepository, userRepository, oneTimeTokenRepository, emailService, tokenService)
+	resendVerificationUseCase := auth.NewResendVerificationUseCase(authRepository, userRepository, oneTimeTokenRepository, emailService, tokenService)
+	twoFactorLoginUseCase := auth.NewTwoFactorLoginUseCase(authRepository, userRepository, refreshTokenRepository, sessionRepository, oneTimeTokenRepository, recoveryCodeRepository, jwtService, tokenService, totpService, lockoutPolicy)
+	requestMagicLinkUseCase := auth.NewRequestMagicLinkUseCase(authRepository, oneTimeTokenRepository, emailService, tokenService)
+	magicLinkLoginUseCase := auth.NewMagicLinkLoginUseCase(authRepository, userRepository, refreshTokenRepository, sessionRepository, oneTimeTokenRepository, jwtService, tokenService, totpService, twoFactorPolicy)
+	enrollTotpUseCase := auth.NewEnrollTotpUseCase(authRepository, userRepository, totpService)
+	confirmTotpUseCase := auth.NewConfirmTotpUseCase(authRepository, userRepository, recoveryCodeRepository, totpService, tokenService)
+	getJwksUseCase := auth.NewGetJwksUseCase(jwtService)
+	changePasswordUseCase := auth.NewChangePasswordUseCase(authRepository, userRepository, refreshTokenRepository, sessionRepository, passwordHistoryRepository, tokenVersionRepository, passwordService, jwtService, tokenService, cfg.PasswordHistorySize)
+	startOidcLoginUseCase := auth.NewStartOidcLoginUseCase(oidcService, oidcLoginStateRepository, tokenService)
+	oidcCallbackUseCase := auth.NewOidcCallbackUseCase(oidcService, oidcLoginStateRepository, externalIdentityRepository, authRepository, userRepository, refreshTokenRepository, sessionRepository, oneTimeTokenRepository, passwordService, jwtService, tokenService, totpService, twoFactorPolicy)
+	listSessionsUseCase := auth.NewListSessionsUseCase(userRepository, sessionRepository)
+	revokeSessionUseCase := auth.NewRevokeSessionUseCase(userRepository, sessionRepository, refreshTokenRepository, revokedTokenRepository, jwtService)
+	revokeAllSessionsUseCase := auth.NewRevokeAllSessionsUseCase(userRepository, sessionRepository, refreshTokenRepository, revokedTokenRepository, jwtService)
+
+	// API key use cases
+	createApiKeyUseCase := apikey.NewCreateApiKeyUseCase(apiKeyRepository, serviceAccountRepository, tokenService)
+	listApiKeysUseCase := apikey.NewListApiKeysUseCase(apiKeyRepository, serviceAccountRepository)
+	revokeApiKeyUseCase := apikey.NewRevokeApiKeyUseCase(apiKeyRepository)
+	createServiceAccountUseCase := apikey.NewCreateServiceAccountUseCase(serviceAccountRepository, roleRepository)
+	listServiceAccountsUseCase := apikey.NewListServiceAccountsUseCase(serviceAccountRepository)
 
 	// User use cases
 	listUsersUseCase := user.NewListUsersUseCase(userRepository)
 	getUserUseCase := user.NewGetUserUseCase(userRepository)
 	updateUserUseCase := user.NewUpdateUserUseCase(userRepository)
 	anonymizeUserUseCase := user.NewAnonymizeUserUseCase(userRepository)
+	unlockUserUseCase := user.NewUnlockUserUseCase(userRepository, authRepository)
+	updateUserRoleUseCase := user.NewUpdateUserRoleUseCase(userRepository, authRepository, roleRepository, tokenVersionRepository)
+	suspendUserUseCase := user.NewSuspendUserUseCase(userRepository, authRepository, tokenVersionRepository)
+	banUserUseCase := user.NewBanUserUseCase(userRepository, authRepository, tokenVersionRepository)
+	reinstateUserUseCase := user.NewReinstateUserUseCase(userRepository, authRepository, tokenVersionRepository)
 
 	// Driver use cases
-	createDriverUseCase := driver.NewCreateDriverUseCase(driverRepository, userRepository, authRepository)
+	createDriverUseCase := driver.NewCreateDriverUseCase(driverRepository, userRepository, authRepository, tokenVersionRepository, jwtService)
 
 	// Brand use cases
 	listBrandsUseCase := brand.NewListBrandsUseCase(brandRepository)
@@ -147,6 +311,7 @@ func NewContainer() (*Container, error) {
 	// City use cases
 	listCitiesUseCase := city.NewListCitiesUseCase(cityRepository)
 	createCityUseCase := city.NewCreateCityUseCase(cityRepository)
+	updateCityUseCase := city.NewUpdateCityUseCase(cityRepository)
 	deleteCityUseCase := city.NewDeleteCityUseCase(cityRepository)
 
 	// Car use cases
@@ -158,46 +323,112 @@ func NewContainer() (*Container, error) {
 	// Trip use cases
 	listTripsUseCase := trip.NewListTripsUseCase(tripRepository)
 	getTripUseCase := trip.NewGetTripUseCase(tripRepository)
-	findTripsUseCase := trip.NewFindTripsUseCase(tripRepository)
-	createTripUseCase := trip.NewCreateTripUseCase(tripRepository, driverRepository, carRepository, cityRepository)
-	deleteTripUseCase := trip.NewDeleteTripUseCase(tripRepository, driverRepository)
+	findTripsUseCase := trip.NewFindTripsUseCase(tripRepository, cityRepository)
+	createTripUseCase := trip.NewCreateTripUseCase(tripRepository, driverRepository, carRepository, cityRepository, pricing)
+	quoteTripPriceUseCase := trip.NewQuoteTripPriceUseCase(carRepository, pricing)
+	deleteTripUseCase := trip.NewDeleteTripUseCase(tripRepository, inscriptionRepository)
+	changeTripStatusUseCase := trip.NewChangeTripStatusUseCase(tripRepository, driverRepository)
+	cancelTripUseCase := trip.NewCancelTripUseCase(tripRepository, driverRepository, inscriptionRepository, userRepository, emailService)
+	updateTripUseCase := trip.NewUpdateTripUseCase(tripRepository, driverRepository, carRepository, cityRepository, inscriptionRepository, userRepository, emailService, pricing)
+	listTripChangesUseCase := trip.NewListTripChangesUseCase(tripRepository, driverRepository, userRepository, inscriptionRepository)
+
+	// Trip series use cases
+	generateTripOccurrencesUseCase := tripseries.NewGenerateTripOccurrencesUseCase(tripSeriesRepository, tripRepository, inscriptionRepository, time.Duration(cfg.TripSeriesHorizonDays)*24*time.Hour)
+	createTripSeriesUseCase := tripseries.NewCreateTripSeriesUseCase(tripSeriesRepository, driverRepository, carRepository, cityRepository, pricing, generateTripOccurrencesUseCase)
+	getTripSeriesUseCase := tripseries.NewGetTripSeriesUseCase(tripSeriesRepository)
+	updateTripSeriesUseCase := tripseries.NewUpdateTripSeriesUseCase(tripSeriesRepository, driverRepository, tripRepository, inscriptionRepository, userRepository, emailService, updateTripUseCase, generateTripOccurrencesUseCase)
+	cancelTripSeriesUseCase := tripseries.NewCancelTripSeriesUseCase(tripSeriesRepository, driverRepository, tripRepository, inscriptionRepository, userRepository, emailService)
+	updateTripOccurrenceUseCase := tripseries.NewUpdateTripOccurrenceUseCase(tripSeriesRepository, driverRepository, tripRepository, updateTripUseCase)
+	subscribeTripSeriesUseCase := tripseries.NewSubscribeTripSeriesUseCase(tripSeriesRepository, userRepository, tripRepository, inscriptionRepository)
+	unsubscribeTripSeriesUseCase := tripseries.NewUnsubscribeTripSeriesUseCase(tripSeriesRepository, userRepository, tripRepository, inscriptionRepository)
 
 	// Inscription use cases
 	listInscriptionsUseCase := inscription.NewListInscriptionsUseCase(inscriptionRepository)
 	createInscriptionUseCase := inscription.NewCreateInscriptionUseCase(inscriptionRepository, userRepository, tripRepository)
-	deleteInscriptionUseCase := inscription.NewDeleteInscriptionUseCase(inscriptionRepository)
+	deleteInscriptionUseCase := inscription.NewDeleteInscriptionUseCase(inscriptionRepository, tripRepository)
 	listUserInscriptionsUseCase := inscription.NewListUserInscriptionsUseCase(inscriptionRepository)
 	listTripPassengersUseCase := inscription.NewListTripPassengersUseCase(inscriptionRepository)
+	confirmInscriptionUseCase := inscription.NewConfirmInscriptionUseCase(inscriptionRepository)
+
+	// Audit use cases
+	listAuditLogsUseCase := audit.NewListAuditLogsUseCase(auditLogRepository)
 
 	return &Container{
 		DB: db,
 
 		// Repositories
-		AuthRepository:        authRepository,
-		UserRepository:        userRepository,
-		DriverRepository:      driverRepository,
-		BrandRepository:       brandRepository,
-		ModelRepository:       modelRepository,
-		ColorRepository:       colorRepository,
-		CarRepository:         carRepository,
-		CityRepository:        cityRepository,
-		TripRepository:        tripRepository,
-		InscriptionRepository: inscriptionRepository,
+		AuthRepository:             authRepository,
+		RefreshTokenRepository:     refreshTokenRepository,
+		SessionRepository:          sessionRepository,
+		RevokedTokenRepository:     revokedTokenRepository,
+		TokenVersionRepository:     tokenVersionRepository,
+		RoleRepository:             roleRepository,
+		OneTimeTokenRepository:     oneTimeTokenRepository,
+		RecoveryCodeRepository:     recoveryCodeRepository,
+		PasswordHistoryRepository:  passwordHistoryRepository,
+		OidcLoginStateRepository:   oidcLoginStateRepository,
+		ExternalIdentityRepository: externalIdentityRepository,
+		ApiKeyRepository:           apiKeyRepository,
+		ServiceAccountRepository:   serviceAccountRepository,
+		UserRepository:             userRepository,
+		DriverRepository:           driverRepository,
+		BrandRepository:            brandRepository,
+		ModelRepository:            modelRepository,
+		ColorRepository:            colorRepository,
+		CarRepository:              carRepository,
+		CityRepository:             cityRepository,
+		TripRepository:             tripRepository,
+		TripSeriesRepository:       tripSeriesRepository,
+		InscriptionRepository:      inscriptionRepository,
+		AuditLogRepository:         auditLogRepository,
 
 		// Services
 		PasswordService: passwordService,
 		JwtService:      jwtService,
 		EmailService:    emailService,
+		TokenService:    tokenService,
+		TotpService:     totpService,
+		OidcService:     oidcService,
 
 		// Auth
-		RegisterUseCase: registerUseCase,
-		LoginUseCase:    loginUseCase,
+		RegisterUseCase:           registerUseCase,
+		LoginUseCase:              loginUseCase,
+		RefreshTokenUseCase:       refreshTokenUseCase,
+		LogoutUseCase:             logoutUseCase,
+		ForgotPasswordUseCase:     forgotPasswordUseCase,
+		ResetPasswordUseCase:      resetPasswordUseCase,
+		VerifyEmailUseCase:        verifyEmailUseCase,
+		ResendVerificationUseCase: resendVerificationUseCase,
+		TwoFactorLoginUseCase:     twoFactorLoginUseCase,
+		RequestMagicLinkUseCase:   requestMagicLinkUseCase,
+		MagicLinkLoginUseCase:     magicLinkLoginUseCase,
+		EnrollTotpUseCase:         enrollTotpUseCase,
+		ConfirmTotpUseCase:        confirmTotpUseCase,
+		GetJwksUseCase:            getJwksUseCase,
+		ChangePasswordUseCase:     changePasswordUseCase,
+		StartOidcLoginUseCase:     startOidcLoginUseCase,
+		OidcCallbackUseCase:       oidcCallbackUseCase,
+		ListSessionsUseCase:       listSessionsUseCase,
+		RevokeSessionUseCase:      revokeSessionUseCase,
+		RevokeAllSessionsUseCase:  revokeAllSessionsUseCase,
+
+		// API keys
+		CreateApiKeyUseCase:         createApiKeyUseCase,
+		ListApiKeysUseCase:          listApiKeysUseCase,
+		RevokeApiKeyUseCase:         revokeApiKeyUseCase,
+		CreateServiceAccountUseCase: createServiceAccountUseCase,
+		ListServiceAccountsUseCase:  listServiceAccountsUseCase,
 
 		// User
-		ListUsersUseCase:     listUsersUseCase,
-		GetUserUseCase:       getUserUseCase,
-		UpdateUserUseCase:    updateUserUseCase,
-		AnonymizeUserUseCase: anonymizeUserUseCase,
+		ListUsersUseCase:      listUsersUseCase,
+		GetUserUseCase:        getUserUseCase,
+		UpdateUserUseCase:     updateUserUseCase,
+		AnonymizeUserUseCase:  anonymizeUserUseCase,
+		UnlockUserUseCase:     unlockUserUseCase,
+		UpdateUserRoleUseCase: updateUserRoleUseCase,
+		SuspendUserUseCase:    suspendUserUseCase,
+		BanUserUseCase:        banUserUseCase,
+		ReinstateUserUseCase:  reinstateUserUseCase,
 
 		// Driver
 		CreateDriverUseCase: createDriverUseCase,
@@ -216,6 +447,7 @@ func NewContainer() (*Container, error) {
 		// City
 		ListCitiesUseCase: listCitiesUseCase,
 		CreateCityUseCase: createCityUseCase,
+		UpdateCityUseCase: updateCityUseCase,
 		DeleteCityUseCase: deleteCityUseCase,
 
 		// Car
@@ -225,11 +457,25 @@ func NewContainer() (*Container, error) {
 		DeleteCarUseCase: deleteCarUseCase,
 
 		// Trip
-		ListTripsUseCase:  listTripsUseCase,
-		GetTripUseCase:    getTripUseCase,
-		FindTripsUseCase:  findTripsUseCase,
-		CreateTripUseCase: createTripUseCase,
-		DeleteTripUseCase: deleteTripUseCase,
+		ListTripsUseCase:        listTripsUseCase,
+		GetTripUseCase:          getTripUseCase,
+		FindTripsUseCase:        findTripsUseCase,
+		CreateTripUseCase:       createTripUseCase,
+		DeleteTripUseCase:       deleteTripUseCase,
+		ChangeTripStatusUseCase: changeTripStatusUseCase,
+		CancelTripUseCase:       cancelTripUseCase,
+		QuoteTripPriceUseCase:   quoteTripPriceUseCase,
+		UpdateTripUseCase:       updateTripUseCase,
+		ListTripChangesUseCase:  listTripChangesUseCase,
+
+		// Trip series
+		CreateTripSeriesUseCase:      createTripSeriesUseCase,
+		GetTripSeriesUseCase:         getTripSeriesUseCase,
+		UpdateTripSeriesUseCase:      updateTripSeriesUseCase,
+		CancelTripSeriesUseCase:      cancelTripSeriesUseCase,
+		UpdateTripOccurrenceUseCase:  updateTripOccurrenceUseCase,
+		SubscribeTripSeriesUseCase:   subscribeTripSeriesUseCase,
+		UnsubscribeTripSeriesUseCase: unsubscribeTripSeriesUseCase,
 
 		// Inscription
 		ListInscriptionsUseCase:     listInscriptionsUseCase,
@@ -237,5 +483,12 @@ func NewContainer() (*Container, error) {
 		DeleteInscriptionUseCase:    deleteInscriptionUseCase,
 		ListUserInscriptionsUseCase: listUserInscriptionsUseCase,
 		ListTripPassengersUseCase:   listTripPassengersUseCase,
+		ConfirmInscriptionUseCase:   confirmInscriptionUseCase,
+
+		// Audit Use Cases
+		ListAuditLogsUseCase: listAuditLogsUseCase,
+
+		// Background jobs
+		TripSeriesJob: jobs.NewTripSeriesJob(generateTripOccurrencesUseCase, cfg.TripSeriesJobInterval),
 	}, nil
 }
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/application/usecases/tripseries"
+)
+
+// TripSeriesJob keeps the occurrences of recurring trips created ahead of time
+type TripSeriesJob struct {
+	generator *tripseries.GenerateTripOccurrencesUseCase
+	interval  time.Duration
+}
+
+// NewTripSeriesJob creates a job generating occurrences every interval
+func NewTripSeriesJob(generator *tripseries.GenerateTripOccurrencesUseCase, interval time.Duration) *TripSeriesJob {
+	return &TripSeriesJob{
+		generator: generator,
+		interval:  interval,
+	}
+}
+
+// Run generates occurrences right away, then every interval until ctx is done
+func (j *TripSeriesJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.generator.Execute(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to generate trip series occurrences: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/cache"
+)
+
+// CacheRevokedTokenRepository keeps the revocation list in Redis, letting
+// each entry expire together with the token it revokes
+type CacheRevokedTokenRepository struct{ cache *cache.CacheService }
+
+func NewCacheRevokedTokenRepository(c *cache.CacheService) repositories.RevokedTokenRepository {
+	return &CacheRevokedTokenRepository{cache: c}
+}
+
+func (r *CacheRevokedTokenRepository) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return r.cache.SetWithTTL(ctx, revokedTokenKey(tokenID), true, ttl)
+}
+
+func (r *CacheRevokedTokenRepository) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var revoked bool
+	found, err := r.cache.Get(ctx, revokedTokenKey(tokenID), &revoked)
+	if err != nil {
+		return false, err
+	}
+	return found && revoked, nil
+}
+
+func revokedTokenKey(tokenID string) string {
+	return cache.BuildKey("revoked", tokenID)
+}
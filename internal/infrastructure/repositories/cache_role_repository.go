@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/cache"
+)
+
+// CacheRoleRepository caches role definitions in Redis in front of another repository,
+// so resolving permissions does not hit the database on every request. Edits made in the
+// database apply once the cached entry expires.
+type CacheRoleRepository struct {
+	cache *cache.CacheService
+	next  repositories.RoleRepository
+}
+
+func NewCacheRoleRepository(c *cache.CacheService, next repositories.RoleRepository) repositories.RoleRepository {
+	return &CacheRoleRepository{cache: c, next: next}
+}
+
+func (r *CacheRoleRepository) FindByName(ctx context.Context, name string) (*entities.Role, error) {
+	var role entities.Role
+	found, err := r.cache.Get(ctx, roleKey(name), &role)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &role, nil
+	}
+
+	existing, err := r.next.FindByName(ctx, name)
+	if err != nil || existing == nil {
+		return existing, err
+	}
+	if err := r.cache.Set(ctx, roleKey(name), existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func roleKey(name string) string {
+	return cache.BuildKey("role", name)
+}
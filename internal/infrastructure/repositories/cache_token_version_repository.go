@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/cache"
+)
+
+// CacheTokenVersionRepository caches token states in Redis in front of another repository,
+// so the auth middleware does not hit the database on every request
+type CacheTokenVersionRepository struct {
+	cache *cache.CacheService
+	next  repositories.TokenVersionRepository
+}
+
+func NewCacheTokenVersionRepository(c *cache.CacheService, next repositories.TokenVersionRepository) repositories.TokenVersionRepository {
+	return &CacheTokenVersionRepository{cache: c, next: next}
+}
+
+func (r *CacheTokenVersionRepository) CurrentState(ctx context.Context, userID string) (*entities.TokenState, error) {
+	var state entities.TokenState
+	found, err := r.cache.Get(ctx, tokenStateKey(userID), &state)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &state, nil
+	}
+
+	current, err := r.next.CurrentState(ctx, userID)
+	if err != nil || current == nil {
+		return current, err
+	}
+	if err := r.cache.Set(ctx, tokenStateKey(userID), current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (r *CacheTokenVersionRepository) Invalidate(ctx context.Context, userID string) error {
+	if err := r.next.Invalidate(ctx, userID); err != nil {
+		return err
+	}
+	return r.cache.Delete(ctx, tokenStateKey(userID))
+}
+
+func tokenStateKey(userID string) string {
+	return cache.BuildKey("token_state", userID)
+}
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormApiKeyRepository struct{ db *gorm.DB }
+
+func NewGormApiKeyRepository(db *gorm.DB) repositories.ApiKeyRepository {
+	return &GormApiKeyRepository{db: db}
+}
+
+func (r *GormApiKeyRepository) Create(ctx context.Context, data entities.CreateApiKeyData) (*entities.ApiKey, error) {
+	m := &database.ApiKeyModel{
+		Name:             data.Name,
+		Prefix:           data.Prefix,
+		KeyHash:          data.KeyHash,
+		Scopes:           strings.Join(data.Scopes, ","),
+		UserID:           data.UserID,
+		ServiceAccountID: data.ServiceAccountID,
+		ExpiresAt:        data.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toApiKeyEntity(m), nil
+}
+
+func (r *GormApiKeyRepository) FindByID(ctx context.Context, id string) (*entities.ApiKey, error) {
+	var m database.ApiKeyModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toApiKeyEntity(&m), nil
+}
+
+// apiKeyPrincipalRow is an API key joined with the role of whoever owns it
+type apiKeyPrincipalRow struct {
+	database.ApiKeyModel
+	Role           string
+	EmailVerified  bool
+	SuspendedUntil *time.Time
+	BannedAt       *time.Time
+}
+
+func (r *GormApiKeyRepository) FindPrincipalByHash(ctx context.Context, keyHash string) (*entities.ApiKeyPrincipal, error) {
+	var rows []apiKeyPrincipalRow
+	err := r.db.WithContext(ctx).Model(&database.ApiKeyModel{}).
+		Select(`api_keys.*,
+			COALESCE(auths.role, service_accounts.role) AS role,
+			(auths.email_verified_at IS NOT NULL OR service_accounts.id IS NOT NULL) AS email_verified,
+			auths.suspended_until, auths.banned_at`).
+		Joins("LEFT JOIN users ON users.id = api_keys.user_id").
+		Joins("LEFT JOIN auths ON auths.ref_id = users.auth_ref_id").
+		Joins("LEFT JOIN service_accounts ON service_accounts.id = api_keys.service_account_id").
+		Where("api_keys.key_hash = ?", keyHash).
+		Where("(auths.ref_id IS NOT NULL AND users.anonymized_at IS NULL AND auths.anonymized_at IS NULL) OR service_accounts.id IS NOT NULL").
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return &entities.ApiKeyPrincipal{
+		Key:           *toApiKeyEntity(&rows[0].ApiKeyModel),
+		Role:          rows[0].Role,
+		EmailVerified: rows[0].EmailVerified,
+		AccountStatus: entities.AccountStatus{
+			SuspendedUntil: rows[0].SuspendedUntil,
+			BannedAt:       rows[0].BannedAt,
+		},
+	}, nil
+}
+
+func (r *GormApiKeyRepository) FindByUserID(ctx context.Context, userID string) ([]entities.ApiKey, error) {
+	return r.findWhere(ctx, "user_id = ?", userID)
+}
+
+func (r *GormApiKeyRepository) FindByServiceAccountID(ctx context.Context, serviceAccountID string) ([]entities.ApiKey, error) {
+	return r.findWhere(ctx, "service_account_id = ?", serviceAccountID)
+}
+
+func (r *GormApiKeyRepository) findWhere(ctx context.Context, query string, args ...interface{}) ([]entities.ApiKey, error) {
+	var models []database.ApiKeyModel
+	if err := r.db.WithContext(ctx).Where(query, args...).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	keys := make([]entities.ApiKey, len(models))
+	for i := range models {
+		keys[i] = *toApiKeyEntity(&models[i])
+	}
+	return keys, nil
+}
+
+func (r *GormApiKeyRepository) Revoke(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.ApiKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormApiKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&database.ApiKeyModel{}).
+		Where("id = ?", id).
+		Update("last_used_at", at).Error
+}
+
+func toApiKeyEntity(m *database.ApiKeyModel) *entities.ApiKey {
+	var scopes []string
+	if m.Scopes != "" {
+		scopes = strings.Split(m.Scopes, ",")
+	}
+	return &entities.ApiKey{
+		ID:               m.ID,
+		Name:             m.Name,
+		Prefix:           m.Prefix,
+		KeyHash:          m.KeyHash,
+		Scopes:           scopes,
+		UserID:           m.UserID,
+		ServiceAccountID: m.ServiceAccountID,
+		ExpiresAt:        m.ExpiresAt,
+		LastUsedAt:       m.LastUsedAt,
+		RevokedAt:        m.RevokedAt,
+		CreatedAt:        m.CreatedAt,
+	}
+}
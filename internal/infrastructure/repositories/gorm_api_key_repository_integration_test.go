@@ -0,0 +1,126 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiKeyRepo_UserKeyPrincipal_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormApiKeyRepository(testDB)
+	ctx := context.Background()
+	_, user := createTestAuthAndUser(t, "keys@example.com", "Key", "Owner", "+33600000050")
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	created, err := repo.Create(ctx, entities.CreateApiKeyData{
+		Name:      "backup script",
+		Prefix:    "cvk_abcdefgh",
+		KeyHash:   "key-hash",
+		Scopes:    []string{entities.ApiKeyScopeRead, entities.ApiKeyScopeWrite},
+		UserID:    &user.ID,
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	principal, err := repo.FindPrincipalByHash(ctx, "key-hash")
+	require.NoError(t, err)
+	require.NotNil(t, principal)
+	assert.Equal(t, created.ID, principal.Key.ID)
+	assert.Equal(t, "USER", principal.Role)
+	assert.False(t, principal.EmailVerified)
+	assert.Equal(t, []string{"read", "write"}, principal.Key.Scopes)
+
+	keys, err := repo.FindByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "cvk_abcdefgh", keys[0].Prefix)
+
+	// Keys stop resolving once their owner is anonymized
+	require.NoError(t, NewGormUserRepository(testDB).Anonymize(ctx, user.ID))
+	principal, err = repo.FindPrincipalByHash(ctx, "key-hash")
+	require.NoError(t, err)
+	assert.Nil(t, principal)
+}
+
+func TestApiKeyRepo_ServiceAccountKeyPrincipal_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	accounts := NewGormServiceAccountRepository(testDB)
+	repo := NewGormApiKeyRepository(testDB)
+	ctx := context.Background()
+
+	account, err := accounts.Create(ctx, entities.CreateServiceAccountData{Name: "billing-export", Role: "ADMIN"})
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, entities.CreateApiKeyData{
+		Name:             "nightly",
+		Prefix:           "cvk_12345678",
+		KeyHash:          "sa-key-hash",
+		Scopes:           []string{entities.ApiKeyScopeRead},
+		ServiceAccountID: &account.ID,
+	})
+	require.NoError(t, err)
+
+	principal, err := repo.FindPrincipalByHash(ctx, "sa-key-hash")
+	require.NoError(t, err)
+	require.NotNil(t, principal)
+	assert.Equal(t, "ADMIN", principal.Role)
+	assert.Equal(t, account.ID, *principal.Key.ServiceAccountID)
+	assert.Nil(t, principal.Key.UserID)
+
+	keys, err := repo.FindByServiceAccountID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	all, err := accounts.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	found, err := accounts.FindByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "billing-export", found.Name)
+}
+
+func TestApiKeyRepo_RevokeAndTouch_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormApiKeyRepository(testDB)
+	ctx := context.Background()
+	_, user := createTestAuthAndUser(t, "revoke@example.com", "Re", "Voke", "+33600000051")
+
+	created, err := repo.Create(ctx, entities.CreateApiKeyData{
+		Name: "ci", Prefix: "cvk_revoke00", KeyHash: "revoke-hash", Scopes: []string{"read"}, UserID: &user.ID,
+	})
+	require.NoError(t, err)
+
+	usedAt := time.Now()
+	require.NoError(t, repo.TouchLastUsed(ctx, created.ID, usedAt))
+
+	revoked, err := repo.Revoke(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// Revoking twice is reported
+	revoked, err = repo.Revoke(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	found, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.True(t, found.IsRevoked())
+	require.NotNil(t, found.LastUsedAt)
+	assert.WithinDuration(t, usedAt, *found.LastUsedAt, time.Second)
+}
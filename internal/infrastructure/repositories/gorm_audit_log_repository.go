@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormAuditLogRepository struct{ db *gorm.DB }
+
+func NewGormAuditLogRepository(db *gorm.DB) repositories.AuditLogRepository {
+	return &GormAuditLogRepository{db: db}
+}
+
+func (r *GormAuditLogRepository) Create(ctx context.Context, data entities.CreateAuditLogData) error {
+	return r.db.WithContext(ctx).Create(&database.AuditLogModel{
+		Action:    data.Action,
+		Success:   data.Success,
+		ActorID:   data.ActorID,
+		TargetID:  data.TargetID,
+		Email:     data.Email,
+		ErrorCode: data.ErrorCode,
+		IPAddress: data.IPAddress,
+		RequestID: data.RequestID,
+	}).Error
+}
+
+func (r *GormAuditLogRepository) FindByFilters(ctx context.Context, filters entities.AuditLogFilters, skip, take int) ([]entities.AuditLog, int, error) {
+	query := r.db.WithContext(ctx).Model(&database.AuditLogModel{})
+
+	if filters.Action != nil {
+		query = query.Where("action = ?", *filters.Action)
+	}
+	if filters.ActorID != nil {
+		query = query.Where("actor_id = ?", *filters.ActorID)
+	}
+	if filters.TargetID != nil {
+		query = query.Where("target_id = ?", *filters.TargetID)
+	}
+	if filters.Email != nil {
+		query = query.Where("email = ?", *filters.Email)
+	}
+	if filters.Success != nil {
+		query = query.Where("success = ?", *filters.Success)
+	}
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+	if filters.To != nil {
+		query = query.Where("created_at < ?", *filters.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []database.AuditLogModel
+	if err := query.Order("created_at DESC").Offset(skip).Limit(take).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	logs := make([]entities.AuditLog, len(models))
+	for i := range models {
+		logs[i] = toAuditLogEntity(&models[i])
+	}
+	return logs, int(total), nil
+}
+
+func toAuditLogEntity(m *database.AuditLogModel) entities.AuditLog {
+	return entities.AuditLog{
+		ID:        m.ID,
+		Action:    m.Action,
+		Success:   m.Success,
+		ActorID:   m.ActorID,
+		TargetID:  m.TargetID,
+		Email:     m.Email,
+		ErrorCode: m.ErrorCode,
+		IPAddress: m.IPAddress,
+		RequestID: m.RequestID,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogRepo_CreateAndFilter_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuditLogRepository(testDB)
+	ctx := context.Background()
+
+	adminID := "11111111-1111-1111-1111-111111111111"
+	targetID := "22222222-2222-2222-2222-222222222222"
+	email := "victim@example.com"
+	errorCode := "INVALID_CREDENTIALS"
+
+	require.NoError(t, repo.Create(ctx, entities.CreateAuditLogData{
+		Action: entities.AuditActionLogin, Success: false, Email: &email, ErrorCode: &errorCode,
+		IPAddress: "203.0.113.7", RequestID: "req-1",
+	}))
+	require.NoError(t, repo.Create(ctx, entities.CreateAuditLogData{
+		Action: entities.AuditActionLogin, Success: true, TargetID: &targetID, Email: &email,
+		IPAddress: "203.0.113.7", RequestID: "req-2",
+	}))
+	require.NoError(t, repo.Create(ctx, entities.CreateAuditLogData{
+		Action: entities.AuditActionBan, Success: true, ActorID: &adminID, TargetID: &targetID,
+		IPAddress: "198.51.100.1", RequestID: "req-3",
+	}))
+
+	all, total, err := repo.FindByFilters(ctx, entities.AuditLogFilters{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, all, 3)
+	assert.Equal(t, "req-3", all[0].RequestID, "newest first")
+
+	failed := false
+	logins, total, err := repo.FindByFilters(ctx, entities.AuditLogFilters{Email: &email, Success: &failed}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, logins, 1)
+	require.NotNil(t, logins[0].ErrorCode)
+	assert.Equal(t, "INVALID_CREDENTIALS", *logins[0].ErrorCode)
+
+	byTarget, total, err := repo.FindByFilters(ctx, entities.AuditLogFilters{TargetID: &targetID}, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, byTarget, 1)
+
+	future := time.Now().Add(time.Hour)
+	none, total, err := repo.FindByFilters(ctx, entities.AuditLogFilters{From: &future}, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, none)
+}
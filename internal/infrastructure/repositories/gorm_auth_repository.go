@@ -2,19 +2,27 @@ package repositories
 
 import (
 	"context"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
 	"github.com/lgxju/gogretago/internal/infrastructure/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// GormAuthRepository stores TOTP secrets encrypted with secretCipher and hands them out decrypted
 type GormAuthRepository struct {
-	db *gorm.DB
+	db           *gorm.DB
+	secretCipher services.SecretCipher
 }
 
-func NewGormAuthRepository(db *gorm.DB) repositories.AuthRepository {
-	return &GormAuthRepository{db: db}
+func NewGormAuthRepository(db *gorm.DB, secretCipher services.SecretCipher) repositories.AuthRepository {
+	return &GormAuthRepository{db: db, secretCipher: secretCipher}
 }
 
 func (r *GormAuthRepository) FindByEmail(ctx context.Context, email string) (*entities.Auth, error) {
@@ -26,7 +34,19 @@ func (r *GormAuthRepository) FindByEmail(ctx context.Context, email string) (*en
 		}
 		return nil, result.Error
 	}
-	return toAuthEntity(&model), nil
+	return r.toEntity(&model)
+}
+
+func (r *GormAuthRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Auth, error) {
+	var model database.AuthModel
+	result := r.db.WithContext(ctx).Where("ref_id = ?", refID).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return r.toEntity(&model)
 }
 
 func (r *GormAuthRepository) CreateWithUser(ctx context.Context, authData entities.CreateAuthData, userData entities.CreateUserData) (*entities.Auth, *entities.PublicUser, error) {
@@ -86,16 +106,159 @@ func (r *GormAuthRepository) ExistsByEmail(ctx context.Context, email string) (b
 }
 
 func (r *GormAuthRepository) UpdateRole(ctx context.Context, refID int64, role string) error {
-	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).Update("role", role).Error
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		Updates(map[string]interface{}{"role": role, "token_version": gorm.Expr("token_version + 1")}).Error
+}
+
+func (r *GormAuthRepository) UpdatePassword(ctx context.Context, refID int64, passwordHash string) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		Updates(map[string]interface{}{"password": passwordHash, "token_version": gorm.Expr("token_version + 1")}).Error
+}
+
+func (r *GormAuthRepository) ReplacePasswordHash(ctx context.Context, refID int64, oldHash, newHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Where("ref_id = ? AND password = ?", refID, oldHash).
+		Update("password", newHash)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormAuthRepository) MarkEmailVerified(ctx context.Context, refID int64) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Where("ref_id = ? AND email_verified_at IS NULL", refID).
+		Update("email_verified_at", time.Now()).Error
+}
+
+func (r *GormAuthRepository) IncrementFailedLogins(ctx context.Context, refID int64) (int, error) {
+	var model database.AuthModel
+	result := r.db.WithContext(ctx).Model(&model).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "failed_login_attempts"}}}).
+		Where("ref_id = ?", refID).
+		UpdateColumn("failed_login_attempts", gorm.Expr("failed_login_attempts + 1"))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return model.FailedLoginAttempts, nil
+}
+
+func (r *GormAuthRepository) LockUntil(ctx context.Context, refID int64, until time.Time) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).UpdateColumn("locked_until", until).Error
+}
+
+func (r *GormAuthRepository) ResetFailedLogins(ctx context.Context, refID int64) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		UpdateColumns(map[string]interface{}{"failed_login_attempts": 0, "locked_until": nil}).Error
+}
+
+func (r *GormAuthRepository) StartTotpEnrollment(ctx context.Context, refID int64, secret string) error {
+	encrypted, err := r.secretCipher.Encrypt(secret)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Where("ref_id = ? AND totp_enabled_at IS NULL", refID).
+		Update("totp_secret", encrypted).Error
+}
+
+func (r *GormAuthRepository) EnableTotp(ctx context.Context, refID int64) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Where("ref_id = ? AND totp_secret IS NOT NULL", refID).
+		Update("totp_enabled_at", time.Now()).Error
+}
+
+func (r *GormAuthRepository) UseTotpStep(ctx context.Context, refID int64, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Where("ref_id = ? AND totp_last_step < ?", refID, step).
+		UpdateColumn("totp_last_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormAuthRepository) Suspend(ctx context.Context, refID int64, until time.Time, reason string) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		UpdateColumns(map[string]interface{}{"suspended_until": until, "suspension_reason": reason}).Error
+}
+
+func (r *GormAuthRepository) Ban(ctx context.Context, refID int64, reason string) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		UpdateColumns(map[string]interface{}{"banned_at": time.Now(), "suspension_reason": reason}).Error
+}
+
+func (r *GormAuthRepository) Reinstate(ctx context.Context, refID int64) error {
+	return r.db.WithContext(ctx).Model(&database.AuthModel{}).Where("ref_id = ?", refID).
+		UpdateColumns(map[string]interface{}{"suspended_until": nil, "suspension_reason": nil, "banned_at": nil}).Error
+}
+
+// toEntity maps an auth row with its TOTP secret decrypted
+func (r *GormAuthRepository) toEntity(model *database.AuthModel) (*entities.Auth, error) {
+	auth := toAuthEntity(model)
+	if auth.TotpSecret != nil {
+		secret, err := r.secretCipher.Decrypt(*auth.TotpSecret)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt TOTP secret of auth %d: %w", model.RefID, err)
+		}
+		auth.TotpSecret = &secret
+	}
+	return auth, nil
+}
+
+// EncryptTotpSecrets encrypts the TOTP secrets stored in plain text before they were encrypted
+// at rest. Secrets that already decrypt with secretCipher are left alone, so it can run at every start;
+// one that neither decrypts nor is base32 means the key changed, and nothing is touched.
+func EncryptTotpSecrets(ctx context.Context, db *gorm.DB, secretCipher services.SecretCipher) error {
+	var models []database.AuthModel
+	if err := db.WithContext(ctx).Select("ref_id", "totp_secret").Where("totp_secret IS NOT NULL").Find(&models).Error; err != nil {
+		return err
+	}
+	for _, m := range models {
+		if _, err := secretCipher.Decrypt(*m.TotpSecret); err == nil {
+			continue
+		}
+		if !isPlainTotpSecret(*m.TotpSecret) {
+			return fmt.Errorf("TOTP secret of auth %d does not decrypt with the configured key", m.RefID)
+		}
+		encrypted, err := secretCipher.Encrypt(*m.TotpSecret)
+		if err != nil {
+			return err
+		}
+		if err := db.WithContext(ctx).Model(&database.AuthModel{}).
+			Where("ref_id = ? AND totp_secret = ?", m.RefID, *m.TotpSecret).
+			UpdateColumn("totp_secret", encrypted).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isPlainTotpSecret reports whether a stored secret is an unencrypted base32 TOTP secret
+func isPlainTotpSecret(secret string) bool {
+	_, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	return secret != "" && err == nil
 }
 
 func toAuthEntity(model *database.AuthModel) *entities.Auth {
 	return &entities.Auth{
-		ID:           model.ID,
-		RefID:        model.RefID,
-		Email:        model.Email,
-		Password:     model.Password,
-		Role:         model.Role,
+		ID:                  model.ID,
+		RefID:               model.RefID,
+		Email:               model.Email,
+		Password:            model.Password,
+		Role:                model.Role,
+		EmailVerifiedAt:     model.EmailVerifiedAt,
+		FailedLoginAttempts: model.FailedLoginAttempts,
+		LockedUntil:         model.LockedUntil,
+		TotpSecret:          model.TotpSecret,
+		TotpEnabledAt:       model.TotpEnabledAt,
+		TotpLastStep:        model.TotpLastStep,
+		TokenVersion:        model.TokenVersion,
+		AccountStatus: entities.AccountStatus{
+			SuspendedUntil:   model.SuspendedUntil,
+			SuspensionReason: model.SuspensionReason,
+			BannedAt:         model.BannedAt,
+		},
 		AnonymizedAt: model.AnonymizedAt,
 		CreatedAt:    model.CreatedAt,
 		UpdatedAt:    model.UpdatedAt,
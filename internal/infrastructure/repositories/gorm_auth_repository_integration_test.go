@@ -3,10 +3,14 @@
 package repositories
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	infraservices "github.com/lgxju/gogretago/internal/infrastructure/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +19,7 @@ func TestAuthRepo_CreateWithUser_Integration(t *testing.T) {
 	cleanTables(t)
 	t.Cleanup(func() { cleanTables(t) })
 
-	repo := NewGormAuthRepository(testDB)
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
 	ctx := context.Background()
 
 	firstName := "John"
@@ -54,7 +58,7 @@ func TestAuthRepo_FindByEmail_Integration(t *testing.T) {
 	cleanTables(t)
 	t.Cleanup(func() { cleanTables(t) })
 
-	repo := NewGormAuthRepository(testDB)
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
 	ctx := context.Background()
 
 	// Create a record first
@@ -82,11 +86,36 @@ func TestAuthRepo_FindByEmail_Integration(t *testing.T) {
 	assert.Nil(t, notFound)
 }
 
+func TestAuthRepo_FindByRefID_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	firstName := "Carl"
+	auth, _, err := repo.CreateWithUser(ctx,
+		entities.CreateAuthData{Email: "carl@example.com", Password: "pw"},
+		entities.CreateUserData{FirstName: &firstName},
+	)
+	require.NoError(t, err)
+
+	found, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, auth.ID, found.ID)
+	assert.Equal(t, "carl@example.com", found.Email)
+
+	notFound, err := repo.FindByRefID(ctx, 99999)
+	require.NoError(t, err)
+	assert.Nil(t, notFound)
+}
+
 func TestAuthRepo_ExistsByEmail_Integration(t *testing.T) {
 	cleanTables(t)
 	t.Cleanup(func() { cleanTables(t) })
 
-	repo := NewGormAuthRepository(testDB)
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
 	ctx := context.Background()
 
 	// Create a record
@@ -112,7 +141,7 @@ func TestAuthRepo_UpdateRole_Integration(t *testing.T) {
 	cleanTables(t)
 	t.Cleanup(func() { cleanTables(t) })
 
-	repo := NewGormAuthRepository(testDB)
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
 	ctx := context.Background()
 
 	// Create a record
@@ -134,3 +163,217 @@ func TestAuthRepo_UpdateRole_Integration(t *testing.T) {
 	require.NotNil(t, updated)
 	assert.Equal(t, "ADMIN", updated.Role)
 }
+
+func TestAuthRepo_UpdatePassword_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "pw@example.com", "Pass", "Word", "+33600000013")
+
+	err := repo.UpdatePassword(ctx, auth.RefID, "new-hash")
+	require.NoError(t, err)
+
+	updated, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, "new-hash", updated.Password)
+	assert.Equal(t, auth.TokenVersion+1, updated.TokenVersion)
+}
+
+func TestAuthRepo_ReplacePasswordHash_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "rehash@example.com", "Re", "Hash", "+33600000014")
+
+	// Only replaces the hash it was computed from
+	ok, err := repo.ReplacePasswordHash(ctx, auth.RefID, "stale-hash", "new-hash")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.ReplacePasswordHash(ctx, auth.RefID, auth.Password, "new-hash")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	updated, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-hash", updated.Password)
+	assert.Equal(t, auth.TokenVersion, updated.TokenVersion, "rehashing does not sign the user out")
+}
+
+func TestAuthRepo_MarkEmailVerified_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "verify@example.com", "Veri", "Fied", "+33600000018")
+	assert.Nil(t, auth.EmailVerifiedAt)
+
+	require.NoError(t, repo.MarkEmailVerified(ctx, auth.RefID))
+
+	verified, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	require.NotNil(t, verified.EmailVerifiedAt)
+	firstVerifiedAt := *verified.EmailVerifiedAt
+
+	// A second call keeps the original timestamp
+	require.NoError(t, repo.MarkEmailVerified(ctx, auth.RefID))
+	again, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.True(t, firstVerifiedAt.Equal(*again.EmailVerifiedAt))
+}
+
+func TestAuthRepo_FailedLogins_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "lockout@example.com", "Lock", "Out", "+33600000019")
+
+	count, err := repo.IncrementFailedLogins(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = repo.IncrementFailedLogins(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	until := time.Now().Add(15 * time.Minute)
+	require.NoError(t, repo.LockUntil(ctx, auth.RefID, until))
+
+	locked, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, locked.FailedLoginAttempts)
+	require.NotNil(t, locked.LockedUntil)
+	assert.True(t, locked.IsLocked(time.Now()))
+
+	require.NoError(t, repo.ResetFailedLogins(ctx, auth.RefID))
+
+	reset, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Zero(t, reset.FailedLoginAttempts)
+	assert.Nil(t, reset.LockedUntil)
+}
+
+func TestAuthRepo_Totp_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "totp@example.com", "To", "Tp", "+33600000021")
+
+	require.NoError(t, repo.StartTotpEnrollment(ctx, auth.RefID, "SECRET1"))
+	pending, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.True(t, pending.HasPendingTotpEnrollment())
+	assert.False(t, pending.IsTwoFactorEnabled())
+
+	require.NoError(t, repo.EnableTotp(ctx, auth.RefID))
+
+	// The secret cannot be swapped once two-factor is enabled
+	require.NoError(t, repo.StartTotpEnrollment(ctx, auth.RefID, "SECRET2"))
+	enabled, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.True(t, enabled.IsTwoFactorEnabled())
+	require.NotNil(t, enabled.TotpSecret)
+	assert.Equal(t, "SECRET1", *enabled.TotpSecret)
+
+	// The secret is only stored encrypted
+	var stored database.AuthModel
+	require.NoError(t, testDB.Where("ref_id = ?", auth.RefID).First(&stored).Error)
+	require.NotNil(t, stored.TotpSecret)
+	assert.NotEqual(t, "SECRET1", *stored.TotpSecret)
+
+	ok, err := repo.UseTotpStep(ctx, auth.RefID, 100)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = repo.UseTotpStep(ctx, auth.RefID, 100)
+	require.NoError(t, err)
+	assert.False(t, ok, "a time step cannot be used twice")
+	ok, err = repo.UseTotpStep(ctx, auth.RefID, 99)
+	require.NoError(t, err)
+	assert.False(t, ok, "an earlier time step is rejected")
+}
+
+func TestAuthRepo_EncryptTotpSecrets_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	legacy, _ := createTestAuthAndUser(t, "legacy-totp@example.com", "Le", "Gacy", "+33600000064")
+	current, _ := createTestAuthAndUser(t, "current-totp@example.com", "Cur", "Rent", "+33600000065")
+	require.NoError(t, testDB.Model(&database.AuthModel{}).Where("ref_id = ?", legacy.RefID).
+		Update("totp_secret", "JBSWY3DPEHPK3PXP").Error)
+	require.NoError(t, repo.StartTotpEnrollment(ctx, current.RefID, "NEWSECRET"))
+
+	// Running twice leaves the secrets encrypted once
+	require.NoError(t, EncryptTotpSecrets(ctx, testDB, testSecretCipher))
+	require.NoError(t, EncryptTotpSecrets(ctx, testDB, testSecretCipher))
+
+	var stored database.AuthModel
+	require.NoError(t, testDB.Where("ref_id = ?", legacy.RefID).First(&stored).Error)
+	assert.NotEqual(t, "JBSWY3DPEHPK3PXP", *stored.TotpSecret)
+
+	found, err := repo.FindByRefID(ctx, legacy.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", *found.TotpSecret)
+	found, err = repo.FindByRefID(ctx, current.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, "NEWSECRET", *found.TotpSecret)
+
+	// Another key must not encrypt the secrets a second time
+	otherCipher, err := infraservices.NewAesSecretCipher(bytes.Repeat([]byte{8}, 32))
+	require.NoError(t, err)
+	assert.Error(t, EncryptTotpSecrets(ctx, testDB, otherCipher))
+	found, err = repo.FindByRefID(ctx, legacy.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", *found.TotpSecret)
+}
+
+func TestAuthRepo_SuspendAndBan_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormAuthRepository(testDB, testSecretCipher)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "moderated@example.com", "Mode", "Rated", "+33600000063")
+
+	until := time.Now().Add(24 * time.Hour)
+	require.NoError(t, repo.Suspend(ctx, auth.RefID, until, "spam"))
+
+	suspended, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.True(t, suspended.IsSuspended(time.Now()))
+	assert.False(t, suspended.IsBanned())
+	require.NotNil(t, suspended.SuspensionReason)
+	assert.Equal(t, "spam", *suspended.SuspensionReason)
+
+	require.NoError(t, repo.Ban(ctx, auth.RefID, "fraud"))
+
+	banned, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.True(t, banned.IsBanned())
+	assert.Equal(t, "fraud", *banned.SuspensionReason)
+
+	require.NoError(t, repo.Reinstate(ctx, auth.RefID))
+
+	reinstated, err := repo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.False(t, reinstated.IsBanned())
+	assert.False(t, reinstated.IsSuspended(time.Now()))
+	assert.Nil(t, reinstated.SuspensionReason)
+}
@@ -42,11 +42,24 @@ func (r *GormCarRepository) FindByID(ctx context.Context, id string) (*entities.
 	return &e, nil
 }
 
+func (r *GormCarRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Car, error) {
+	var m database.CarModel
+	if err := r.db.WithContext(ctx).Where("ref_id = ?", refID).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	e := toCarEntity(&m)
+	return &e, nil
+}
+
 func (r *GormCarRepository) Create(ctx context.Context, data entities.CreateCarData) (*entities.Car, error) {
 	m := &database.CarModel{
-		LicensePlate: data.LicensePlate,
-		ModelRefID:   data.ModelRefID,
-		DriverRefID:  data.DriverRefID,
+		LicensePlate:    data.LicensePlate,
+		ModelRefID:      data.ModelRefID,
+		DriverRefID:     data.DriverRefID,
+		FuelConsumption: data.FuelConsumption,
 	}
 	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
 		return nil, err
@@ -63,6 +76,9 @@ func (r *GormCarRepository) Update(ctx context.Context, id string, data entities
 	if data.ModelRefID != nil {
 		updates["model_ref_id"] = *data.ModelRefID
 	}
+	if data.FuelConsumption != nil {
+		updates["fuel_consumption"] = *data.FuelConsumption
+	}
 	if len(updates) > 0 {
 		if err := r.db.WithContext(ctx).Model(&database.CarModel{}).Where("id = ?", id).Updates(updates).Error; err != nil {
 			return nil, err
@@ -86,8 +102,9 @@ func (r *GormCarRepository) ExistsByLicensePlate(ctx context.Context, licensePla
 func toCarEntity(m *database.CarModel) entities.Car {
 	return entities.Car{
 		ID: m.ID, RefID: m.RefID,
-		LicensePlate: m.LicensePlate,
-		ModelRefID:   m.ModelRefID,
-		DriverRefID:  m.DriverRefID,
+		LicensePlate:    m.LicensePlate,
+		ModelRefID:      m.ModelRefID,
+		DriverRefID:     m.DriverRefID,
+		FuelConsumption: m.FuelConsumption,
 	}
 }
@@ -55,7 +55,13 @@ func (r *GormCityRepository) FindByCityName(ctx context.Context, name string) (*
 }
 
 func (r *GormCityRepository) Create(ctx context.Context, data entities.CreateCityData) (*entities.City, error) {
-	m := &database.CityModel{CityName: data.CityName, Zipcode: data.Zipcode}
+	m := &database.CityModel{
+		CityName:  data.CityName,
+		Zipcode:   data.Zipcode,
+		TimeZone:  data.TimeZone,
+		Latitude:  data.Latitude,
+		Longitude: data.Longitude,
+	}
 	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
 		return nil, err
 	}
@@ -63,10 +69,32 @@ func (r *GormCityRepository) Create(ctx context.Context, data entities.CreateCit
 	return &e, nil
 }
 
+func (r *GormCityRepository) Update(ctx context.Context, id string, data entities.UpdateCityData) (*entities.City, error) {
+	updates := map[string]interface{}{}
+	if data.TimeZone != nil {
+		updates["time_zone"] = *data.TimeZone
+	}
+	if data.Latitude != nil {
+		updates["latitude"] = *data.Latitude
+	}
+	if data.Longitude != nil {
+		updates["longitude"] = *data.Longitude
+	}
+	if len(updates) > 0 {
+		if err := r.db.WithContext(ctx).Model(&database.CityModel{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return nil, err
+		}
+	}
+	return r.FindByID(ctx, id)
+}
+
 func (r *GormCityRepository) Delete(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&database.CityModel{}).Error
 }
 
 func toCityEntity(m *database.CityModel) entities.City {
-	return entities.City{ID: m.ID, RefID: m.RefID, CityName: m.CityName, Zipcode: m.Zipcode}
+	return entities.City{
+		ID: m.ID, RefID: m.RefID, CityName: m.CityName, Zipcode: m.Zipcode, TimeZone: m.TimeZone,
+		Latitude: m.Latitude, Longitude: m.Longitude,
+	}
 }
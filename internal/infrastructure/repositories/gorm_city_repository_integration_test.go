@@ -40,9 +40,34 @@ func TestCityRepo_CRUD_Integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, notFound)
 
+	assert.False(t, found.Located())
+
+	// Update locates a city created without coordinates
+	parisLat, parisLng := 48.8566, 2.3522
+	located, err := repo.Update(ctx, city.ID, entities.UpdateCityData{Latitude: &parisLat, Longitude: &parisLng})
+	require.NoError(t, err)
+	require.True(t, located.Located())
+	assert.InDelta(t, 48.8566, *located.Latitude, 1e-9)
+	assert.InDelta(t, 2.3522, *located.Longitude, 1e-9)
+	assert.Equal(t, "Paris", located.CityName)
+	assert.Equal(t, entities.DefaultTimeZone, located.TimeZone)
+
+	// Update moves a city to another time zone
+	timeZone := "Europe/London"
+	moved, err := repo.Update(ctx, city.ID, entities.UpdateCityData{TimeZone: &timeZone})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/London", moved.TimeZone)
+	assert.True(t, moved.Located())
+
 	// Create more cities
-	_, err = repo.Create(ctx, entities.CreateCityData{CityName: "Lyon", Zipcode: "69000"})
+	lat, lng := 45.7640, 4.8357
+	lyon, err := repo.Create(ctx, entities.CreateCityData{CityName: "Lyon", Zipcode: "69000", Latitude: &lat, Longitude: &lng})
+	require.NoError(t, err)
+	found, err = repo.FindByID(ctx, lyon.ID)
 	require.NoError(t, err)
+	require.True(t, found.Located())
+	assert.InDelta(t, 45.7640, *found.Latitude, 1e-9)
+	assert.InDelta(t, 4.8357, *found.Longitude, 1e-9)
 	_, err = repo.Create(ctx, entities.CreateCityData{CityName: "Marseille", Zipcode: "13000"})
 	require.NoError(t, err)
 
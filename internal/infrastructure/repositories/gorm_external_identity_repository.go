@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormExternalIdentityRepository struct{ db *gorm.DB }
+
+func NewGormExternalIdentityRepository(db *gorm.DB) repositories.ExternalIdentityRepository {
+	return &GormExternalIdentityRepository{db: db}
+}
+
+func (r *GormExternalIdentityRepository) FindBySubject(ctx context.Context, issuer, subject string) (*entities.ExternalIdentity, error) {
+	var m database.ExternalIdentityModel
+	if err := r.db.WithContext(ctx).Where("issuer = ? AND subject = ?", issuer, subject).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toExternalIdentityEntity(&m), nil
+}
+
+func (r *GormExternalIdentityRepository) Create(ctx context.Context, data entities.CreateExternalIdentityData) (*entities.ExternalIdentity, error) {
+	m := &database.ExternalIdentityModel{
+		Issuer:    data.Issuer,
+		Subject:   data.Subject,
+		AuthRefID: data.AuthRefID,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toExternalIdentityEntity(m), nil
+}
+
+func toExternalIdentityEntity(m *database.ExternalIdentityModel) *entities.ExternalIdentity {
+	return &entities.ExternalIdentity{
+		ID:        m.ID,
+		Issuer:    m.Issuer,
+		Subject:   m.Subject,
+		AuthRefID: m.AuthRefID,
+		CreatedAt: m.CreatedAt,
+	}
+}
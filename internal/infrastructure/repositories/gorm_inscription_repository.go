@@ -7,6 +7,7 @@ import (
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 	"github.com/lgxju/gogretago/internal/infrastructure/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormInscriptionRepository struct{ db *gorm.DB }
@@ -99,17 +100,78 @@ func (r *GormInscriptionRepository) FindByIDAndUserID(ctx context.Context, id, u
 	return &e, nil
 }
 
-func (r *GormInscriptionRepository) Create(ctx context.Context, data entities.CreateInscriptionData) (*entities.Inscription, error) {
-	m := &database.InscriptionModel{
-		UserRefID: data.UserRefID,
-		TripRefID: data.TripRefID,
-		Status:    "ACTIVE",
+func (r *GormInscriptionRepository) Book(ctx context.Context, trip *entities.Trip, data entities.CreateInscriptionData) (*entities.Inscription, error) {
+	var booked *entities.Inscription
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var m database.TripModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("ref_id = ?", data.TripRefID).First(&m).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+		// The stops come from the caller; the status and seats are read under the lock
+		locked := *trip
+		locked.Status, locked.Seats = m.Status, m.Seats
+		if !locked.IsBookable() {
+			return nil
+		}
+
+		bookings, err := findTripBookings(tx, m.RefID)
+		if err != nil {
+			return err
+		}
+		if locked.SeatsLeft(bookings, data.FromPosition, data.ToPosition) <= 0 {
+			return nil
+		}
+
+		created := &database.InscriptionModel{
+			UserRefID:    data.UserRefID,
+			TripRefID:    data.TripRefID,
+			Status:       entities.InscriptionStatusActive,
+			FromPosition: data.FromPosition,
+			ToPosition:   data.ToPosition,
+		}
+		if err := tx.Create(created).Error; err != nil {
+			return err
+		}
+		inscription := toInscriptionEntity(created)
+
+		if next := locked.StatusForBookings(append(bookings, inscription)); next != m.Status {
+			if err := tx.Model(&m).Update("status", next).Error; err != nil {
+				return err
+			}
+		}
+		booked = &inscription
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+	return booked, nil
+}
+
+// findTripBookings returns the bookings of a trip as the transaction sees them
+func findTripBookings(tx *gorm.DB, tripRefID int64) ([]entities.Inscription, error) {
+	var models []database.InscriptionModel
+	if err := tx.Where("trip_ref_id = ?", tripRefID).Find(&models).Error; err != nil {
 		return nil, err
 	}
-	e := toInscriptionEntity(m)
-	return &e, nil
+	bookings := make([]entities.Inscription, len(models))
+	for i := range models {
+		bookings[i] = toInscriptionEntity(&models[i])
+	}
+	return bookings, nil
+}
+
+func (r *GormInscriptionRepository) UpdateStatus(ctx context.Context, id string, from, to string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.InscriptionModel{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
 }
 
 func (r *GormInscriptionRepository) Delete(ctx context.Context, id string) error {
@@ -128,7 +190,7 @@ func (r *GormInscriptionRepository) ExistsByUserAndTrip(ctx context.Context, use
 func (r *GormInscriptionRepository) CountByTripRefID(ctx context.Context, tripRefID int64) (int, error) {
 	var count int64
 	if err := r.db.WithContext(ctx).Model(&database.InscriptionModel{}).
-		Where("trip_ref_id = ? AND status = 'ACTIVE'", tripRefID).Count(&count).Error; err != nil {
+		Where("trip_ref_id = ? AND status IN ?", tripRefID, entities.SeatHoldingStatuses).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return int(count), nil
@@ -139,6 +201,7 @@ func toInscriptionEntity(m *database.InscriptionModel) entities.Inscription {
 		ID: m.ID, RefID: m.RefID,
 		CreatedAt: m.CreatedAt,
 		UserRefID: m.UserRefID, TripRefID: m.TripRefID,
-		Status: m.Status,
+		Status:       m.Status,
+		FromPosition: m.FromPosition, ToPosition: m.ToPosition,
 	}
 }
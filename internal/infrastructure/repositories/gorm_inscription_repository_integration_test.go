@@ -29,7 +29,7 @@ func createInscriptionPrerequisites(t *testing.T) (userRefID int64, userID strin
 		Seats:       4,
 		DriverRefID: driverRef,
 		CarRefID:    carRef,
-		CityRefIDs:  []int64{depCityRef, arrCityRef},
+		Stops:       []entities.CreateTripStopData{{CityRefID: depCityRef}, {CityRefID: arrCityRef}},
 	})
 	require.NoError(t, err)
 
@@ -39,6 +39,24 @@ func createInscriptionPrerequisites(t *testing.T) (userRefID int64, userID strin
 	return passenger.RefID, passenger.ID, trip.RefID, trip.ID
 }
 
+// bookTestInscription books the passenger on the whole route of the trip
+func bookTestInscription(t *testing.T, userRefID, tripRefID int64, tripID string) *entities.Inscription {
+	t.Helper()
+	ctx := context.Background()
+
+	trip, err := NewGormTripRepository(testDB).FindByID(ctx, tripID)
+	require.NoError(t, err)
+	require.NotNil(t, trip)
+	inscription, err := NewGormInscriptionRepository(testDB).Book(ctx, trip, entities.CreateInscriptionData{
+		UserRefID:  userRefID,
+		TripRefID:  tripRefID,
+		ToPosition: trip.LastPosition(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, inscription)
+	return inscription
+}
+
 func TestInscriptionRepo_CRUD_Integration(t *testing.T) {
 	cleanTables(t)
 	t.Cleanup(func() { cleanTables(t) })
@@ -48,13 +66,8 @@ func TestInscriptionRepo_CRUD_Integration(t *testing.T) {
 
 	userRefID, userID, tripRefID, tripID := createInscriptionPrerequisites(t)
 
-	// Create inscription
-	inscription, err := repo.Create(ctx, entities.CreateInscriptionData{
-		UserRefID: userRefID,
-		TripRefID: tripRefID,
-	})
-	require.NoError(t, err)
-	require.NotNil(t, inscription)
+	// Book inscription
+	inscription := bookTestInscription(t, userRefID, tripRefID, tripID)
 	assert.NotEmpty(t, inscription.ID)
 	assert.Greater(t, inscription.RefID, int64(0))
 	assert.Equal(t, userRefID, inscription.UserRefID)
@@ -134,3 +147,171 @@ func TestInscriptionRepo_CRUD_Integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, deleted)
 }
+
+func TestInscriptionRepo_TripCancellation_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormInscriptionRepository(testDB)
+	tripRepo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	userRefID, _, tripRefID, tripID := createInscriptionPrerequisites(t)
+	inscription := bookTestInscription(t, userRefID, tripRefID, tripID)
+
+	cancelled, err := tripRepo.Cancel(ctx, tripID, "Car broke down")
+	require.NoError(t, err)
+	assert.True(t, cancelled)
+
+	// A cancelled trip cannot be cancelled again, and keeps its first reason
+	cancelled, err = tripRepo.Cancel(ctx, tripID, "Twice")
+	require.NoError(t, err)
+	assert.False(t, cancelled)
+
+	trip, err := tripRepo.FindByID(ctx, tripID)
+	require.NoError(t, err)
+	require.NotNil(t, trip)
+	assert.Equal(t, entities.TripStatusCancelled, trip.Status)
+	require.NotNil(t, trip.CancellationReason)
+	assert.Equal(t, "Car broke down", *trip.CancellationReason)
+
+	found, err := repo.FindByID(ctx, inscription.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, entities.InscriptionStatusCancelledByDriver, found.Status)
+
+	count, err := repo.CountByTripRefID(ctx, tripRefID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestInscriptionRepo_TripUpdate_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormInscriptionRepository(testDB)
+	tripRepo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	userRefID, _, tripRefID, tripID := createInscriptionPrerequisites(t)
+	inscription := bookTestInscription(t, userRefID, tripRefID, tripID)
+	dijon, err := NewGormCityRepository(testDB).Create(ctx, entities.CreateCityData{CityName: "Dijon", Zipcode: "21000"})
+	require.NoError(t, err)
+
+	departure := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+	trip, err := tripRepo.Update(ctx, tripID, entities.UpdateTripData{
+		DateTrip:           &departure,
+		DepartureCityRefID: &dijon.RefID,
+		StopShift:          2 * time.Hour,
+		Changes: []entities.CreateTripChangeData{
+			{Field: entities.TripChangeDepartureCity, OldValue: "Paris", NewValue: "Dijon"},
+			{Field: entities.TripChangeDate, OldValue: "2026-04-01 08:00 UTC", NewValue: "2026-04-01 10:00 UTC"},
+		},
+		ReconfirmBookings: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, trip)
+	assert.True(t, departure.Equal(trip.DateTrip))
+	assert.Equal(t, "Dijon", trip.Stops[0].CityName)
+
+	changes, err := tripRepo.FindChanges(ctx, tripRefID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, entities.TripChangeDepartureCity, changes[0].Field)
+
+	found, err := repo.FindByID(ctx, inscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.InscriptionStatusPendingConfirmation, found.Status)
+
+	count, err := repo.CountByTripRefID(ctx, tripRefID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "a booking waiting for confirmation keeps its seat")
+
+	confirmed, err := repo.UpdateStatus(ctx, inscription.ID, entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+	found, err = repo.FindByID(ctx, inscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.InscriptionStatusActive, found.Status)
+
+	// Only a booking waiting for confirmation can be confirmed
+	confirmed, err = repo.UpdateStatus(ctx, inscription.ID, entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive)
+	require.NoError(t, err)
+	assert.False(t, confirmed)
+}
+
+func TestInscriptionRepo_Book_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormInscriptionRepository(testDB)
+	tripRepo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	userRefID, _, tripRefID, tripID := createInscriptionPrerequisites(t)
+	trip, err := tripRepo.FindByID(ctx, tripID)
+	require.NoError(t, err)
+	require.NotNil(t, trip)
+
+	data := entities.CreateInscriptionData{UserRefID: userRefID, TripRefID: tripRefID, ToPosition: 1}
+	for i := 0; i < trip.Seats; i++ {
+		booked, err := repo.Book(ctx, trip, data)
+		require.NoError(t, err)
+		require.NotNil(t, booked)
+		assert.Equal(t, entities.InscriptionStatusActive, booked.Status)
+	}
+
+	found, err := tripRepo.FindByID(ctx, tripID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusFull, found.Status)
+
+	// The trip passed in is stale, the seats are checked again under the lock
+	booked, err := repo.Book(ctx, trip, data)
+	require.NoError(t, err)
+	assert.Nil(t, booked)
+
+	count, err := repo.CountByTripRefID(ctx, tripRefID)
+	require.NoError(t, err)
+	assert.Equal(t, trip.Seats, count)
+}
+
+func TestInscriptionRepo_SeatsFollowBookings_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	tripRepo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	userRefID, _, tripRefID, tripID := createInscriptionPrerequisites(t)
+	bookTestInscription(t, userRefID, tripRefID, tripID)
+	bookTestInscription(t, userRefID, tripRefID, tripID)
+
+	// Seats cannot drop below the two taken
+	one := 1
+	refused, err := tripRepo.Update(ctx, tripID, entities.UpdateTripData{
+		Seats:   &one,
+		Changes: []entities.CreateTripChangeData{{Field: entities.TripChangeSeats, OldValue: "4", NewValue: "1"}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, refused)
+	changes, err := tripRepo.FindChanges(ctx, tripRefID)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// Down to the seats taken, the trip becomes full
+	two := 2
+	updated, err := tripRepo.Update(ctx, tripID, entities.UpdateTripData{Seats: &two})
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, 2, updated.Seats)
+	assert.Equal(t, entities.TripStatusFull, updated.Status)
+
+	// And opens again with a seat more
+	three := 3
+	reopened, err := tripRepo.Update(ctx, tripID, entities.UpdateTripData{Seats: &three})
+	require.NoError(t, err)
+	require.NotNil(t, reopened)
+	found, err := tripRepo.FindByID(ctx, tripID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusScheduled, found.Status)
+}
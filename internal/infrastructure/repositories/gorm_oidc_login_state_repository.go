@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormOidcLoginStateRepository struct{ db *gorm.DB }
+
+func NewGormOidcLoginStateRepository(db *gorm.DB) repositories.OidcLoginStateRepository {
+	return &GormOidcLoginStateRepository{db: db}
+}
+
+func (r *GormOidcLoginStateRepository) Create(ctx context.Context, data entities.CreateOidcLoginStateData) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Abandoned logins are useless once expired, so prune as we go
+		if err := tx.Where("expires_at <= ?", time.Now()).Delete(&database.OidcLoginStateModel{}).Error; err != nil {
+			return err
+		}
+		m := &database.OidcLoginStateModel{
+			StateHash:    data.StateHash,
+			Provider:     data.Provider,
+			Nonce:        data.Nonce,
+			CodeVerifier: data.CodeVerifier,
+			ExpiresAt:    data.ExpiresAt,
+		}
+		return tx.Create(m).Error
+	})
+}
+
+func (r *GormOidcLoginStateRepository) Consume(ctx context.Context, stateHash string) (*entities.OidcLoginState, error) {
+	var models []database.OidcLoginStateModel
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("state_hash = ?", stateHash).
+		Delete(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	m := models[0]
+	return &entities.OidcLoginState{
+		ID:           m.ID,
+		StateHash:    m.StateHash,
+		Provider:     m.Provider,
+		Nonce:        m.Nonce,
+		CodeVerifier: m.CodeVerifier,
+		ExpiresAt:    m.ExpiresAt,
+		CreatedAt:    m.CreatedAt,
+	}, nil
+}
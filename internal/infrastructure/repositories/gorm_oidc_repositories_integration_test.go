@@ -0,0 +1,94 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOidcLoginStateRepo_CreateAndConsume_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormOidcLoginStateRepository(testDB)
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(10 * time.Minute)
+	require.NoError(t, repo.Create(ctx, entities.CreateOidcLoginStateData{
+		StateHash:    "state-hash",
+		Provider:     "google",
+		Nonce:        "nonce-1",
+		CodeVerifier: "verifier-1",
+		ExpiresAt:    expiresAt,
+	}))
+
+	state, err := repo.Consume(ctx, "state-hash")
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, "google", state.Provider)
+	assert.Equal(t, "nonce-1", state.Nonce)
+	assert.Equal(t, "verifier-1", state.CodeVerifier)
+	assert.WithinDuration(t, expiresAt, state.ExpiresAt, time.Second)
+
+	// A state can only be redeemed once
+	state, err = repo.Consume(ctx, "state-hash")
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestOidcLoginStateRepo_PrunesExpired_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormOidcLoginStateRepository(testDB)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, entities.CreateOidcLoginStateData{
+		StateHash: "old", Provider: "google", Nonce: "n", CodeVerifier: "v", ExpiresAt: time.Now().Add(-time.Minute),
+	}))
+	require.NoError(t, repo.Create(ctx, entities.CreateOidcLoginStateData{
+		StateHash: "new", Provider: "google", Nonce: "n", CodeVerifier: "v", ExpiresAt: time.Now().Add(time.Minute),
+	}))
+
+	state, err := repo.Consume(ctx, "old")
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestExternalIdentityRepo_CreateAndFind_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormExternalIdentityRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "oidc@example.com", "Open", "Id", "+33600000040")
+
+	created, err := repo.Create(ctx, entities.CreateExternalIdentityData{
+		Issuer: "https://accounts.example.com", Subject: "sub-1", AuthRefID: auth.RefID,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	found, err := repo.FindBySubject(ctx, "https://accounts.example.com", "sub-1")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, auth.RefID, found.AuthRefID)
+
+	// Subjects are scoped to their issuer
+	found, err = repo.FindBySubject(ctx, "https://other.example.com", "sub-1")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	// The same identity cannot be linked twice
+	_, err = repo.Create(ctx, entities.CreateExternalIdentityData{
+		Issuer: "https://accounts.example.com", Subject: "sub-1", AuthRefID: auth.RefID,
+	})
+	assert.Error(t, err)
+}
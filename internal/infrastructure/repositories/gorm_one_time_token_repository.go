@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormOneTimeTokenRepository struct{ db *gorm.DB }
+
+func NewGormOneTimeTokenRepository(db *gorm.DB) repositories.OneTimeTokenRepository {
+	return &GormOneTimeTokenRepository{db: db}
+}
+
+func (r *GormOneTimeTokenRepository) Create(ctx context.Context, data entities.CreateOneTimeTokenData) (*entities.OneTimeToken, error) {
+	m := &database.OneTimeTokenModel{
+		AuthRefID: data.AuthRefID,
+		Purpose:   data.Purpose,
+		TokenHash: data.TokenHash,
+		ExpiresAt: data.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toOneTimeTokenEntity(m), nil
+}
+
+func (r *GormOneTimeTokenRepository) FindByTokenHash(ctx context.Context, purpose, tokenHash string) (*entities.OneTimeToken, error) {
+	var m database.OneTimeTokenModel
+	if err := r.db.WithContext(ctx).Where("purpose = ? AND token_hash = ?", purpose, tokenHash).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toOneTimeTokenEntity(&m), nil
+}
+
+func (r *GormOneTimeTokenRepository) Consume(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.OneTimeTokenModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormOneTimeTokenRepository) InvalidateAll(ctx context.Context, authRefID int64, purpose string) error {
+	return r.db.WithContext(ctx).Model(&database.OneTimeTokenModel{}).
+		Where("auth_ref_id = ? AND purpose = ? AND used_at IS NULL", authRefID, purpose).
+		Update("used_at", time.Now()).Error
+}
+
+func (r *GormOneTimeTokenRepository) CountCreatedSince(ctx context.Context, authRefID int64, purpose string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&database.OneTimeTokenModel{}).
+		Where("auth_ref_id = ? AND purpose = ? AND created_at >= ?", authRefID, purpose, since).
+		Count(&count).Error
+	return count, err
+}
+
+func toOneTimeTokenEntity(m *database.OneTimeTokenModel) *entities.OneTimeToken {
+	return &entities.OneTimeToken{
+		ID:        m.ID,
+		AuthRefID: m.AuthRefID,
+		Purpose:   m.Purpose,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,113 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneTimeTokenRepo_CreateFindConsume_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormOneTimeTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "reset@example.com", "Re", "Set", "+33600000016")
+
+	created, err := repo.Create(ctx, entities.CreateOneTimeTokenData{
+		AuthRefID: auth.RefID,
+		Purpose:   entities.OneTimeTokenPasswordReset,
+		TokenHash: "reset-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Nil(t, created.UsedAt)
+
+	found, err := repo.FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, "reset-hash")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, created.ID, found.ID)
+
+	// Lookups are scoped by purpose
+	other, err := repo.FindByTokenHash(ctx, "OTHER", "reset-hash")
+	require.NoError(t, err)
+	assert.Nil(t, other)
+
+	consumed, err := repo.Consume(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, consumed)
+
+	consumed, err = repo.Consume(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, consumed, "a token can only be consumed once")
+}
+
+func TestOneTimeTokenRepo_InvalidateAll_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormOneTimeTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "invalidate@example.com", "In", "Valid", "+33600000017")
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		_, err := repo.Create(ctx, entities.CreateOneTimeTokenData{
+			AuthRefID: auth.RefID,
+			Purpose:   entities.OneTimeTokenPasswordReset,
+			TokenHash: hash,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, repo.InvalidateAll(ctx, auth.RefID, entities.OneTimeTokenPasswordReset))
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		token, err := repo.FindByTokenHash(ctx, entities.OneTimeTokenPasswordReset, hash)
+		require.NoError(t, err)
+		assert.True(t, token.IsUsed())
+	}
+}
+
+func TestOneTimeTokenRepo_CountCreatedSince_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormOneTimeTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "magic@example.com", "Ma", "Gic", "+33600000062")
+	before := time.Now().Add(-time.Minute)
+
+	for _, hash := range []string{"magic-1", "magic-2"} {
+		_, err := repo.Create(ctx, entities.CreateOneTimeTokenData{
+			AuthRefID: auth.RefID,
+			Purpose:   entities.OneTimeTokenMagicLink,
+			TokenHash: hash,
+			ExpiresAt: time.Now().Add(15 * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := repo.CountCreatedSince(ctx, auth.RefID, entities.OneTimeTokenMagicLink, before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Counts are scoped by purpose and time
+	count, err = repo.CountCreatedSince(ctx, auth.RefID, entities.OneTimeTokenPasswordReset, before)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = repo.CountCreatedSince(ctx, auth.RefID, entities.OneTimeTokenMagicLink, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormPasswordHistoryRepository struct{ db *gorm.DB }
+
+func NewGormPasswordHistoryRepository(db *gorm.DB) repositories.PasswordHistoryRepository {
+	return &GormPasswordHistoryRepository{db: db}
+}
+
+func (r *GormPasswordHistoryRepository) Add(ctx context.Context, authRefID int64, passwordHash string, keep int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if keep > 0 {
+			m := &database.PasswordHistoryModel{AuthRefID: authRefID, PasswordHash: passwordHash}
+			if err := tx.Create(m).Error; err != nil {
+				return err
+			}
+		}
+
+		// Prune everything but the newest keep entries
+		newest := tx.Model(&database.PasswordHistoryModel{}).
+			Select("id").
+			Where("auth_ref_id = ?", authRefID).
+			Order("created_at DESC, id DESC").
+			Limit(keep)
+		return tx.Where("auth_ref_id = ? AND id NOT IN (?)", authRefID, newest).
+			Delete(&database.PasswordHistoryModel{}).Error
+	})
+}
+
+func (r *GormPasswordHistoryRepository) FindRecent(ctx context.Context, authRefID int64, limit int) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	var hashes []string
+	err := r.db.WithContext(ctx).Model(&database.PasswordHistoryModel{}).
+		Where("auth_ref_id = ?", authRefID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
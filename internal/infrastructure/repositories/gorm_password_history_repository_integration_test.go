@@ -0,0 +1,45 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordHistoryRepo_AddAndFindRecent_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormPasswordHistoryRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "history@example.com", "Hist", "Ory", "+33600000030")
+	other, _ := createTestAuthAndUser(t, "other-history@example.com", "Other", "Ory", "+33600000031")
+
+	hashes, err := repo.FindRecent(ctx, auth.RefID, 5)
+	require.NoError(t, err)
+	assert.Empty(t, hashes)
+
+	require.NoError(t, repo.Add(ctx, auth.RefID, "hash-1", 2))
+	require.NoError(t, repo.Add(ctx, auth.RefID, "hash-2", 2))
+	require.NoError(t, repo.Add(ctx, auth.RefID, "hash-3", 2))
+	require.NoError(t, repo.Add(ctx, other.RefID, "other-hash", 2))
+
+	// Only the newest entries are kept, newest first
+	hashes, err = repo.FindRecent(ctx, auth.RefID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hash-3", "hash-2"}, hashes)
+
+	hashes, err = repo.FindRecent(ctx, auth.RefID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hash-3"}, hashes)
+
+	// Other accounts are untouched
+	hashes, err = repo.FindRecent(ctx, other.RefID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other-hash"}, hashes)
+}
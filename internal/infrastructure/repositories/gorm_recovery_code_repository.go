@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormRecoveryCodeRepository struct{ db *gorm.DB }
+
+func NewGormRecoveryCodeRepository(db *gorm.DB) repositories.RecoveryCodeRepository {
+	return &GormRecoveryCodeRepository{db: db}
+}
+
+func (r *GormRecoveryCodeRepository) ReplaceAll(ctx context.Context, authRefID int64, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("auth_ref_id = ?", authRefID).Delete(&database.RecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		if len(codeHashes) == 0 {
+			return nil
+		}
+		models := make([]database.RecoveryCodeModel, len(codeHashes))
+		for i, hash := range codeHashes {
+			models[i] = database.RecoveryCodeModel{AuthRefID: authRefID, CodeHash: hash}
+		}
+		return tx.Create(&models).Error
+	})
+}
+
+func (r *GormRecoveryCodeRepository) Consume(ctx context.Context, authRefID int64, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.RecoveryCodeModel{}).
+		Where("auth_ref_id = ? AND code_hash = ? AND used_at IS NULL", authRefID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
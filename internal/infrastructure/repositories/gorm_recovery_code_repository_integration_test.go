@@ -0,0 +1,41 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryCodeRepo_ReplaceAndConsume_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRecoveryCodeRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "recovery@example.com", "Reco", "Very", "+33600000020")
+
+	require.NoError(t, repo.ReplaceAll(ctx, auth.RefID, []string{"hash-1", "hash-2"}))
+
+	ok, err := repo.Consume(ctx, auth.RefID, "hash-1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// A code can only be used once
+	ok, err = repo.Consume(ctx, auth.RefID, "hash-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Replacing the codes discards the old ones
+	require.NoError(t, repo.ReplaceAll(ctx, auth.RefID, []string{"hash-3"}))
+	ok, err = repo.Consume(ctx, auth.RefID, "hash-2")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = repo.Consume(ctx, auth.RefID, "hash-3")
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormRefreshTokenRepository struct{ db *gorm.DB }
+
+func NewGormRefreshTokenRepository(db *gorm.DB) repositories.RefreshTokenRepository {
+	return &GormRefreshTokenRepository{db: db}
+}
+
+func (r *GormRefreshTokenRepository) Create(ctx context.Context, data entities.CreateRefreshTokenData) (*entities.RefreshToken, error) {
+	m := newRefreshTokenModel(data)
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	e := toRefreshTokenEntity(m)
+	return &e, nil
+}
+
+func (r *GormRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	var m database.RefreshTokenModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	e := toRefreshTokenEntity(&m)
+	return &e, nil
+}
+
+func (r *GormRefreshTokenRepository) Rotate(ctx context.Context, id string, data entities.CreateRefreshTokenData) (*entities.RefreshToken, error) {
+	var rotated *entities.RefreshToken
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the request that flips revoked_at wins; a concurrent replay sees zero rows
+		result := tx.Model(&database.RefreshTokenModel{}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		m := newRefreshTokenModel(data)
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&database.RefreshTokenModel{}).Where("id = ?", id).Update("replaced_by", m.ID).Error; err != nil {
+			return err
+		}
+
+		e := toRefreshTokenEntity(m)
+		rotated = &e
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return rotated, nil
+}
+
+func (r *GormRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).Model(&database.RefreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *GormRefreshTokenRepository) RevokeAllForAuth(ctx context.Context, authRefID int64) error {
+	return r.db.WithContext(ctx).Model(&database.RefreshTokenModel{}).
+		Where("auth_ref_id = ? AND revoked_at IS NULL", authRefID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func newRefreshTokenModel(data entities.CreateRefreshTokenData) *database.RefreshTokenModel {
+	familyID := data.FamilyID
+	if familyID == "" {
+		familyID = uuid.NewString()
+	}
+	return &database.RefreshTokenModel{
+		AuthRefID: data.AuthRefID,
+		FamilyID:  familyID,
+		TokenHash: data.TokenHash,
+		ExpiresAt: data.ExpiresAt,
+	}
+}
+
+func toRefreshTokenEntity(m *database.RefreshTokenModel) entities.RefreshToken {
+	return entities.RefreshToken{
+		ID:         m.ID,
+		AuthRefID:  m.AuthRefID,
+		FamilyID:   m.FamilyID,
+		TokenHash:  m.TokenHash,
+		ExpiresAt:  m.ExpiresAt,
+		RevokedAt:  m.RevokedAt,
+		ReplacedBy: m.ReplacedBy,
+		CreatedAt:  m.CreatedAt,
+	}
+}
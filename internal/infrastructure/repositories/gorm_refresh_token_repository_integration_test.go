@@ -0,0 +1,148 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokenRepo_CreateAndFind_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRefreshTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "refresh@example.com", "Re", "Fresh", "+33600000010")
+
+	created, err := repo.Create(ctx, entities.CreateRefreshTokenData{
+		AuthRefID: auth.RefID,
+		TokenHash: "hash-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.FamilyID, "empty FamilyID should start a new family")
+	assert.Nil(t, created.RevokedAt)
+
+	found, err := repo.FindByTokenHash(ctx, "hash-1")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Equal(t, auth.RefID, found.AuthRefID)
+
+	notFound, err := repo.FindByTokenHash(ctx, "unknown")
+	require.NoError(t, err)
+	assert.Nil(t, notFound)
+}
+
+func TestRefreshTokenRepo_Rotate_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRefreshTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "rotate@example.com", "Ro", "Tate", "+33600000011")
+
+	original, err := repo.Create(ctx, entities.CreateRefreshTokenData{
+		AuthRefID: auth.RefID,
+		TokenHash: "hash-original",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	next := entities.CreateRefreshTokenData{
+		AuthRefID: auth.RefID,
+		FamilyID:  original.FamilyID,
+		TokenHash: "hash-next",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	rotated, err := repo.Rotate(ctx, original.ID, next)
+	require.NoError(t, err)
+	require.NotNil(t, rotated)
+	assert.Equal(t, original.FamilyID, rotated.FamilyID)
+
+	old, err := repo.FindByTokenHash(ctx, "hash-original")
+	require.NoError(t, err)
+	require.NotNil(t, old)
+	assert.NotNil(t, old.RevokedAt)
+	require.NotNil(t, old.ReplacedBy)
+	assert.Equal(t, rotated.ID, *old.ReplacedBy)
+
+	// Rotating an already revoked token is a no-op
+	next.TokenHash = "hash-replay"
+	again, err := repo.Rotate(ctx, original.ID, next)
+	require.NoError(t, err)
+	assert.Nil(t, again)
+}
+
+func TestRefreshTokenRepo_RevokeFamily_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRefreshTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "family@example.com", "Fa", "Mily", "+33600000012")
+
+	first, err := repo.Create(ctx, entities.CreateRefreshTokenData{
+		AuthRefID: auth.RefID,
+		TokenHash: "hash-a",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	other, err := repo.Create(ctx, entities.CreateRefreshTokenData{
+		AuthRefID: auth.RefID,
+		TokenHash: "hash-b",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.RevokeFamily(ctx, first.FamilyID))
+
+	revoked, err := repo.FindByTokenHash(ctx, "hash-a")
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	untouched, err := repo.FindByTokenHash(ctx, "hash-b")
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, untouched.ID)
+	assert.Nil(t, untouched.RevokedAt)
+}
+
+func TestRefreshTokenRepo_RevokeAllForAuth_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRefreshTokenRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "all@example.com", "Al", "L", "+33600000014")
+	other, _ := createTestAuthAndUser(t, "other@example.com", "Ot", "Her", "+33600000015")
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		_, err := repo.Create(ctx, entities.CreateRefreshTokenData{AuthRefID: auth.RefID, TokenHash: hash, ExpiresAt: time.Now().Add(time.Hour)})
+		require.NoError(t, err)
+	}
+	_, err := repo.Create(ctx, entities.CreateRefreshTokenData{AuthRefID: other.RefID, TokenHash: "hash-other", ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.RevokeAllForAuth(ctx, auth.RefID))
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		token, err := repo.FindByTokenHash(ctx, hash)
+		require.NoError(t, err)
+		assert.NotNil(t, token.RevokedAt)
+	}
+	untouched, err := repo.FindByTokenHash(ctx, "hash-other")
+	require.NoError(t, err)
+	assert.Nil(t, untouched.RevokedAt)
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormRevokedTokenRepository struct{ db *gorm.DB }
+
+func NewGormRevokedTokenRepository(db *gorm.DB) repositories.RevokedTokenRepository {
+	return &GormRevokedTokenRepository{db: db}
+}
+
+func (r *GormRevokedTokenRepository) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Entries are useless once the token itself has expired, so prune as we go
+		if err := tx.Where("expires_at <= ?", now).Delete(&database.RevokedTokenModel{}).Error; err != nil {
+			return err
+		}
+		m := &database.RevokedTokenModel{TokenID: tokenID, ExpiresAt: expiresAt}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(m).Error
+	})
+}
+
+func (r *GormRevokedTokenRepository) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&database.RevokedTokenModel{}).
+		Where("token_id = ? AND expires_at > ?", tokenID, time.Now()).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
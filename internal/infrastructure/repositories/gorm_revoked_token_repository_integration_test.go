@@ -0,0 +1,55 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedTokenRepo_RevokeAndCheck_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRevokedTokenRepository(testDB)
+	ctx := context.Background()
+
+	revoked, err := repo.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, repo.Revoke(ctx, "jti-1", time.Now().Add(time.Hour)))
+	// Revoking twice is harmless
+	require.NoError(t, repo.Revoke(ctx, "jti-1", time.Now().Add(time.Hour)))
+
+	revoked, err = repo.IsRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestRevokedTokenRepo_ExpiredEntries_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRevokedTokenRepository(testDB)
+	ctx := context.Background()
+
+	// Already-expired tokens are not recorded at all
+	require.NoError(t, repo.Revoke(ctx, "jti-expired", time.Now().Add(-time.Minute)))
+	revoked, err := repo.IsRevoked(ctx, "jti-expired")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Stale rows are pruned on the next revocation
+	require.NoError(t, testDB.Create(&database.RevokedTokenModel{TokenID: "jti-stale", ExpiresAt: time.Now().Add(-time.Hour)}).Error)
+	require.NoError(t, repo.Revoke(ctx, "jti-fresh", time.Now().Add(time.Hour)))
+
+	var count int64
+	require.NoError(t, testDB.Model(&database.RevokedTokenModel{}).Where("token_id = ?", "jti-stale").Count(&count).Error)
+	assert.Zero(t, count)
+}
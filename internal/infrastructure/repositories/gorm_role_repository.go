@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormRoleRepository struct{ db *gorm.DB }
+
+func NewGormRoleRepository(db *gorm.DB) repositories.RoleRepository {
+	return &GormRoleRepository{db: db}
+}
+
+func (r *GormRoleRepository) FindByName(ctx context.Context, name string) (*entities.Role, error) {
+	var m database.RoleModel
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toRoleEntity(&m), nil
+}
+
+func toRoleEntity(m *database.RoleModel) *entities.Role {
+	var permissions []string
+	if m.Permissions != "" {
+		permissions = strings.Split(m.Permissions, ",")
+	}
+	return &entities.Role{
+		Name:        m.Name,
+		Description: m.Description,
+		Permissions: permissions,
+	}
+}
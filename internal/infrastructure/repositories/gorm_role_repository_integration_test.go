@@ -0,0 +1,36 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleRepo_FindByName_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormRoleRepository(testDB)
+	ctx := context.Background()
+
+	require.NoError(t, testDB.Create(&database.RoleModel{
+		Name:        "SUPPORT",
+		Description: "Reads accounts to answer tickets",
+		Permissions: "profile:read,users:read",
+	}).Error)
+
+	role, err := repo.FindByName(ctx, "SUPPORT")
+	require.NoError(t, err)
+	require.NotNil(t, role)
+	assert.Equal(t, "Reads accounts to answer tickets", role.Description)
+	assert.Equal(t, []string{"profile:read", "users:read"}, role.Permissions)
+
+	missing, err := repo.FindByName(ctx, "NOBODY")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
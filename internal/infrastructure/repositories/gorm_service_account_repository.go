@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormServiceAccountRepository struct{ db *gorm.DB }
+
+func NewGormServiceAccountRepository(db *gorm.DB) repositories.ServiceAccountRepository {
+	return &GormServiceAccountRepository{db: db}
+}
+
+func (r *GormServiceAccountRepository) Create(ctx context.Context, data entities.CreateServiceAccountData) (*entities.ServiceAccount, error) {
+	m := &database.ServiceAccountModel{Name: data.Name, Role: data.Role}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toServiceAccountEntity(m), nil
+}
+
+func (r *GormServiceAccountRepository) FindByID(ctx context.Context, id string) (*entities.ServiceAccount, error) {
+	var m database.ServiceAccountModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toServiceAccountEntity(&m), nil
+}
+
+func (r *GormServiceAccountRepository) FindAll(ctx context.Context) ([]entities.ServiceAccount, error) {
+	var models []database.ServiceAccountModel
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	accounts := make([]entities.ServiceAccount, len(models))
+	for i := range models {
+		accounts[i] = *toServiceAccountEntity(&models[i])
+	}
+	return accounts, nil
+}
+
+func toServiceAccountEntity(m *database.ServiceAccountModel) *entities.ServiceAccount {
+	return &entities.ServiceAccount{ID: m.ID, Name: m.Name, Role: m.Role, CreatedAt: m.CreatedAt}
+}
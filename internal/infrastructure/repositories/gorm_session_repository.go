@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormSessionRepository struct{ db *gorm.DB }
+
+func NewGormSessionRepository(db *gorm.DB) repositories.SessionRepository {
+	return &GormSessionRepository{db: db}
+}
+
+func (r *GormSessionRepository) Create(ctx context.Context, data entities.CreateSessionData) (*entities.Session, error) {
+	m := &database.SessionModel{
+		AuthRefID:  data.AuthRefID,
+		UserAgent:  data.Client.UserAgent,
+		IPAddress:  data.Client.IPAddress,
+		LastSeenAt: time.Now(),
+		ExpiresAt:  data.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	e := toSessionEntity(m)
+	return &e, nil
+}
+
+func (r *GormSessionRepository) FindByID(ctx context.Context, id string) (*entities.Session, error) {
+	var m database.SessionModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	e := toSessionEntity(&m)
+	return &e, nil
+}
+
+func (r *GormSessionRepository) FindActiveByAuthRefID(ctx context.Context, authRefID int64) ([]entities.Session, error) {
+	var models []database.SessionModel
+	err := r.db.WithContext(ctx).
+		Where("auth_ref_id = ? AND revoked_at IS NULL AND expires_at > ?", authRefID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]entities.Session, len(models))
+	for i := range models {
+		sessions[i] = toSessionEntity(&models[i])
+	}
+	return sessions, nil
+}
+
+func (r *GormSessionRepository) Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&database.SessionModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{"last_seen_at": lastSeenAt, "expires_at": expiresAt}).Error
+}
+
+func (r *GormSessionRepository) Revoke(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.SessionModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormSessionRepository) RevokeAllForAuth(ctx context.Context, authRefID int64) ([]string, error) {
+	var revoked []database.SessionModel
+	err := r.db.WithContext(ctx).Model(&revoked).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("auth_ref_id = ? AND revoked_at IS NULL", authRefID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(revoked))
+	for i := range revoked {
+		ids[i] = revoked[i].ID
+	}
+	return ids, nil
+}
+
+func toSessionEntity(m *database.SessionModel) entities.Session {
+	return entities.Session{
+		ID:         m.ID,
+		AuthRefID:  m.AuthRefID,
+		UserAgent:  m.UserAgent,
+		IPAddress:  m.IPAddress,
+		CreatedAt:  m.CreatedAt,
+		LastSeenAt: m.LastSeenAt,
+		ExpiresAt:  m.ExpiresAt,
+		RevokedAt:  m.RevokedAt,
+	}
+}
@@ -0,0 +1,92 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRepo_CreateFindAndTouch_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormSessionRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "session@example.com", "Ses", "Sion", "+33600000060")
+
+	created, err := repo.Create(ctx, entities.CreateSessionData{
+		AuthRefID: auth.RefID,
+		Client:    entities.SessionClient{UserAgent: "Firefox", IPAddress: "203.0.113.7"},
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "Firefox", created.UserAgent)
+	assert.Equal(t, "203.0.113.7", created.IPAddress)
+	assert.False(t, created.LastSeenAt.IsZero())
+
+	seenAt := time.Now().Add(time.Minute).Truncate(time.Microsecond)
+	expiresAt := time.Now().Add(2 * time.Hour).Truncate(time.Microsecond)
+	require.NoError(t, repo.Touch(ctx, created.ID, seenAt, expiresAt))
+
+	found, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.True(t, seenAt.Equal(found.LastSeenAt))
+	assert.True(t, expiresAt.Equal(found.ExpiresAt))
+
+	notFound, err := repo.FindByID(ctx, "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	assert.Nil(t, notFound)
+}
+
+func TestSessionRepo_FindActiveAndRevoke_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormSessionRepository(testDB)
+	ctx := context.Background()
+
+	auth, _ := createTestAuthAndUser(t, "sessions@example.com", "Ses", "Sions", "+33600000061")
+	create := func(expiresAt time.Time) *entities.Session {
+		s, err := repo.Create(ctx, entities.CreateSessionData{AuthRefID: auth.RefID, ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		return s
+	}
+	first := create(time.Now().Add(time.Hour))
+	second := create(time.Now().Add(time.Hour))
+	create(time.Now().Add(-time.Minute))
+
+	active, err := repo.FindActiveByAuthRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Len(t, active, 2, "expired sessions are not listed")
+
+	revoked, err := repo.Revoke(ctx, first.ID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = repo.Revoke(ctx, first.ID)
+	require.NoError(t, err)
+	assert.False(t, revoked, "revoking twice reports the second call")
+
+	active, err = repo.FindActiveByAuthRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, second.ID, active[0].ID)
+
+	// The expired session is still unrevoked and is revoked with the rest
+	ids, err := repo.RevokeAllForAuth(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Len(t, ids, 2)
+	assert.Contains(t, ids, second.ID)
+
+	active, err = repo.FindActiveByAuthRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
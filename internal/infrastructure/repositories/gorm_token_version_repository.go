@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+type GormTokenVersionRepository struct{ db *gorm.DB }
+
+func NewGormTokenVersionRepository(db *gorm.DB) repositories.TokenVersionRepository {
+	return &GormTokenVersionRepository{db: db}
+}
+
+func (r *GormTokenVersionRepository) CurrentState(ctx context.Context, userID string) (*entities.TokenState, error) {
+	var models []database.AuthModel
+	err := r.db.WithContext(ctx).Model(&database.AuthModel{}).
+		Select("auths.token_version", "auths.suspended_until", "auths.banned_at").
+		Joins("JOIN users ON users.auth_ref_id = auths.ref_id").
+		Where("users.id = ?", userID).
+		Limit(1).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return &entities.TokenState{
+		Version: models[0].TokenVersion,
+		AccountStatus: entities.AccountStatus{
+			SuspendedUntil: models[0].SuspendedUntil,
+			BannedAt:       models[0].BannedAt,
+		},
+	}, nil
+}
+
+// Invalidate is a no-op: every lookup reads the database
+func (r *GormTokenVersionRepository) Invalidate(ctx context.Context, userID string) error {
+	return nil
+}
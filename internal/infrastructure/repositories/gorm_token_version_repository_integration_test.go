@@ -0,0 +1,59 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenVersionRepo_CurrentState_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	authRepo := NewGormAuthRepository(testDB, testSecretCipher)
+	repo := NewGormTokenVersionRepository(testDB)
+	ctx := context.Background()
+
+	auth, user, err := authRepo.CreateWithUser(ctx,
+		entities.CreateAuthData{Email: "version@example.com", Password: "hashed"},
+		entities.CreateUserData{},
+	)
+	require.NoError(t, err)
+
+	state, err := repo.CurrentState(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, int64(0), state.Version)
+
+	// Changing the role bumps the version
+	require.NoError(t, authRepo.UpdateRole(ctx, auth.RefID, "DRIVER"))
+	require.NoError(t, repo.Invalidate(ctx, user.ID))
+
+	state, err = repo.CurrentState(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, int64(1), state.Version)
+
+	updated, err := authRepo.FindByRefID(ctx, auth.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, "DRIVER", updated.Role)
+	assert.Equal(t, int64(1), updated.TokenVersion)
+
+	// The moderation state is read along with the version
+	require.NoError(t, authRepo.Ban(ctx, auth.RefID, "fraud"))
+	state, err = repo.CurrentState(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.True(t, state.IsBanned())
+
+	// Unknown users are reported as not found
+	state, err = repo.CurrentState(ctx, uuid.NewString())
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
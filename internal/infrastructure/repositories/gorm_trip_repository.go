@@ -2,15 +2,21 @@ package repositories
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/domain/repositories"
 	"github.com/lgxju/gogretago/internal/infrastructure/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormTripRepository struct{ db *gorm.DB }
 
+// earthRadiusKm is the mean radius of the Earth used for distances between cities
+const earthRadiusKm = 6371
+
 func NewGormTripRepository(db *gorm.DB) repositories.TripRepository {
 	return &GormTripRepository{db: db}
 }
@@ -27,6 +33,9 @@ func (r *GormTripRepository) FindAll(ctx context.Context, skip, take int) ([]ent
 	for i, m := range models {
 		result[i] = toTripEntity(&m)
 	}
+	if err := r.loadStops(ctx, result); err != nil {
+		return nil, 0, err
+	}
 	return result, int(total), nil
 }
 
@@ -38,21 +47,81 @@ func (r *GormTripRepository) FindByID(ctx context.Context, id string) (*entities
 		}
 		return nil, err
 	}
-	e := toTripEntity(&m)
-	return &e, nil
+	return r.withStops(ctx, &m)
+}
+
+func (r *GormTripRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Trip, error) {
+	var m database.TripModel
+	if err := r.db.WithContext(ctx).Where("ref_id = ?", refID).First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.withStops(ctx, &m)
 }
 
 func (r *GormTripRepository) FindByFilters(ctx context.Context, filters entities.TripFilters) ([]entities.Trip, error) {
 	query := r.db.WithContext(ctx).Model(&database.TripModel{})
 
-	if filters.DepartureCity != nil {
-		query = query.Where("ref_id IN (SELECT trip_ref_id FROM city_trips ct JOIN cities c ON c.ref_id = ct.city_ref_id WHERE ct.type = 'DEPARTURE' AND c.city_name = ?)", *filters.DepartureCity)
-	}
-	if filters.ArrivalCity != nil {
-		query = query.Where("ref_id IN (SELECT trip_ref_id FROM city_trips ct JOIN cities c ON c.ref_id = ct.city_ref_id WHERE ct.type = 'ARRIVAL' AND c.city_name = ?)", *filters.ArrivalCity)
+	// Passengers can get on at any stop but the arrival and off at any stop after the one they got on
+	// at. Each pair of stops matching the search is kept with how far its stops are from the searched
+	// points, and a trip with the closest of its pairs.
+	near := filters.DepartureArea != nil || filters.ArrivalArea != nil
+	if near || filters.DepartureCity != nil || filters.ArrivalCity != nil {
+		boarding := matchStop("dc", filters.DepartureCity, filters.DepartureArea)
+		alighting := matchStop("ac", filters.ArrivalCity, filters.ArrivalArea)
+		args := append(append([]interface{}{}, boarding.distanceArgs...), alighting.distanceArgs...)
+		args = append(append(args, boarding.args...), alighting.args...)
+		query = query.Joins(`JOIN (SELECT d.trip_ref_id, MIN(`+boarding.distance+` + `+alighting.distance+`) AS distance
+			FROM city_trips d
+			JOIN cities dc ON dc.ref_id = d.city_ref_id
+			JOIN city_trips a ON a.trip_ref_id = d.trip_ref_id AND a.position > d.position
+			JOIN cities ac ON ac.ref_id = a.city_ref_id
+			WHERE `+boarding.condition+` AND `+alighting.condition+`
+			GROUP BY d.trip_ref_id) route ON route.trip_ref_id = trips.ref_id`, args...)
 	}
+	// Days and departure windows are those of the clock in the trip's own time zone
 	if filters.Date != nil {
-		query = query.Where("DATE(date_trip) = DATE(?)", *filters.Date)
+		query = query.Where("DATE(date_trip AT TIME ZONE time_zone) = ?", filters.Date.Format("2006-01-02"))
+	}
+	localTime := "CAST(date_trip AT TIME ZONE time_zone AS time)"
+	switch after, before := filters.DepartureAfter, filters.DepartureBefore; {
+	case after != nil && before != nil && *after > *before:
+		query = query.Where("("+localTime+" >= CAST(? AS time) OR "+localTime+" <= CAST(? AS time))", *after, *before)
+	case after != nil && before != nil:
+		query = query.Where(localTime+" BETWEEN CAST(? AS time) AND CAST(? AS time)", *after, *before)
+	case after != nil:
+		query = query.Where(localTime+" >= CAST(? AS time)", *after)
+	case before != nil:
+		query = query.Where(localTime+" <= CAST(? AS time)", *before)
+	}
+	if len(filters.Statuses) > 0 {
+		query = query.Where("status IN ?", filters.Statuses)
+	}
+	if filters.MinPrice != nil {
+		query = query.Where("price_per_seat >= ?", *filters.MinPrice)
+	}
+	if filters.MaxPrice != nil {
+		query = query.Where("price_per_seat <= ?", *filters.MaxPrice)
+	}
+	// Upcoming trips of suspended or banned drivers are left out of search. This only hides them: they
+	// can still be looked up and booked by ID
+	query = query.Where(`(date_trip <= NOW() OR driver_ref_id NOT IN (SELECT d.ref_id FROM drivers d
+		JOIN users u ON u.ref_id = d.user_ref_id
+		JOIN auths a ON a.ref_id = u.auth_ref_id
+		WHERE a.banned_at IS NOT NULL OR a.suspended_until > NOW()))`)
+
+	// Trips at the same price or distance are listed by departure
+	switch {
+	case filters.Sort == entities.TripSortDistance && near:
+		query = query.Order("route.distance").Order("date_trip")
+	case filters.Sort == entities.TripSortPrice:
+		query = query.Order("price_per_seat").Order("date_trip")
+	case filters.Sort == entities.TripSortPriceDesc:
+		query = query.Order("price_per_seat DESC").Order("date_trip")
+	default:
+		query = query.Order("date_trip")
 	}
 
 	var models []database.TripModel
@@ -63,44 +132,303 @@ func (r *GormTripRepository) FindByFilters(ctx context.Context, filters entities
 	for i, m := range models {
 		result[i] = toTripEntity(&m)
 	}
+	if err := r.loadStops(ctx, result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// stopMatch is the condition on the city of a stop, joined as alias, and its distance in km to the
+// searched point, zero when the search is not around a point
+type stopMatch struct {
+	condition    string
+	args         []interface{}
+	distance     string
+	distanceArgs []interface{}
+}
+
+// matchStop matches a stop within the area when there is one, by city name otherwise, and any stop
+// without either
+func matchStop(alias string, cityName *string, area *entities.GeoArea) stopMatch {
+	switch {
+	case area != nil:
+		distance := haversineKm(alias)
+		point := []interface{}{area.Latitude, area.Latitude, area.Longitude}
+		return stopMatch{
+			condition:    distance + " <= ?",
+			args:         append(append([]interface{}{}, point...), area.RadiusKm),
+			distance:     distance,
+			distanceArgs: point,
+		}
+	case cityName != nil:
+		return stopMatch{condition: alias + ".city_name = ?", args: []interface{}{*cityName}, distance: "0"}
+	}
+	return stopMatch{condition: "TRUE", distance: "0"}
+}
+
+// haversineKm is the great-circle distance in km from the city joined as alias to a point, bound to
+// the point's latitude, latitude again and longitude. Cities without coordinates have no distance.
+func haversineKm(alias string) string {
+	return fmt.Sprintf(`(%[2]d * 2 * ASIN(LEAST(1, SQRT(
+		POWER(SIN(RADIANS(%[1]s.latitude - ?) / 2), 2) +
+		COS(RADIANS(?)) * COS(RADIANS(%[1]s.latitude)) * POWER(SIN(RADIANS(%[1]s.longitude - ?) / 2), 2)))))`,
+		alias, earthRadiusKm)
+}
+
 func (r *GormTripRepository) Create(ctx context.Context, data entities.CreateTripData) (*entities.Trip, error) {
-	var trip *entities.Trip
+	var m *database.TripModel
+	exists := false
 
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		m := &database.TripModel{
-			DateTrip:    data.DateTrip,
-			Kms:         data.Kms,
-			Seats:       data.Seats,
-			DriverRefID: data.DriverRefID,
-			CarRefID:    data.CarRefID,
-		}
-		if err := tx.Create(m).Error; err != nil {
-			return err
+		m = &database.TripModel{
+			DateTrip:         data.DateTrip,
+			TimeZone:         data.TimeZone,
+			EstimatedArrival: &data.EstimatedArrival,
+			Kms:              data.Kms,
+			Seats:            data.Seats,
+			PricePerSeat:     data.PricePerSeat,
+			Currency:         data.Currency,
+			DriverRefID:      data.DriverRefID,
+			CarRefID:         data.CarRefID,
+			Status:           entities.TripStatusScheduled,
+			SeriesRefID:      data.SeriesRefID,
+			OccurrenceDate:   data.OccurrenceDate,
+		}
+		// An occurrence already materialized by an earlier run is left as it is
+		create := tx
+		if data.SeriesRefID != nil {
+			create = tx.Clauses(clause.OnConflict{DoNothing: true})
+		}
+		result := create.Create(m)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			exists = true
+			return nil
 		}
 
-		// Create city-trip associations: first is DEPARTURE, second is ARRIVAL
-		if len(data.CityRefIDs) >= 2 {
-			cityTrips := []database.CityTripModel{
-				{TripRefID: m.RefID, CityRefID: data.CityRefIDs[0], Type: "DEPARTURE"},
-				{TripRefID: m.RefID, CityRefID: data.CityRefIDs[1], Type: "ARRIVAL"},
+		// Create the stops in route order: first is DEPARTURE, last is ARRIVAL
+		if len(data.Stops) >= 2 {
+			cityTrips := make([]database.CityTripModel, len(data.Stops))
+			for i, stop := range data.Stops {
+				stopType := entities.TripStopWaypoint
+				switch i {
+				case 0:
+					stopType = entities.TripStopDeparture
+				case len(data.Stops) - 1:
+					stopType = entities.TripStopArrival
+				}
+				cityTrips[i] = database.CityTripModel{
+					TripRefID: m.RefID, CityRefID: stop.CityRefID,
+					Type: stopType, Position: i, StopTime: stop.Time,
+				}
 			}
 			if err := tx.Create(&cityTrips).Error; err != nil {
 				return err
 			}
 		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, nil
+	}
+	return r.withStops(ctx, m)
+}
+
+func (r *GormTripRepository) FindUpcomingBySeries(ctx context.Context, seriesRefID int64) ([]entities.Trip, error) {
+	var models []database.TripModel
+	if err := r.db.WithContext(ctx).
+		Where("series_ref_id = ? AND status IN ? AND date_trip > NOW()", seriesRefID,
+			[]string{entities.TripStatusScheduled, entities.TripStatusFull}).
+		Order("date_trip").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	result := make([]entities.Trip, len(models))
+	for i, m := range models {
+		result[i] = toTripEntity(&m)
+	}
+	if err := r.loadStops(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *GormTripRepository) Update(ctx context.Context, id string, data entities.UpdateTripData) (*entities.Trip, error) {
+	updated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Locked so no booking commits between counting the seats taken and saving the change
+		var m database.TripModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&m).Error; err != nil {
+			return err
+		}
+		if data.Seats != nil {
+			resized, err := resizeSeats(tx, &m, *data.Seats)
+			if err != nil || !resized {
+				return err
+			}
+		}
+		updated = true
+
+		updates := map[string]interface{}{}
+		if data.DateTrip != nil {
+			updates["date_trip"] = *data.DateTrip
+		}
+		if data.EstimatedArrival != nil {
+			updates["estimated_arrival"] = *data.EstimatedArrival
+		}
+		if data.TimeZone != nil {
+			updates["time_zone"] = *data.TimeZone
+		}
+		if data.Kms != nil {
+			updates["kms"] = *data.Kms
+		}
+		if data.PricePerSeat != nil {
+			updates["price_per_seat"] = *data.PricePerSeat
+		}
+		if data.CarRefID != nil {
+			updates["car_ref_id"] = *data.CarRefID
+		}
+		if data.SeriesOverridden != nil {
+			updates["series_overridden"] = *data.SeriesOverridden
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(&m).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
 
-		e := toTripEntity(m)
-		trip = &e
+		if data.DepartureCityRefID != nil {
+			if err := tx.Model(&database.CityTripModel{}).
+				Where("trip_ref_id = ? AND type = ?", m.RefID, entities.TripStopDeparture).
+				Update("city_ref_id", *data.DepartureCityRefID).Error; err != nil {
+				return err
+			}
+		}
+		if data.ArrivalCityRefID != nil {
+			if err := tx.Model(&database.CityTripModel{}).
+				Where("trip_ref_id = ? AND type = ?", m.RefID, entities.TripStopArrival).
+				Update("city_ref_id", *data.ArrivalCityRefID).Error; err != nil {
+				return err
+			}
+		}
+		if data.StopShift != 0 {
+			if err := tx.Model(&database.CityTripModel{}).
+				Where("trip_ref_id = ? AND stop_time IS NOT NULL", m.RefID).
+				Update("stop_time", gorm.Expr("stop_time + make_interval(secs => ?)", data.StopShift.Seconds())).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(data.Changes) > 0 {
+			changes := make([]database.TripChangeModel, len(data.Changes))
+			for i, c := range data.Changes {
+				changes[i] = database.TripChangeModel{TripRefID: m.RefID, Field: c.Field, OldValue: c.OldValue, NewValue: c.NewValue}
+			}
+			if err := tx.Create(&changes).Error; err != nil {
+				return err
+			}
+		}
+		if data.ReconfirmBookings {
+			return tx.Model(&database.InscriptionModel{}).
+				Where("trip_ref_id = ? AND status = ?", m.RefID, entities.InscriptionStatusActive).
+				Update("status", entities.InscriptionStatusPendingConfirmation).Error
+		}
 		return nil
 	})
+	if err != nil || !updated {
+		return nil, err
+	}
+	return r.FindByID(ctx, id)
+}
 
+// resizeSeats gives the locked trip the number of seats and moves it between SCHEDULED and FULL to
+// match its bookings. It returns false, changing nothing, when fewer seats would be left than are
+// already booked.
+func resizeSeats(tx *gorm.DB, m *database.TripModel, seats int) (bool, error) {
+	trip := toTripEntity(m)
+	var positions []int
+	if err := tx.Model(&database.CityTripModel{}).Where("trip_ref_id = ?", m.RefID).
+		Order("position").Pluck("position", &positions).Error; err != nil {
+		return false, err
+	}
+	for _, position := range positions {
+		trip.Stops = append(trip.Stops, entities.TripStop{Position: position})
+	}
+	bookings, err := findTripBookings(tx, m.RefID)
 	if err != nil {
+		return false, err
+	}
+	if trip.SeatsTaken(bookings) > seats {
+		return false, nil
+	}
+
+	trip.Seats = seats
+	updates := map[string]interface{}{"seats": seats}
+	if next := trip.StatusForBookings(bookings); next != m.Status {
+		updates["status"] = next
+	}
+	return true, tx.Model(m).Updates(updates).Error
+}
+
+func (r *GormTripRepository) FindChanges(ctx context.Context, tripRefID int64) ([]entities.TripChange, error) {
+	var models []database.TripChangeModel
+	if err := r.db.WithContext(ctx).Where("trip_ref_id = ?", tripRefID).Order("created_at").Find(&models).Error; err != nil {
 		return nil, err
 	}
-	return trip, nil
+	result := make([]entities.TripChange, len(models))
+	for i, m := range models {
+		result[i] = entities.TripChange{
+			ID: m.ID, TripRefID: m.TripRefID,
+			Field: m.Field, OldValue: m.OldValue, NewValue: m.NewValue,
+			CreatedAt: m.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *GormTripRepository) UpdateStatus(ctx context.Context, id string, from, to string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&database.TripModel{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *GormTripRepository) Cancel(ctx context.Context, id string, reason string) (bool, error) {
+	cancelled := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var m database.TripModel
+		if err := tx.Where("id = ?", id).First(&m).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+		// The update locks the trip until the bookings are cancelled, so a booking made meanwhile
+		// either is cancelled along with the others or sees the trip cancelled
+		result := tx.Model(&m).
+			Where("status IN ?", []string{entities.TripStatusScheduled, entities.TripStatusFull}).
+			Updates(map[string]interface{}{
+				"status":              entities.TripStatusCancelled,
+				"cancellation_reason": reason,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		cancelled = true
+		return tx.Model(&database.InscriptionModel{}).
+			Where("trip_ref_id = ? AND status IN ?", m.RefID, entities.SeatHoldingStatuses).
+			Update("status", entities.InscriptionStatusCancelledByDriver).Error
+	})
+	return cancelled, err
 }
 
 func (r *GormTripRepository) Delete(ctx context.Context, id string) error {
@@ -109,18 +437,81 @@ func (r *GormTripRepository) Delete(ctx context.Context, id string) error {
 		if err := tx.Where("id = ?", id).First(&m).Error; err != nil {
 			return err
 		}
-		// Delete city-trip associations first
+		// Delete city-trip associations and the trip's history first
 		if err := tx.Where("trip_ref_id = ?", m.RefID).Delete(&database.CityTripModel{}).Error; err != nil {
 			return err
 		}
+		if err := tx.Where("trip_ref_id = ?", m.RefID).Delete(&database.TripChangeModel{}).Error; err != nil {
+			return err
+		}
 		return tx.Where("id = ?", id).Delete(&database.TripModel{}).Error
 	})
 }
 
+// withStops converts a trip model and loads its stops
+func (r *GormTripRepository) withStops(ctx context.Context, m *database.TripModel) (*entities.Trip, error) {
+	trips := []entities.Trip{toTripEntity(m)}
+	if err := r.loadStops(ctx, trips); err != nil {
+		return nil, err
+	}
+	return &trips[0], nil
+}
+
+// loadStops fills in the stops of the given trips with a single query, then renders their times
+// in each trip's time zone
+func (r *GormTripRepository) loadStops(ctx context.Context, trips []entities.Trip) error {
+	if len(trips) == 0 {
+		return nil
+	}
+	refIDs := make([]int64, len(trips))
+	for i, t := range trips {
+		refIDs[i] = t.RefID
+	}
+
+	var rows []struct {
+		TripRefID int64
+		CityRefID int64
+		CityName  string
+		Position  int
+		Type      string
+		StopTime  *time.Time
+	}
+	if err := r.db.WithContext(ctx).Table("city_trips ct").
+		Select("ct.trip_ref_id, ct.city_ref_id, c.city_name, ct.position, ct.type, ct.stop_time").
+		Joins("JOIN cities c ON c.ref_id = ct.city_ref_id").
+		Where("ct.trip_ref_id IN ?", refIDs).
+		Order("ct.trip_ref_id, ct.position").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	stops := make(map[int64][]entities.TripStop, len(trips))
+	for _, row := range rows {
+		stops[row.TripRefID] = append(stops[row.TripRefID], entities.TripStop{
+			CityRefID: row.CityRefID, CityName: row.CityName,
+			Position: row.Position, Type: row.Type, Time: row.StopTime,
+		})
+	}
+	for i := range trips {
+		trips[i].Stops = stops[trips[i].RefID]
+		trips[i].Localize()
+	}
+	return nil
+}
+
 func toTripEntity(m *database.TripModel) entities.Trip {
-	return entities.Trip{
+	e := entities.Trip{
 		ID: m.ID, RefID: m.RefID,
-		DateTrip: m.DateTrip, Kms: m.Kms, Seats: m.Seats,
+		DateTrip: m.DateTrip, TimeZone: m.TimeZone, Kms: m.Kms, Seats: m.Seats,
+		PricePerSeat: m.PricePerSeat, Currency: m.Currency,
 		DriverRefID: m.DriverRefID, CarRefID: m.CarRefID,
+		Status: m.Status, CancellationReason: m.CancellationReason,
+		SeriesRefID: m.SeriesRefID, OccurrenceDate: m.OccurrenceDate, SeriesOverridden: m.SeriesOverridden,
+	}
+	if m.EstimatedArrival != nil {
+		e.EstimatedArrival = *m.EstimatedArrival
+	} else {
+		e.EstimatedArrival = entities.EstimateArrival(m.DateTrip, m.Kms)
 	}
+	return e
 }
@@ -58,7 +58,7 @@ func TestTripRepo_CRUD_Integration(t *testing.T) {
 		Seats:       3,
 		DriverRefID: driverRefID,
 		CarRefID:    carRefID,
-		CityRefIDs:  []int64{departureCityRefID, arrivalCityRefID},
+		Stops:       []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
 	})
 	require.NoError(t, err)
 	require.NotNil(t, trip)
@@ -88,10 +88,22 @@ func TestTripRepo_CRUD_Integration(t *testing.T) {
 	assert.Equal(t, 1, total)
 	assert.Len(t, trips, 1)
 
-	// Delete (also deletes city_trips associations)
+	// Update records the change in the trip's history
+	seats := 4
+	_, err = repo.Update(ctx, trip.ID, entities.UpdateTripData{
+		Seats:   &seats,
+		Changes: []entities.CreateTripChangeData{{Field: entities.TripChangeSeats, OldValue: "3", NewValue: "4"}},
+	})
+	require.NoError(t, err)
+
+	// Delete (also deletes city_trips associations and trip changes)
 	err = repo.Delete(ctx, trip.ID)
 	require.NoError(t, err)
 
+	changes, err := repo.FindChanges(ctx, trip.RefID)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
 	// Verify deleted
 	deleted, err := repo.FindByID(ctx, trip.ID)
 	require.NoError(t, err)
@@ -101,3 +113,286 @@ func TestTripRepo_CRUD_Integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, total)
 }
+
+func TestTripRepo_FindByFilters_HidesSuspendedDrivers_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	_, err := repo.Create(ctx, entities.CreateTripData{
+		DateTrip:    time.Now().Add(48 * time.Hour),
+		Kms:         450,
+		Seats:       3,
+		DriverRefID: driverRefID,
+		CarRefID:    carRefID,
+		Stops:       []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
+	})
+	require.NoError(t, err)
+
+	departure := "Paris"
+	trips, err := repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &departure})
+	require.NoError(t, err)
+	assert.Len(t, trips, 1)
+
+	require.NoError(t, testDB.Exec("UPDATE auths SET suspended_until = ? WHERE email = ?",
+		time.Now().Add(24*time.Hour), "trip-driver@example.com").Error)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &departure})
+	require.NoError(t, err)
+	assert.Empty(t, trips)
+}
+
+func TestTripRepo_Status_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	trip, err := repo.Create(ctx, entities.CreateTripData{
+		DateTrip:    time.Now().Add(48 * time.Hour),
+		Kms:         450,
+		Seats:       3,
+		DriverRefID: driverRefID,
+		CarRefID:    carRefID,
+		Stops:       []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripStatusScheduled, trip.Status)
+
+	bookable := []string{entities.TripStatusScheduled}
+	trips, err := repo.FindByFilters(ctx, entities.TripFilters{Statuses: bookable})
+	require.NoError(t, err)
+	assert.Len(t, trips, 1)
+
+	moved, err := repo.UpdateStatus(ctx, trip.ID, entities.TripStatusScheduled, entities.TripStatusFull)
+	require.NoError(t, err)
+	assert.True(t, moved)
+
+	moved, err = repo.UpdateStatus(ctx, trip.ID, entities.TripStatusScheduled, entities.TripStatusCancelled)
+	require.NoError(t, err)
+	assert.False(t, moved)
+
+	found, err := repo.FindByRefID(ctx, trip.RefID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, entities.TripStatusFull, found.Status)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{Statuses: bookable})
+	require.NoError(t, err)
+	assert.Empty(t, trips)
+
+	notFound, err := repo.FindByRefID(ctx, 0)
+	require.NoError(t, err)
+	assert.Nil(t, notFound)
+}
+
+func TestTripRepo_Stops_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	dijon, err := NewGormCityRepository(testDB).Create(ctx, entities.CreateCityData{CityName: "Dijon", Zipcode: "21000"})
+	require.NoError(t, err)
+
+	dateTrip := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	stopTime := dateTrip.Add(3 * time.Hour)
+	trip, err := repo.Create(ctx, entities.CreateTripData{
+		DateTrip:    dateTrip,
+		Kms:         450,
+		Seats:       3,
+		DriverRefID: driverRefID,
+		CarRefID:    carRefID,
+		Stops: []entities.CreateTripStopData{
+			{CityRefID: departureCityRefID},
+			{CityRefID: dijon.RefID, Time: &stopTime},
+			{CityRefID: arrivalCityRefID},
+		},
+	})
+	require.NoError(t, err)
+
+	found, err := repo.FindByID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, found.Stops, 3)
+	assert.Equal(t, "Paris", found.Stops[0].CityName)
+	assert.Equal(t, entities.TripStopDeparture, found.Stops[0].Type)
+	assert.Equal(t, "Dijon", found.Stops[1].CityName)
+	assert.Equal(t, entities.TripStopWaypoint, found.Stops[1].Type)
+	require.NotNil(t, found.Stops[1].Time)
+	assert.True(t, stopTime.Equal(*found.Stops[1].Time))
+	assert.Equal(t, "Lyon", found.Stops[2].CityName)
+	assert.Equal(t, entities.TripStopArrival, found.Stops[2].Type)
+	assert.Equal(t, 2, found.LastPosition())
+
+	// A search matches any pair of stops in the direction of travel
+	paris, lyon, dijonName := "Paris", "Lyon", "Dijon"
+	trips, err := repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &dijonName, ArrivalCity: &lyon})
+	require.NoError(t, err)
+	assert.Len(t, trips, 1)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &paris, ArrivalCity: &dijonName})
+	require.NoError(t, err)
+	assert.Len(t, trips, 1)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &lyon, ArrivalCity: &paris})
+	require.NoError(t, err)
+	assert.Empty(t, trips)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{DepartureCity: &lyon})
+	require.NoError(t, err)
+	assert.Empty(t, trips, "nobody boards at the arrival city")
+}
+
+func TestTripRepo_TimeZones_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+
+	// 07:30 in Paris
+	departure := time.Date(2027, 1, 15, 6, 30, 0, 0, time.UTC)
+	trip, err := repo.Create(ctx, entities.CreateTripData{
+		DateTrip:         departure,
+		EstimatedArrival: entities.EstimateArrival(departure, 400),
+		TimeZone:         "Europe/Paris",
+		Kms:              400,
+		Seats:            3,
+		DriverRefID:      driverRefID,
+		CarRefID:         carRefID,
+		Stops:            []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", trip.TimeZone)
+	assert.Equal(t, "2027-01-15T07:30:00+01:00", trip.LocalDateTrip.Format(time.RFC3339))
+	assert.Equal(t, "2027-01-15T11:30:00Z", trip.EstimatedArrival.Format(time.RFC3339))
+
+	day := time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC)
+	window := func(after, before string) []entities.Trip {
+		t.Helper()
+		trips, err := repo.FindByFilters(ctx, entities.TripFilters{Date: &day, DepartureAfter: &after, DepartureBefore: &before})
+		require.NoError(t, err)
+		return trips
+	}
+
+	assert.Len(t, window("07:00", "09:00"), 1)
+	assert.Empty(t, window("08:00", "09:00"), "the window is on the Paris clock, not UTC")
+	assert.Len(t, window("22:00", "08:00"), 1, "a window may span midnight")
+}
+
+func TestTripRepo_FindByFilters_Price_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	for i, price := range []int{2500, 900, 1800} {
+		_, err := repo.Create(ctx, entities.CreateTripData{
+			DateTrip:     time.Now().Add(time.Duration(48+i) * time.Hour),
+			Kms:          450,
+			Seats:        3,
+			PricePerSeat: price,
+			Currency:     "EUR",
+			DriverRefID:  driverRefID,
+			CarRefID:     carRefID,
+			Stops:        []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
+		})
+		require.NoError(t, err)
+	}
+
+	trips, err := repo.FindByFilters(ctx, entities.TripFilters{Sort: entities.TripSortPrice})
+	require.NoError(t, err)
+	require.Len(t, trips, 3)
+	assert.Equal(t, []int{900, 1800, 2500}, []int{trips[0].PricePerSeat, trips[1].PricePerSeat, trips[2].PricePerSeat})
+	assert.Equal(t, "EUR", trips[0].Currency)
+
+	minPrice, maxPrice := 1000, 2000
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{MinPrice: &minPrice, MaxPrice: &maxPrice})
+	require.NoError(t, err)
+	require.Len(t, trips, 1)
+	assert.Equal(t, 1800, trips[0].PricePerSeat)
+
+	trips, err = repo.FindByFilters(ctx, entities.TripFilters{MinPrice: &minPrice, Sort: entities.TripSortPriceDesc})
+	require.NoError(t, err)
+	require.Len(t, trips, 2)
+	assert.Equal(t, 2500, trips[0].PricePerSeat)
+}
+
+func TestTripRepo_FindByFilters_Distance_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripRepository(testDB)
+	cityRepo := NewGormCityRepository(testDB)
+	ctx := context.Background()
+
+	// Lyon and Paris from the prerequisites have no coordinates
+	driverRefID, carRefID, parisRefID, lyonRefID := createTripPrerequisites(t)
+	located := func(name string, lat, lng float64) int64 {
+		t.Helper()
+		city, err := cityRepo.Create(ctx, entities.CreateCityData{CityName: name, Latitude: &lat, Longitude: &lng})
+		require.NoError(t, err)
+		return city.RefID
+	}
+	villeurbanne := located("Villeurbanne", 45.7719, 4.8902)
+	saintFons := located("Saint-Fons", 45.7086, 4.8533)
+	vienne := located("Vienne", 45.5256, 4.8744)
+	orly := located("Orly", 48.7433, 2.3930)
+
+	create := func(from, to int64) string {
+		t.Helper()
+		trip, err := repo.Create(ctx, entities.CreateTripData{
+			DateTrip:    time.Now().Add(48 * time.Hour),
+			Kms:         450,
+			Seats:       3,
+			DriverRefID: driverRefID,
+			CarRefID:    carRefID,
+			Stops:       []entities.CreateTripStopData{{CityRefID: from}, {CityRefID: to}},
+		})
+		require.NoError(t, err)
+		return trip.ID
+	}
+	fromVienne := create(vienne, parisRefID)
+	fromVilleurbanne := create(villeurbanne, parisRefID)
+	fromSaintFons := create(saintFons, orly)
+	create(lyonRefID, parisRefID)
+
+	ids := func(filters entities.TripFilters) []string {
+		t.Helper()
+		trips, err := repo.FindByFilters(ctx, filters)
+		require.NoError(t, err)
+		result := make([]string, len(trips))
+		for i := range trips {
+			result[i] = trips[i].ID
+		}
+		return result
+	}
+	aroundLyon := func(radiusKm float64) *entities.GeoArea {
+		return &entities.GeoArea{Latitude: 45.7640, Longitude: 4.8357, RadiusKm: radiusKm}
+	}
+
+	assert.Equal(t, []string{fromVilleurbanne, fromSaintFons},
+		ids(entities.TripFilters{DepartureArea: aroundLyon(10), Sort: entities.TripSortDistance}))
+	assert.Equal(t, []string{fromVilleurbanne, fromSaintFons, fromVienne},
+		ids(entities.TripFilters{DepartureArea: aroundLyon(30), Sort: entities.TripSortDistance}))
+
+	paris := "Paris"
+	assert.Equal(t, []string{fromVilleurbanne, fromVienne},
+		ids(entities.TripFilters{DepartureArea: aroundLyon(30), ArrivalCity: &paris, Sort: entities.TripSortDistance}))
+
+	aroundParis := &entities.GeoArea{Latitude: 48.8566, Longitude: 2.3522, RadiusKm: 20}
+	assert.Equal(t, []string{fromSaintFons},
+		ids(entities.TripFilters{DepartureArea: aroundLyon(30), ArrivalArea: aroundParis, Sort: entities.TripSortDistance}))
+	assert.Empty(t, ids(entities.TripFilters{ArrivalArea: aroundLyon(30)}), "nobody gets off near Lyon")
+}
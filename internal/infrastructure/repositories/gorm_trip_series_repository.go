@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormTripSeriesRepository struct{ db *gorm.DB }
+
+func NewGormTripSeriesRepository(db *gorm.DB) repositories.TripSeriesRepository {
+	return &GormTripSeriesRepository{db: db}
+}
+
+// tripSeriesRow is a series with the names of its departure and arrival cities
+type tripSeriesRow struct {
+	database.TripSeriesModel
+	DepartureCity string
+	ArrivalCity   string
+}
+
+func (r *GormTripSeriesRepository) query(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("trip_series ts").
+		Select("ts.*, dc.city_name AS departure_city, ac.city_name AS arrival_city").
+		Joins("JOIN cities dc ON dc.ref_id = ts.departure_city_ref_id").
+		Joins("JOIN cities ac ON ac.ref_id = ts.arrival_city_ref_id")
+}
+
+func (r *GormTripSeriesRepository) FindByID(ctx context.Context, id string) (*entities.TripSeries, error) {
+	var rows []tripSeriesRow
+	if err := r.query(ctx).Where("ts.id = ?", id).Limit(1).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	e := toTripSeriesEntity(&rows[0])
+	return &e, nil
+}
+
+func (r *GormTripSeriesRepository) FindActive(ctx context.Context) ([]entities.TripSeries, error) {
+	var rows []tripSeriesRow
+	if err := r.query(ctx).Where("ts.status = ?", entities.TripSeriesStatusActive).Order("ts.ref_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make([]entities.TripSeries, len(rows))
+	for i := range rows {
+		result[i] = toTripSeriesEntity(&rows[i])
+	}
+	return result, nil
+}
+
+func (r *GormTripSeriesRepository) Create(ctx context.Context, data entities.CreateTripSeriesData) (*entities.TripSeries, error) {
+	m := &database.TripSeriesModel{
+		DriverRefID:        data.DriverRefID,
+		CarRefID:           data.CarRefID,
+		DepartureCityRefID: data.DepartureCityRefID,
+		ArrivalCityRefID:   data.ArrivalCityRefID,
+		Kms:                data.Kms,
+		Seats:              data.Seats,
+		PricePerSeat:       data.PricePerSeat,
+		Currency:           data.Currency,
+		TimeZone:           data.TimeZone,
+		DepartureTime:      data.DepartureTime,
+		Weekdays:           strings.Join(data.Weekdays, ","),
+		Interval:           data.Interval,
+		StartDate:          data.StartDate,
+		EndDate:            data.EndDate,
+		Exceptions:         strings.Join(data.Exceptions, ","),
+		Status:             entities.TripSeriesStatusActive,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return r.FindByID(ctx, m.ID)
+}
+
+func (r *GormTripSeriesRepository) Update(ctx context.Context, id string, data entities.UpdateTripSeriesData) (*entities.TripSeries, error) {
+	updates := map[string]interface{}{}
+	if data.Seats != nil {
+		updates["seats"] = *data.Seats
+	}
+	if data.DepartureTime != nil {
+		updates["departure_time"] = *data.DepartureTime
+	}
+	if data.Weekdays != nil {
+		updates["weekdays"] = strings.Join(data.Weekdays, ",")
+	}
+	if data.Interval != nil {
+		updates["interval_weeks"] = *data.Interval
+	}
+	if data.EndDate != nil {
+		updates["end_date"] = *data.EndDate
+	}
+	if data.Exceptions != nil {
+		updates["exceptions"] = strings.Join(data.Exceptions, ",")
+	}
+	if len(updates) > 0 {
+		if err := r.db.WithContext(ctx).Model(&database.TripSeriesModel{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return nil, err
+		}
+	}
+	return r.FindByID(ctx, id)
+}
+
+func (r *GormTripSeriesRepository) Cancel(ctx context.Context, id string, reason string) error {
+	return r.db.WithContext(ctx).Model(&database.TripSeriesModel{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": entities.TripSeriesStatusCancelled, "cancellation_reason": reason}).Error
+}
+
+func (r *GormTripSeriesRepository) Subscribe(ctx context.Context, seriesRefID, userRefID int64) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&database.TripSeriesSubscriptionModel{SeriesRefID: seriesRefID, UserRefID: userRefID}).Error
+}
+
+func (r *GormTripSeriesRepository) Unsubscribe(ctx context.Context, seriesRefID, userRefID int64) error {
+	return r.db.WithContext(ctx).
+		Where("series_ref_id = ? AND user_ref_id = ?", seriesRefID, userRefID).
+		Delete(&database.TripSeriesSubscriptionModel{}).Error
+}
+
+func (r *GormTripSeriesRepository) IsSubscribed(ctx context.Context, seriesRefID, userRefID int64) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&database.TripSeriesSubscriptionModel{}).
+		Where("series_ref_id = ? AND user_ref_id = ?", seriesRefID, userRefID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *GormTripSeriesRepository) FindSubscriberRefIDs(ctx context.Context, seriesRefID int64) ([]int64, error) {
+	var refIDs []int64
+	if err := r.db.WithContext(ctx).Model(&database.TripSeriesSubscriptionModel{}).
+		Where("series_ref_id = ?", seriesRefID).
+		Order("created_at").
+		Pluck("user_ref_id", &refIDs).Error; err != nil {
+		return nil, err
+	}
+	return refIDs, nil
+}
+
+func toTripSeriesEntity(row *tripSeriesRow) entities.TripSeries {
+	m := row.TripSeriesModel
+	return entities.TripSeries{
+		ID: m.ID, RefID: m.RefID,
+		DriverRefID: m.DriverRefID, CarRefID: m.CarRefID,
+		DepartureCityRefID: m.DepartureCityRefID, ArrivalCityRefID: m.ArrivalCityRefID,
+		DepartureCity: row.DepartureCity, ArrivalCity: row.ArrivalCity,
+		Kms: m.Kms, Seats: m.Seats, PricePerSeat: m.PricePerSeat, Currency: m.Currency,
+		TimeZone: m.TimeZone, DepartureTime: m.DepartureTime,
+		Weekdays: splitNonEmpty(m.Weekdays), Interval: m.Interval,
+		StartDate: m.StartDate, EndDate: m.EndDate, Exceptions: splitNonEmpty(m.Exceptions),
+		Status: m.Status, CancellationReason: m.CancellationReason,
+	}
+}
+
+// splitNonEmpty splits a comma-separated column, returning an empty list for an empty value
+func splitNonEmpty(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
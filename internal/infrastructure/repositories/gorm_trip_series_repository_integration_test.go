@@ -0,0 +1,169 @@
+//go:build integration
+
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTripSeriesRepo_CRUD_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	repo := NewGormTripSeriesRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	endDate := "2030-06-28"
+
+	series, err := repo.Create(ctx, entities.CreateTripSeriesData{
+		DriverRefID:        driverRefID,
+		CarRefID:           carRefID,
+		DepartureCityRefID: departureCityRefID,
+		ArrivalCityRefID:   arrivalCityRefID,
+		Kms:                460,
+		Seats:              3,
+		TimeZone:           "Europe/Paris",
+		DepartureTime:      "07:30",
+		Weekdays:           []string{"MO", "FR"},
+		Interval:           1,
+		StartDate:          "2030-01-07",
+		EndDate:            &endDate,
+		Exceptions:         []string{},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, series)
+	assert.NotEmpty(t, series.ID)
+	assert.Equal(t, "Paris", series.DepartureCity)
+	assert.Equal(t, "Lyon", series.ArrivalCity)
+	assert.Equal(t, []string{"MO", "FR"}, series.Weekdays)
+	assert.Empty(t, series.Exceptions)
+	assert.Equal(t, entities.TripSeriesStatusActive, series.Status)
+
+	// Update
+	seats := 2
+	updated, err := repo.Update(ctx, series.ID, entities.UpdateTripSeriesData{Seats: &seats, Exceptions: []string{"2030-01-11"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Seats)
+	assert.Equal(t, []string{"2030-01-11"}, updated.Exceptions)
+	assert.Equal(t, []string{"MO", "FR"}, updated.Weekdays)
+
+	active, err := repo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Len(t, active, 1)
+
+	// Subscriptions
+	_, passenger := createTestAuthAndUser(t, "commuter@example.com", "Com", "Muter", "+33622222222")
+	require.NoError(t, repo.Subscribe(ctx, series.RefID, passenger.RefID))
+	require.NoError(t, repo.Subscribe(ctx, series.RefID, passenger.RefID))
+	subscribed, err := repo.IsSubscribed(ctx, series.RefID, passenger.RefID)
+	require.NoError(t, err)
+	assert.True(t, subscribed)
+	subscribers, err := repo.FindSubscriberRefIDs(ctx, series.RefID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{passenger.RefID}, subscribers)
+
+	require.NoError(t, repo.Unsubscribe(ctx, series.RefID, passenger.RefID))
+	subscribed, err = repo.IsSubscribed(ctx, series.RefID, passenger.RefID)
+	require.NoError(t, err)
+	assert.False(t, subscribed)
+
+	// Cancel
+	require.NoError(t, repo.Cancel(ctx, series.ID, "Moved away"))
+	cancelled, err := repo.FindByID(ctx, series.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.TripSeriesStatusCancelled, cancelled.Status)
+	require.NotNil(t, cancelled.CancellationReason)
+	assert.Equal(t, "Moved away", *cancelled.CancellationReason)
+
+	active, err = repo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	notFound, err := repo.FindByID(ctx, "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	assert.Nil(t, notFound)
+}
+
+func TestTripRepo_SeriesOccurrences_Integration(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	seriesRepo := NewGormTripSeriesRepository(testDB)
+	tripRepo := NewGormTripRepository(testDB)
+	ctx := context.Background()
+
+	driverRefID, carRefID, departureCityRefID, arrivalCityRefID := createTripPrerequisites(t)
+	series, err := seriesRepo.Create(ctx, entities.CreateTripSeriesData{
+		DriverRefID: driverRefID, CarRefID: carRefID,
+		DepartureCityRefID: departureCityRefID, ArrivalCityRefID: arrivalCityRefID,
+		Kms: 460, Seats: 3, TimeZone: "Europe/Paris", DepartureTime: "07:30",
+		Weekdays: []string{"MO"}, Interval: 1, StartDate: "2030-01-07",
+	})
+	require.NoError(t, err)
+
+	date := "2030-01-07"
+	departure := time.Date(2030, 1, 7, 6, 30, 0, 0, time.UTC)
+	data := entities.CreateTripData{
+		DateTrip:         departure,
+		EstimatedArrival: entities.EstimateArrival(departure, 460),
+		TimeZone:         "Europe/Paris",
+		Kms:              460,
+		Seats:            3,
+		DriverRefID:      driverRefID,
+		CarRefID:         carRefID,
+		Stops:            []entities.CreateTripStopData{{CityRefID: departureCityRefID}, {CityRefID: arrivalCityRefID}},
+		SeriesRefID:      &series.RefID,
+		OccurrenceDate:   &date,
+	}
+
+	occurrence, err := tripRepo.Create(ctx, data)
+	require.NoError(t, err)
+	require.NotNil(t, occurrence)
+	require.NotNil(t, occurrence.SeriesRefID)
+	assert.Equal(t, series.RefID, *occurrence.SeriesRefID)
+	require.NotNil(t, occurrence.OccurrenceDate)
+	assert.Equal(t, date, *occurrence.OccurrenceDate)
+
+	// The same occurrence is only created once
+	again, err := tripRepo.Create(ctx, data)
+	require.NoError(t, err)
+	assert.Nil(t, again)
+
+	upcoming, err := tripRepo.FindUpcomingBySeries(ctx, series.RefID)
+	require.NoError(t, err)
+	require.Len(t, upcoming, 1)
+	assert.Equal(t, occurrence.ID, upcoming[0].ID)
+
+	// Moved and resized on its own
+	later := departure.Add(time.Hour)
+	arrival := entities.EstimateArrival(later, 460)
+	two, overridden := 2, true
+	rescheduled, err := tripRepo.Update(ctx, occurrence.ID, entities.UpdateTripData{
+		DateTrip:         &later,
+		EstimatedArrival: &arrival,
+		Seats:            &two,
+		SeriesOverridden: &overridden,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rescheduled)
+	found, err := tripRepo.FindByID(ctx, occurrence.ID)
+	require.NoError(t, err)
+	assert.True(t, found.DateTrip.Equal(later))
+	assert.Equal(t, 2, found.Seats)
+	assert.True(t, found.SeriesOverridden)
+
+	// Cancelled occurrences are no longer upcoming
+	cancelled, err := tripRepo.Cancel(ctx, occurrence.ID, "Holiday")
+	require.NoError(t, err)
+	assert.True(t, cancelled)
+	upcoming, err = tripRepo.FindUpcomingBySeries(ctx, series.RefID)
+	require.NoError(t, err)
+	assert.Empty(t, upcoming)
+}
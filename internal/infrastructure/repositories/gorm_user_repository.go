@@ -71,6 +71,24 @@ func (r *GormUserRepository) FindByAuthRefID(ctx context.Context, authRefID int6
 	return &pu, nil
 }
 
+func (r *GormUserRepository) FindByRefID(ctx context.Context, refID int64) (*entities.PublicUser, error) {
+	var user database.UserModel
+	if err := r.db.WithContext(ctx).Where("ref_id = ?", refID).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var auth database.AuthModel
+	if err := r.db.WithContext(ctx).Where("ref_id = ?", user.AuthRefID).First(&auth).Error; err != nil {
+		return nil, err
+	}
+
+	pu := toPublicUserEntity(&user, &auth)
+	return &pu, nil
+}
+
 func (r *GormUserRepository) Update(ctx context.Context, id string, data entities.UpdateUserData) (*entities.PublicUser, error) {
 	updates := map[string]interface{}{}
 	if data.FirstName != nil {
@@ -14,7 +14,7 @@ import (
 // createTestAuthAndUser is a helper that creates an auth+user pair for user repository tests.
 func createTestAuthAndUser(t *testing.T, email, first, last, phone string) (*entities.Auth, *entities.PublicUser) {
 	t.Helper()
-	authRepo := NewGormAuthRepository(testDB)
+	authRepo := NewGormAuthRepository(testDB, testSecretCipher)
 	ctx := context.Background()
 
 	firstName := first
@@ -53,6 +53,17 @@ func TestUserRepo_FindByID_Integration(t *testing.T) {
 	notFound, err := repo.FindByID(ctx, "00000000-0000-0000-0000-000000000000")
 	require.NoError(t, err)
 	assert.Nil(t, notFound)
+
+	// Find by ref ID
+	foundByRef, err := repo.FindByRefID(ctx, user.RefID)
+	require.NoError(t, err)
+	require.NotNil(t, foundByRef)
+	assert.Equal(t, user.ID, foundByRef.ID)
+	assert.Equal(t, "findme@example.com", foundByRef.Email)
+
+	notFoundByRef, err := repo.FindByRefID(ctx, 0)
+	require.NoError(t, err)
+	assert.Nil(t, notFoundByRef)
 }
 
 func TestUserRepo_FindAll_Integration(t *testing.T) {
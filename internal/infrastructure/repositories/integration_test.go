@@ -3,6 +3,7 @@
 package repositories
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
@@ -10,7 +11,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lgxju/gogretago/internal/domain/services"
 	"github.com/lgxju/gogretago/internal/infrastructure/database"
+	infraservices "github.com/lgxju/gogretago/internal/infrastructure/services"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -21,6 +24,9 @@ import (
 
 var testDB *gorm.DB
 
+// testSecretCipher encrypts the TOTP secrets of the test database
+var testSecretCipher services.SecretCipher
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -63,6 +69,18 @@ func TestMain(m *testing.M) {
 	// Run migrations
 	if err := testDB.AutoMigrate(
 		&database.AuthModel{},
+		&database.RefreshTokenModel{},
+		&database.SessionModel{},
+		&database.AuditLogModel{},
+		&database.RevokedTokenModel{},
+		&database.OneTimeTokenModel{},
+		&database.RecoveryCodeModel{},
+		&database.PasswordHistoryModel{},
+		&database.OidcLoginStateModel{},
+		&database.ExternalIdentityModel{},
+		&database.RoleModel{},
+		&database.ServiceAccountModel{},
+		&database.ApiKeyModel{},
 		&database.UserModel{},
 		&database.DriverModel{},
 		&database.BrandModel{},
@@ -74,10 +92,18 @@ func TestMain(m *testing.M) {
 		&database.TripModel{},
 		&database.CityTripModel{},
 		&database.InscriptionModel{},
+		&database.TripChangeModel{},
+		&database.TripSeriesModel{},
+		&database.TripSeriesSubscriptionModel{},
 	); err != nil {
 		log.Fatalf("failed to run migrations: %v", err)
 	}
 
+	testSecretCipher, err = infraservices.NewAesSecretCipher(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		log.Fatalf("failed to create secret cipher: %v", err)
+	}
+
 	fmt.Println("Test database ready")
 	os.Exit(m.Run())
 }
@@ -85,6 +111,9 @@ func TestMain(m *testing.M) {
 func cleanTables(t *testing.T) {
 	t.Helper()
 	tables := []string{
+		"trip_series_subscriptions",
+		"trip_series",
+		"trip_changes",
 		"inscriptions",
 		"city_trips",
 		"trips",
@@ -96,6 +125,18 @@ func cleanTables(t *testing.T) {
 		"cities",
 		"drivers",
 		"users",
+		"refresh_tokens",
+		"sessions",
+		"audit_logs",
+		"revoked_tokens",
+		"one_time_tokens",
+		"recovery_codes",
+		"password_history",
+		"oidc_login_states",
+		"external_identities",
+		"api_keys",
+		"service_accounts",
+		"roles",
 		"auths",
 	}
 	for _, table := range tables {
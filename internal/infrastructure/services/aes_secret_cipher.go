@@ -0,0 +1,57 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+var errMalformedCiphertext = errors.New("malformed ciphertext")
+
+// AesSecretCipher implements SecretCipher with AES-GCM; each value gets a random nonce
+// stored in front of it, and the whole is encoded as unpadded base64
+type AesSecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewAesSecretCipher creates an AesSecretCipher from a 16, 24 or 32 byte key
+func NewAesSecretCipher(key []byte) (services.SecretCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret encryption key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AesSecretCipher{aead: aead}, nil
+}
+
+// Encrypt seals plaintext under a fresh nonce
+func (c *AesSecretCipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value returned by Encrypt, failing if it was altered
+func (c *AesSecretCipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", errMalformedCiphertext
+	}
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSecretCipher(t *testing.T, fill byte) *AesSecretCipher {
+	t.Helper()
+	c, err := NewAesSecretCipher(bytes.Repeat([]byte{fill}, 32))
+	require.NoError(t, err)
+	return c.(*AesSecretCipher)
+}
+
+func TestAesSecretCipher_RoundTrip(t *testing.T) {
+	c := newTestSecretCipher(t, 1)
+
+	ciphertext, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.NotContains(t, ciphertext, "JBSWY3DPEHPK3PXP")
+
+	plaintext, err := c.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", plaintext)
+}
+
+func TestAesSecretCipher_UsesFreshNonce(t *testing.T) {
+	c := newTestSecretCipher(t, 1)
+
+	first, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	second, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestAesSecretCipher_RejectsOtherKey(t *testing.T) {
+	ciphertext, err := newTestSecretCipher(t, 1).Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+
+	_, err = newTestSecretCipher(t, 2).Decrypt(ciphertext)
+	assert.Error(t, err)
+}
+
+func TestAesSecretCipher_RejectsPlaintext(t *testing.T) {
+	c := newTestSecretCipher(t, 1)
+
+	_, err := c.Decrypt("JBSWY3DPEHPK3PXP")
+	assert.Error(t, err)
+	_, err = c.Decrypt("")
+	assert.Error(t, err)
+}
+
+func TestNewAesSecretCipher_InvalidKey(t *testing.T) {
+	_, err := NewAesSecretCipher([]byte("too short"))
+	assert.Error(t, err)
+}
@@ -11,21 +11,38 @@ import (
 	"golang.org/x/crypto/argon2"
 )
 
-// Argon2 configuration matching the TypeScript implementation
 const (
-	argonTime    = 2
-	argonMemory  = 19 * 1024 // 19456 KiB
-	argonThreads = 1
 	argonKeyLen  = 32
 	argonSaltLen = 16
 )
 
+// ArgonParams are the Argon2id cost parameters new hashes are created with
+type ArgonParams struct {
+	Time      uint32
+	MemoryKiB uint32
+	Threads   uint8
+}
+
+// DefaultArgonParams match the TypeScript implementation (OWASP minimum for Argon2id)
+var DefaultArgonParams = ArgonParams{Time: 2, MemoryKiB: 19 * 1024, Threads: 1}
+
 // ArgonPasswordService implements PasswordService using Argon2id
-type ArgonPasswordService struct{}
+type ArgonPasswordService struct {
+	params ArgonParams
+}
 
-// NewArgonPasswordService creates a new ArgonPasswordService
-func NewArgonPasswordService() services.PasswordService {
-	return &ArgonPasswordService{}
+// NewArgonPasswordService creates a new ArgonPasswordService. Zero parameters fall back to the defaults.
+func NewArgonPasswordService(params ArgonParams) services.PasswordService {
+	if params.Time == 0 {
+		params.Time = DefaultArgonParams.Time
+	}
+	if params.MemoryKiB == 0 {
+		params.MemoryKiB = DefaultArgonParams.MemoryKiB
+	}
+	if params.Threads == 0 {
+		params.Threads = DefaultArgonParams.Threads
+	}
+	return &ArgonPasswordService{params: params}
 }
 
 // Hash hashes a password using Argon2id
@@ -35,46 +52,80 @@ func (s *ArgonPasswordService) Hash(password string) (string, error) {
 		return "", err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	p := s.params
+	hash := argon2.IDKey([]byte(password), salt, p.Time, p.MemoryKiB, p.Threads, argonKeyLen)
 
 	// Encode in the standard format: $argon2id$v=19$m=19456,t=2,p=1$salt$hash
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hash)
 
 	encoded := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
-		argon2.Version, argonMemory, argonTime, argonThreads, b64Salt, b64Hash)
+		argon2.Version, p.MemoryKiB, p.Time, p.Threads, b64Salt, b64Hash)
 
 	return encoded, nil
 }
 
 // Verify verifies a password against a hash
 func (s *ArgonPasswordService) Verify(password, encodedHash string) (bool, error) {
-	// Parse the encoded hash
-	parts := strings.Split(encodedHash, "$")
-	if len(parts) != 6 {
-		return false, fmt.Errorf("invalid hash format")
-	}
-
-	var memory, time uint32
-	var threads uint8
-	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads)
+	decoded, err := decodeArgonHash(encodedHash)
 	if err != nil {
 		return false, err
 	}
 
-	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	// Compute hash of provided password
+	p := decoded.params
+	computedHash := argon2.IDKey([]byte(password), decoded.salt, p.Time, p.MemoryKiB, p.Threads, uint32(len(decoded.hash)))
+
+	// Constant-time comparison
+	return subtle.ConstantTimeCompare(decoded.hash, computedHash) == 1, nil
+}
+
+// NeedsRehash reports whether the hash was made with other parameters than the current ones.
+// Hashes that cannot be parsed need rehashing too.
+func (s *ArgonPasswordService) NeedsRehash(encodedHash string) bool {
+	decoded, err := decodeArgonHash(encodedHash)
 	if err != nil {
-		return false, err
+		return true
 	}
+	return decoded.variant != "argon2id" ||
+		decoded.version != argon2.Version ||
+		decoded.params != s.params ||
+		len(decoded.hash) != argonKeyLen
+}
 
-	expectedHash, err := base64.RawStdEncoding.DecodeString(parts[5])
-	if err != nil {
-		return false, err
+type argonHash struct {
+	variant string
+	version int
+	params  ArgonParams
+	salt    []byte
+	hash    []byte
+}
+
+// decodeArgonHash parses a hash in the $argon2id$v=..$m=..,t=..,p=..$salt$hash format
+func decodeArgonHash(encodedHash string) (*argonHash, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("invalid hash format")
 	}
 
-	// Compute hash of provided password
-	computedHash := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(expectedHash)))
+	decoded := &argonHash{variant: parts[1]}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &decoded.version); err != nil {
+		return nil, err
+	}
 
-	// Constant-time comparison
-	return subtle.ConstantTimeCompare(expectedHash, computedHash) == 1, nil
+	p := &decoded.params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.MemoryKiB, &p.Time, &p.Threads); err != nil {
+		return nil, err
+	}
+
+	var err error
+	decoded.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, err
+	}
+	decoded.hash, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, err
+	}
+	return decoded, nil
 }
@@ -9,14 +9,14 @@ import (
 )
 
 func TestHash_ProducesArgon2idFormat(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	hash, err := svc.Hash("TestPassword123")
 	require.NoError(t, err)
 	assert.True(t, strings.HasPrefix(hash, "$argon2id$v="), "hash should start with $argon2id$v=")
 }
 
 func TestHash_DifferentSalts(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	hash1, err := svc.Hash("SamePassword1")
 	require.NoError(t, err)
 
@@ -27,7 +27,7 @@ func TestHash_DifferentSalts(t *testing.T) {
 }
 
 func TestVerify_CorrectPassword(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	password := "CorrectPassword1"
 
 	hash, err := svc.Hash(password)
@@ -39,7 +39,7 @@ func TestVerify_CorrectPassword(t *testing.T) {
 }
 
 func TestVerify_WrongPassword(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 
 	hash, err := svc.Hash("CorrectPassword1")
 	require.NoError(t, err)
@@ -50,13 +50,13 @@ func TestVerify_WrongPassword(t *testing.T) {
 }
 
 func TestVerify_InvalidHashFormat(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	_, err := svc.Verify("anypassword", "notahash")
 	assert.Error(t, err, "invalid hash format should return error")
 }
 
 func TestVerify_CorruptedSalt(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	// Valid format but with corrupted base64 salt (invalid characters for base64)
 	corrupted := "$argon2id$v=19$m=19456,t=2,p=1$!!!invalid-base64!!!$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
 	_, err := svc.Verify("anypassword", corrupted)
@@ -64,9 +64,46 @@ func TestVerify_CorruptedSalt(t *testing.T) {
 }
 
 func TestVerify_CorruptedHash(t *testing.T) {
-	svc := NewArgonPasswordService()
+	svc := NewArgonPasswordService(DefaultArgonParams)
 	// Valid format with valid base64 salt but corrupted base64 hash
 	corrupted := "$argon2id$v=19$m=19456,t=2,p=1$AAAAAAAAAAAAAAAAAAAAAA$!!!invalid-base64!!!"
 	_, err := svc.Verify("anypassword", corrupted)
 	assert.Error(t, err, "corrupted hash should return error")
 }
+
+func TestNewArgonPasswordService_ZeroParamsUseDefaults(t *testing.T) {
+	svc := NewArgonPasswordService(ArgonParams{})
+	hash, err := svc.Hash("TestPassword123")
+	require.NoError(t, err)
+	assert.Contains(t, hash, "$m=19456,t=2,p=1$")
+}
+
+func TestHash_UsesConfiguredParams(t *testing.T) {
+	svc := NewArgonPasswordService(ArgonParams{Time: 3, MemoryKiB: 8 * 1024, Threads: 2})
+	hash, err := svc.Hash("TestPassword123")
+	require.NoError(t, err)
+	assert.Contains(t, hash, "$m=8192,t=3,p=2$")
+
+	ok, err := svc.Verify("TestPassword123", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestNeedsRehash(t *testing.T) {
+	current := NewArgonPasswordService(DefaultArgonParams)
+	hash, err := current.Hash("TestPassword123")
+	require.NoError(t, err)
+	assert.False(t, current.NeedsRehash(hash), "hash made with the current parameters")
+
+	stronger := NewArgonPasswordService(ArgonParams{Time: 3, MemoryKiB: 64 * 1024, Threads: 1})
+	assert.True(t, stronger.NeedsRehash(hash), "hash made with weaker parameters")
+
+	// Hashes made with older parameters still verify
+	ok, err := stronger.Verify("TestPassword123", hash)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	argon2i := strings.Replace(hash, "$argon2id$", "$argon2i$", 1)
+	assert.True(t, current.NeedsRehash(argon2i), "other Argon2 variant")
+	assert.True(t, current.NeedsRehash("notahash"), "unparseable hash")
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+const minRSAKeyBits = 2048
+
+// jwtKey is a key loaded from JWT_KEYS_DIR. Private keys can sign and verify; public keys
+// only verify, which lets a retired signing key keep validating live tokens until they expire.
+type jwtKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// loadJwtKeys reads every <kid>.pem file in dir, keyed by kid
+func loadJwtKeys(dir string) (map[string]*jwtKey, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWT keys: %w", err)
+	}
+
+	keys := make(map[string]*jwtKey)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading JWT key %s: %w", entry.Name(), err)
+		}
+		key, err := parseJwtKey(strings.TrimSuffix(entry.Name(), ".pem"), data)
+		if err != nil {
+			return nil, err
+		}
+		keys[key.id] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no JWT keys found in %s", dir)
+	}
+	return keys, nil
+}
+
+// parseJwtKey decodes a PEM encoded RSA or Ed25519 key, private (PKCS#1 or PKCS#8) or public (PKIX)
+func parseJwtKey(id string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT key %s: no PEM data", id)
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("JWT key %s: unsupported PEM block %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("JWT key %s: %w", id, err)
+	}
+
+	return newJwtKey(id, key)
+}
+
+func newJwtKey(id string, key any) (*jwtKey, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("JWT key %s: RSA keys must be at least %d bits", id, minRSAKeyBits)
+		}
+		return &jwtKey{id: id, method: jwt.SigningMethodRS256, private: k, public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("JWT key %s: RSA keys must be at least %d bits", id, minRSAKeyBits)
+		}
+		return &jwtKey{id: id, method: jwt.SigningMethodRS256, public: k}, nil
+	case ed25519.PrivateKey:
+		return &jwtKey{id: id, method: jwt.SigningMethodEdDSA, private: k, public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &jwtKey{id: id, method: jwt.SigningMethodEdDSA, public: k}, nil
+	default:
+		return nil, fmt.Errorf("JWT key %s: unsupported key type %T", id, key)
+	}
+}
+
+// jwk returns the public half of the key as a JSON Web Key
+func (k *jwtKey) jwk() services.JSONWebKey {
+	jwk := services.JSONWebKey{
+		Kid: k.id,
+		Use: "sig",
+		Alg: k.method.Alg(),
+	}
+
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
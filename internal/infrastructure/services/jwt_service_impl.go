@@ -3,60 +3,107 @@ package services
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/lgxju/gogretago/config"
 	"github.com/lgxju/gogretago/internal/domain/services"
 )
 
-// JwtServiceImpl implements JwtService using golang-jwt
+// JwtServiceImpl implements JwtService using golang-jwt with RS256 or EdDSA keys.
+// Tokens are signed with the configured key and carry its kid; any key loaded
+// from JWT_KEYS_DIR is accepted for verification, so keys can be rotated.
 type JwtServiceImpl struct {
-	secret    string
-	expiresIn string
+	signingKey       *jwtKey
+	keys             map[string]*jwtKey
+	issuer           string
+	audience         string
+	clockSkew        time.Duration
+	expiresIn        string
+	refreshExpiresIn string
 }
 
-// NewJwtService creates a new JwtServiceImpl
-func NewJwtService() services.JwtService {
+// NewJwtService creates a new JwtServiceImpl with the keys found in JWT_KEYS_DIR
+func NewJwtService() (services.JwtService, error) {
 	cfg := config.Get()
-	return &JwtServiceImpl{
-		secret:    cfg.JWTSecret,
-		expiresIn: cfg.JWTExpiresIn,
+
+	keys, err := loadJwtKeys(cfg.JWTKeysDir)
+	if err != nil {
+		return nil, err
 	}
+	signingKey, ok := keys[cfg.JWTSigningKeyID]
+	if !ok {
+		return nil, fmt.Errorf("JWT signing key %q not found in %s", cfg.JWTSigningKeyID, cfg.JWTKeysDir)
+	}
+	if signingKey.private == nil {
+		return nil, fmt.Errorf("JWT signing key %q is a public key", cfg.JWTSigningKeyID)
+	}
+
+	return &JwtServiceImpl{
+		signingKey:       signingKey,
+		keys:             keys,
+		issuer:           cfg.JWTIssuer,
+		audience:         cfg.JWTAudience,
+		clockSkew:        cfg.JWTClockSkew,
+		expiresIn:        cfg.JWTExpiresIn,
+		refreshExpiresIn: cfg.JWTRefreshExpiresIn,
+	}, nil
 }
 
-// Sign creates a new JWT token with userId and role
+// Sign creates a new JWT token with userId, role and a unique jti
 func (s *JwtServiceImpl) Sign(payload services.JwtPayload) (string, error) {
+	now := time.Now()
 	exp := s.calculateExpiration()
 
 	claims := jwt.MapClaims{
-		"userId": payload.UserID,
-		"role":   payload.Role,
-		"exp":    exp,
-		"iat":    time.Now().Unix(),
-		"iss":    "covoitapi",
-		"aud":    "covoitapi",
+		"jti":            uuid.NewString(),
+		"userId":         payload.UserID,
+		"role":           payload.Role,
+		"email_verified": payload.EmailVerified,
+		"ver":            payload.TokenVersion,
+		"exp":            exp,
+		"iat":            now.Unix(),
+		"nbf":            now.Unix(),
+		"iss":            s.issuer,
+		"aud":            s.audience,
+	}
+
+	if payload.SessionID != "" {
+		claims["sid"] = payload.SessionID
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.secret))
+	token := jwt.NewWithClaims(s.signingKey.method, claims)
+	token.Header["kid"] = s.signingKey.id
+	return token.SignedString(s.signingKey.private)
 }
 
-// Verify validates a JWT token and returns the payload
+// Verify validates a JWT token and returns the payload. The signature must match the key
+// named by the kid header, and iss, aud, exp, nbf and iat are all checked within the
+// configured clock skew.
 func (s *JwtServiceImpl) Verify(tokenString string) (*services.JwtPayload, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.secret), nil
-	})
-
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audience),
+		jwt.WithLeeway(s.clockSkew),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+
+	token, err := parser.Parse(tokenString, s.verificationKey)
 	if err != nil {
 		return nil, err
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+		// golang-jwt only checks nbf when present
+		if nbf, err := claims.GetNotBefore(); err != nil || nbf == nil {
+			return nil, fmt.Errorf("invalid token payload: missing nbf")
+		}
+
 		userID, ok := claims["userId"].(string)
 		if !ok {
 			return nil, fmt.Errorf("invalid token payload: missing userId")
@@ -67,20 +114,76 @@ func (s *JwtServiceImpl) Verify(tokenString string) (*services.JwtPayload, error
 			role = "USER"
 		}
 
-		return &services.JwtPayload{UserID: userID, Role: role}, nil
+		emailVerified, _ := claims["email_verified"].(bool)
+		// Tokens signed before versioning count as version 0
+		version, _ := claims["ver"].(float64)
+		tokenID, _ := claims["jti"].(string)
+		sessionID, _ := claims["sid"].(string)
+
+		var expiresAt time.Time
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			expiresAt = exp.Time
+		}
+
+		return &services.JwtPayload{
+			UserID:        userID,
+			Role:          role,
+			EmailVerified: emailVerified,
+			TokenVersion:  int64(version),
+			SessionID:     sessionID,
+			TokenID:       tokenID,
+			ExpiresAt:     expiresAt,
+		}, nil
 	}
 
 	return nil, fmt.Errorf("invalid token")
 }
 
-// calculateExpiration parses the expiresIn string and returns Unix timestamp
+// PublicKeys returns every verification key, sorted by kid, for the JWKS endpoint
+func (s *JwtServiceImpl) PublicKeys() services.JSONWebKeySet {
+	set := services.JSONWebKeySet{Keys: make([]services.JSONWebKey, 0, len(s.keys))}
+	for _, key := range s.keys {
+		set.Keys = append(set.Keys, key.jwk())
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	return set
+}
+
+// verificationKey looks up the public key named by the token's kid header
+func (s *JwtServiceImpl) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %v for key %q", token.Header["alg"], kid)
+	}
+	return key.public, nil
+}
+
+// AccessTokenTTL returns the lifetime of signed access tokens
+func (s *JwtServiceImpl) AccessTokenTTL() time.Duration {
+	return parseExpiresIn(s.expiresIn)
+}
+
+// RefreshTokenTTL returns the lifetime of the refresh tokens issued alongside access tokens
+func (s *JwtServiceImpl) RefreshTokenTTL() time.Duration {
+	return parseExpiresIn(s.refreshExpiresIn)
+}
+
+// calculateExpiration returns the Unix timestamp at which a token signed now expires
 func (s *JwtServiceImpl) calculateExpiration() int64 {
-	now := time.Now()
+	return time.Now().Add(s.AccessTokenTTL()).Unix()
+}
+
+// parseExpiresIn parses durations such as "15m", "24h" or "30d", defaulting to 24 hours
+func parseExpiresIn(expiresIn string) time.Duration {
 	re := regexp.MustCompile(`^(\d+)([hdm])$`)
-	match := re.FindStringSubmatch(s.expiresIn)
+	match := re.FindStringSubmatch(expiresIn)
 
 	if len(match) != 3 {
-		return now.Add(24 * time.Hour).Unix()
+		return 24 * time.Hour
 	}
 
 	value, _ := strconv.Atoi(match[1])
@@ -88,12 +191,12 @@ func (s *JwtServiceImpl) calculateExpiration() int64 {
 
 	switch unit {
 	case "h":
-		return now.Add(time.Duration(value) * time.Hour).Unix()
+		return time.Duration(value) * time.Hour
 	case "d":
-		return now.Add(time.Duration(value) * 24 * time.Hour).Unix()
+		return time.Duration(value) * 24 * time.Hour
 	case "m":
-		return now.Add(time.Duration(value) * time.Minute).Unix()
+		return time.Duration(value) * time.Minute
 	default:
-		return now.Add(24 * time.Hour).Unix()
+		return 24 * time.Hour
 	}
 }
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lgxju/gogretago/config"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+const oidcHTTPTimeout = 10 * time.Second
+
+// OidcServiceImpl implements OidcService over plain HTTP. Provider metadata and
+// signing keys are discovered lazily from {issuer}/.well-known/openid-configuration
+// and cached; the key set is refetched when a token names an unknown kid.
+type OidcServiceImpl struct {
+	providers map[string]config.OidcProviderConfig
+	clockSkew time.Duration
+	client    *http.Client
+
+	mu        sync.Mutex
+	discovery map[string]*oidcDiscovery
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+
+	keys map[string]any
+}
+
+// NewOidcService creates a new OidcServiceImpl for the providers in OIDC_PROVIDERS
+func NewOidcService() services.OidcService {
+	cfg := config.Get()
+	providers := make(map[string]config.OidcProviderConfig, len(cfg.OidcProviders))
+	for _, p := range cfg.OidcProviders {
+		providers[p.Name] = p
+	}
+	return &OidcServiceImpl{
+		providers: providers,
+		clockSkew: cfg.JWTClockSkew,
+		client:    &http.Client{Timeout: oidcHTTPTimeout},
+		discovery: make(map[string]*oidcDiscovery),
+	}
+}
+
+// HasProvider reports whether provider is configured
+func (s *OidcServiceImpl) HasProvider(provider string) bool {
+	_, ok := s.providers[provider]
+	return ok
+}
+
+// AuthorizationURL builds the authorization request for provider
+func (s *OidcServiceImpl) AuthorizationURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return "", fmt.Errorf("unknown OIDC provider %q", provider)
+	}
+	d, err := s.discover(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.ClientID},
+		"redirect_uri":          {p.RedirectURL},
+		"scope":                 {strings.Join(p.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return d.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems code at the token endpoint and validates the returned ID token
+func (s *OidcServiceImpl) Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*services.OidcClaims, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown OIDC provider %q", provider)
+	}
+	d, err := s.discover(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := s.redeemCode(ctx, p, d, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	return s.validateIDToken(ctx, p, d, idToken, nonce)
+}
+
+func (s *OidcServiceImpl) redeemCode(ctx context.Context, p config.OidcProviderConfig, d *oidcDiscovery, code, codeVerifier string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.RedirectURL},
+		"code_verifier": {codeVerifier},
+		"client_id":     {p.ClientID},
+	}
+	if p.ClientSecret != "" {
+		form.Set("client_secret", p.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var body struct {
+		IDToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+	if err := s.do(req, &body); err != nil {
+		if body.Error != "" {
+			return "", fmt.Errorf("OIDC token exchange failed: %s", body.Error)
+		}
+		return "", err
+	}
+	if body.IDToken == "" {
+		return "", errors.New("OIDC token response has no id_token")
+	}
+	return body.IDToken, nil
+}
+
+func (s *OidcServiceImpl) validateIDToken(ctx context.Context, p config.OidcProviderConfig, d *oidcDiscovery, idToken, nonce string) (*services.OidcClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithAudience(p.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(s.clockSkew),
+	)
+
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return s.signingKey(ctx, d, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	if got, _ := claims["nonce"].(string); got == "" || got != nonce {
+		return nil, errors.New("invalid ID token: nonce mismatch")
+	}
+	if azp, ok := claims["azp"].(string); ok && azp != p.ClientID {
+		return nil, errors.New("invalid ID token: authorized party mismatch")
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("invalid ID token: missing subject")
+	}
+
+	result := &services.OidcClaims{Issuer: d.Issuer, Subject: subject}
+	result.Email, _ = claims["email"].(string)
+	result.GivenName, _ = claims["given_name"].(string)
+	result.FamilyName, _ = claims["family_name"].(string)
+	// Some providers send email_verified as a string
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		result.EmailVerified = v
+	case string:
+		result.EmailVerified = v == "true"
+	}
+	return result, nil
+}
+
+// discover returns the cached metadata of p, fetching it on first use
+func (s *OidcServiceImpl) discover(ctx context.Context, p config.OidcProviderConfig) (*oidcDiscovery, error) {
+	s.mu.Lock()
+	d, ok := s.discovery[p.Name]
+	s.mu.Unlock()
+	if ok {
+		return d, nil
+	}
+
+	issuer := strings.TrimSuffix(p.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	d = &oidcDiscovery{}
+	if err := s.do(req, d); err != nil {
+		return nil, fmt.Errorf("OIDC discovery for %q failed: %w", p.Name, err)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("OIDC discovery for %q returned issuer %q", p.Name, d.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JwksURI == "" {
+		return nil, fmt.Errorf("OIDC discovery for %q is incomplete", p.Name)
+	}
+
+	s.mu.Lock()
+	s.discovery[p.Name] = d
+	s.mu.Unlock()
+	return d, nil
+}
+
+// signingKey returns the provider key named kid, refreshing the key set once if it is unknown
+func (s *OidcServiceImpl) signingKey(ctx context.Context, d *oidcDiscovery, kid string) (any, error) {
+	s.mu.Lock()
+	key, ok := d.keys[kid]
+	s.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	keys, err := s.fetchKeys(ctx, d.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	d.keys = keys
+	s.mu.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	// Providers with a single key may omit the kid
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (s *OidcServiceImpl) fetchKeys(ctx context.Context, jwksURI string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []oidcJWK `json:"keys"`
+	}
+	if err := s.do(req, &set); err != nil {
+		return nil, fmt.Errorf("fetching OIDC keys failed: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// Skip key types we cannot use rather than rejecting the whole set
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+// do sends req and decodes the JSON response into out, failing on non-2xx statuses
+func (s *OidcServiceImpl) do(req *http.Request, out any) error {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	decodeErr := json.Unmarshal(data, out)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s returned status %d", req.Method, req.URL.Redacted(), resp.StatusCode)
+	}
+	return decodeErr
+}
+
+// oidcJWK is a public key from a provider JWKS
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k oidcJWK) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("RSA exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeJWKInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeJWKInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty JWK parameter")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	domainservices "github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOidcProvider serves discovery, JWKS and token endpoints backed by an RSA key
+type fakeOidcProvider struct {
+	server     *httptest.Server
+	key        *rsa.PrivateKey
+	kid        string
+	issuer     string
+	idToken    func(issuer string) jwt.MapClaims
+	tokenForms []url.Values
+	jwksHits   int
+}
+
+func newFakeOidcProvider(t *testing.T) *fakeOidcProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &fakeOidcProvider{key: key, kid: "provider-key-1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := p.server.URL
+		if p.issuer != "" {
+			issuer = p.issuer
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		p.jwksHits++
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": p.kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		p.tokenForms = append(p.tokenForms, r.PostForm)
+		if r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, p.idToken(p.server.URL))
+		token.Header["kid"] = p.kid
+		signed, err := token.SignedString(p.key)
+		require.NoError(t, err)
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "id_token": signed})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	p.idToken = func(issuer string) jwt.MapClaims { return validIDTokenClaims(issuer) }
+	return p
+}
+
+func validIDTokenClaims(issuer string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            issuer,
+		"sub":            "subject-1",
+		"aud":            "client-1",
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          "nonce-1",
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"given_name":     "Jane",
+		"family_name":    "Doe",
+	}
+}
+
+// setupOidcEnv registers the fake provider as "test" and returns a service for it
+func setupOidcEnv(t *testing.T, p *fakeOidcProvider) domainservices.OidcService {
+	t.Helper()
+	t.Setenv("OIDC_PROVIDERS", "test")
+	t.Setenv("OIDC_TEST_ISSUER", p.server.URL)
+	t.Setenv("OIDC_TEST_CLIENT_ID", "client-1")
+	t.Setenv("OIDC_TEST_CLIENT_SECRET", "secret-1")
+	t.Setenv("OIDC_TEST_REDIRECT_URL", "https://app.example.com/callback")
+	setupJwtEnv(t, "1h")
+	return NewOidcService()
+}
+
+func TestOidcHasProvider(t *testing.T) {
+	svc := setupOidcEnv(t, newFakeOidcProvider(t))
+
+	assert.True(t, svc.HasProvider("test"))
+	assert.False(t, svc.HasProvider("other"))
+}
+
+func TestOidcAuthorizationURL(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	svc := setupOidcEnv(t, p)
+
+	rawURL, err := svc.AuthorizationURL(context.Background(), "test", "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	assert.Equal(t, p.server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+
+	q := u.Query()
+	challenge := sha256.Sum256([]byte("verifier-1"))
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "client-1", q.Get("client_id"))
+	assert.Equal(t, "https://app.example.com/callback", q.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, "state-1", q.Get("state"))
+	assert.Equal(t, "nonce-1", q.Get("nonce"))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(challenge[:]), q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+}
+
+func TestOidcAuthorizationURL_UnknownProvider(t *testing.T) {
+	svc := setupOidcEnv(t, newFakeOidcProvider(t))
+
+	_, err := svc.AuthorizationURL(context.Background(), "other", "s", "n", "v")
+	assert.Error(t, err)
+}
+
+func TestOidcExchange_Success(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	svc := setupOidcEnv(t, p)
+
+	claims, err := svc.Exchange(context.Background(), "test", "good-code", "verifier-1", "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, p.server.URL, claims.Issuer)
+	assert.Equal(t, "subject-1", claims.Subject)
+	assert.Equal(t, "jane@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "Jane", claims.GivenName)
+	assert.Equal(t, "Doe", claims.FamilyName)
+
+	require.Len(t, p.tokenForms, 1)
+	form := p.tokenForms[0]
+	assert.Equal(t, "authorization_code", form.Get("grant_type"))
+	assert.Equal(t, "verifier-1", form.Get("code_verifier"))
+	assert.Equal(t, "https://app.example.com/callback", form.Get("redirect_uri"))
+	assert.Equal(t, "secret-1", form.Get("client_secret"))
+}
+
+func TestOidcExchange_CachesKeys(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	svc := setupOidcEnv(t, p)
+
+	_, err := svc.Exchange(context.Background(), "test", "good-code", "v", "nonce-1")
+	require.NoError(t, err)
+	_, err = svc.Exchange(context.Background(), "test", "good-code", "v", "nonce-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, p.jwksHits)
+}
+
+func TestOidcExchange_RefetchesKeysOnRotation(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	svc := setupOidcEnv(t, p)
+
+	_, err := svc.Exchange(context.Background(), "test", "good-code", "v", "nonce-1")
+	require.NoError(t, err)
+
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p.key, p.kid = rotated, "provider-key-2"
+
+	_, err = svc.Exchange(context.Background(), "test", "good-code", "v", "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, p.jwksHits)
+}
+
+func TestOidcExchange_RejectedCode(t *testing.T) {
+	svc := setupOidcEnv(t, newFakeOidcProvider(t))
+
+	_, err := svc.Exchange(context.Background(), "test", "bad-code", "v", "nonce-1")
+	assert.ErrorContains(t, err, "invalid_grant")
+}
+
+func TestOidcExchange_InvalidIDTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(claims jwt.MapClaims)
+		nonce  string
+	}{
+		{"nonce mismatch", func(jwt.MapClaims) {}, "other-nonce"},
+		{"wrong audience", func(c jwt.MapClaims) { c["aud"] = "someone-else" }, "nonce-1"},
+		{"wrong issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, "nonce-1"},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, "nonce-1"},
+		{"missing expiry", func(c jwt.MapClaims) { delete(c, "exp") }, "nonce-1"},
+		{"wrong authorized party", func(c jwt.MapClaims) { c["azp"] = "someone-else" }, "nonce-1"},
+		{"missing subject", func(c jwt.MapClaims) { delete(c, "sub") }, "nonce-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newFakeOidcProvider(t)
+			p.idToken = func(issuer string) jwt.MapClaims {
+				claims := validIDTokenClaims(issuer)
+				tt.tamper(claims)
+				return claims
+			}
+			svc := setupOidcEnv(t, p)
+
+			_, err := svc.Exchange(context.Background(), "test", "good-code", "v", tt.nonce)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestOidcExchange_EmailVerifiedAsString(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	p.idToken = func(issuer string) jwt.MapClaims {
+		claims := validIDTokenClaims(issuer)
+		claims["email_verified"] = "true"
+		return claims
+	}
+	svc := setupOidcEnv(t, p)
+
+	claims, err := svc.Exchange(context.Background(), "test", "good-code", "v", "nonce-1")
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+}
+
+func TestOidcDiscovery_IssuerMismatch(t *testing.T) {
+	p := newFakeOidcProvider(t)
+	p.issuer = "https://evil.example.com"
+	svc := setupOidcEnv(t, p)
+
+	_, err := svc.AuthorizationURL(context.Background(), "test", "s", "n", "v")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+const opaqueTokenBytes = 32
+
+// OpaqueTokenService implements TokenService with random, URL-safe tokens hashed with SHA-256
+type OpaqueTokenService struct{}
+
+// NewOpaqueTokenService creates a new OpaqueTokenService
+func NewOpaqueTokenService() services.TokenService {
+	return &OpaqueTokenService{}
+}
+
+// Generate returns a new random token encoded as unpadded base64url
+func (s *OpaqueTokenService) Generate() (string, error) {
+	b := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash returns the hex-encoded SHA-256 digest of a token.
+// Tokens carry enough entropy that a fast, unsalted hash is sufficient for lookups.
+func (s *OpaqueTokenService) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
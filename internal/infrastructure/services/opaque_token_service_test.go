@@ -0,0 +1,36 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpaqueTokenGenerate_IsRandom(t *testing.T) {
+	svc := NewOpaqueTokenService()
+
+	token1, err := svc.Generate()
+	require.NoError(t, err)
+	token2, err := svc.Generate()
+	require.NoError(t, err)
+
+	assert.Len(t, token1, 43, "32 random bytes encode to 43 base64url characters")
+	assert.NotEqual(t, token1, token2)
+}
+
+func TestOpaqueTokenHash_IsDeterministic(t *testing.T) {
+	svc := NewOpaqueTokenService()
+
+	assert.Equal(t, svc.Hash("some-token"), svc.Hash("some-token"))
+	assert.NotEqual(t, svc.Hash("some-token"), svc.Hash("other-token"))
+	assert.Len(t, svc.Hash("some-token"), 64)
+}
+
+func TestOpaqueTokenHash_DoesNotContainToken(t *testing.T) {
+	svc := NewOpaqueTokenService()
+
+	token, err := svc.Generate()
+	require.NoError(t, err)
+	assert.NotContains(t, svc.Hash(token), token)
+}
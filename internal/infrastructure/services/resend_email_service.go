@@ -3,6 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/lgxju/gogretago/config"
 	"github.com/lgxju/gogretago/internal/domain/services"
@@ -13,6 +17,7 @@ import (
 type ResendEmailService struct {
 	client    *resend.Client
 	fromEmail string
+	appURL    string
 }
 
 // NewResendEmailService creates a new ResendEmailService
@@ -21,6 +26,7 @@ func NewResendEmailService() services.EmailService {
 	return &ResendEmailService{
 		client:    resend.NewClient(cfg.ResendAPIKey),
 		fromEmail: cfg.ResendFromEmail,
+		appURL:    strings.TrimSuffix(cfg.AppURL, "/"),
 	}
 }
 
@@ -39,6 +45,114 @@ func (s *ResendEmailService) SendWelcomeEmail(to, firstName string) error {
 	})
 }
 
+// SendPasswordResetEmail sends a link that lets the user choose a new password
+func (s *ResendEmailService) SendPasswordResetEmail(to, token string) error {
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.appURL, url.QueryEscape(token))
+	html := fmt.Sprintf(`
+		<h1>Reset your password</h1>
+		<p>We received a request to reset the password for your account.</p>
+		<p><a href="%s">Choose a new password</a></p>
+		<p>This link expires in one hour and can only be used once. If you did not ask for it, you can ignore this email.</p>
+	`, link)
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Reset your password",
+		HTML:    html,
+	})
+}
+
+// SendVerificationEmail sends a link that confirms the user owns the address
+func (s *ResendEmailService) SendVerificationEmail(to, token string) error {
+	link := fmt.Sprintf("%s/verify-email?token=%s", s.appURL, url.QueryEscape(token))
+	html := fmt.Sprintf(`
+		<h1>Confirm your email address</h1>
+		<p>Please confirm this address to start offering and booking rides.</p>
+		<p><a href="%s">Verify my email</a></p>
+		<p>This link expires in 48 hours. If you did not create an account, you can ignore this email.</p>
+	`, link)
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Confirm your email address",
+		HTML:    html,
+	})
+}
+
+// SendMagicLinkEmail sends a link that signs the user in without a password
+func (s *ResendEmailService) SendMagicLinkEmail(to, token string) error {
+	link := fmt.Sprintf("%s/magic-link?email=%s&token=%s", s.appURL, url.QueryEscape(to), url.QueryEscape(token))
+	html := fmt.Sprintf(`
+		<h1>Sign in to Carpooling</h1>
+		<p>Use the link below to sign in without your password.</p>
+		<p><a href="%s">Sign me in</a></p>
+		<p>This link expires in 15 minutes and can only be used once. If you did not ask for it, you can ignore this email.</p>
+	`, link)
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Your sign-in link",
+		HTML:    html,
+	})
+}
+
+// SendTripCancelledEmail tells a passenger that the driver cancelled a trip they booked
+func (s *ResendEmailService) SendTripCancelledEmail(to, firstName string, dateTrip time.Time, reason string) error {
+	body := fmt.Sprintf(`
+		<h1>Your ride on %s was cancelled</h1>
+		<p>Hello %s, the driver cancelled the trip you booked and your seat has been released.</p>
+		<p>Reason given by the driver: %s</p>
+		<p>You can search for another ride on the platform.</p>
+	`, dateTrip.Format("January 2, 2006 at 15:04 MST"), html.EscapeString(firstName), html.EscapeString(reason))
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Your ride was cancelled",
+		HTML:    body,
+	})
+}
+
+// SendTripChangedEmail tells a passenger what the driver changed on a trip they booked, and asks them
+// to confirm their seat again when the change requires it
+func (s *ResendEmailService) SendTripChangedEmail(to, firstName string, dateTrip time.Time, changes []string, reconfirm bool) error {
+	var items strings.Builder
+	for _, change := range changes {
+		items.WriteString("<li>" + html.EscapeString(change) + "</li>")
+	}
+	next := "<p>Your booking is unchanged.</p>"
+	if reconfirm {
+		next = "<p>Please confirm your seat on the platform, or cancel your booking if the new ride does not suit you.</p>"
+	}
+	body := fmt.Sprintf(`
+		<h1>Your ride on %s was changed</h1>
+		<p>Hello %s, the driver changed the trip you booked:</p>
+		<ul>%s</ul>
+		%s
+	`, dateTrip.Format("January 2, 2006 at 15:04 MST"), html.EscapeString(firstName), items.String(), next)
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Your ride was changed",
+		HTML:    body,
+	})
+}
+
+// SendTripSeriesCancelledEmail tells a passenger that the driver stopped a recurring trip they subscribed to
+func (s *ResendEmailService) SendTripSeriesCancelledEmail(to, firstName, departureCity, arrivalCity, reason string) error {
+	body := fmt.Sprintf(`
+		<h1>Your rides from %s to %s were cancelled</h1>
+		<p>Hello %s, the driver stopped this recurring trip and your upcoming seats have been released.</p>
+		<p>Reason given by the driver: %s</p>
+		<p>You can search for another ride on the platform.</p>
+	`, html.EscapeString(departureCity), html.EscapeString(arrivalCity), html.EscapeString(firstName), html.EscapeString(reason))
+
+	return s.Send(services.SendEmailOptions{
+		To:      to,
+		Subject: "Your recurring ride was cancelled",
+		HTML:    body,
+	})
+}
+
 // Send sends an email using Resend
 func (s *ResendEmailService) Send(options services.SendEmailOptions) error {
 	params := &resend.SendEmailRequest{
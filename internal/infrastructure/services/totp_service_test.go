@@ -0,0 +1,91 @@
+package services
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 appendix B test secret "12345678901234567890"
+var rfcTotpSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestTotpValidate_RFC6238Vectors(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+
+	// The RFC lists 8-digit values; the 6-digit code is their last six digits
+	vectors := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, v := range vectors {
+		step, ok := svc.Validate(rfcTotpSecret, v.code, time.Unix(v.unix, 0))
+		assert.True(t, ok, "code %s at %d", v.code, v.unix)
+		assert.Equal(t, v.unix/30, step)
+	}
+}
+
+func TestTotpValidate_AllowsOneStepOfDrift(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+	at := time.Unix(1111111109, 0)
+
+	step, ok := svc.Validate(rfcTotpSecret, "081804", at.Add(30*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1111111109/30), step)
+
+	_, ok = svc.Validate(rfcTotpSecret, "081804", at.Add(90*time.Second))
+	assert.False(t, ok)
+}
+
+func TestTotpValidate_RejectsMalformedInput(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+	now := time.Unix(59, 0)
+
+	_, ok := svc.Validate(rfcTotpSecret, "12345", now)
+	assert.False(t, ok)
+	_, ok = svc.Validate("not base32!", "287082", now)
+	assert.False(t, ok)
+}
+
+func TestTotpGenerateSecret_IsRandomBase32(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+
+	secret1, err := svc.GenerateSecret()
+	require.NoError(t, err)
+	secret2, err := svc.GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, secret1, 32, "20 random bytes encode to 32 base32 characters")
+	assert.NotEqual(t, secret1, secret2)
+}
+
+func TestTotpProvisioningURI(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+
+	uri, err := url.Parse(svc.ProvisioningURI("JBSWY3DPEHPK3PXP", "user@example.com"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/CovoitAPI:user@example.com", uri.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	assert.Equal(t, "CovoitAPI", uri.Query().Get("issuer"))
+}
+
+func TestTotpGenerateRecoveryCode_Format(t *testing.T) {
+	svc := &TotpServiceImpl{issuer: "CovoitAPI"}
+
+	code, err := svc.GenerateRecoveryCode()
+	require.NoError(t, err)
+	assert.Regexp(t, `^[A-Z2-7]{4}(-[A-Z2-7]{4}){3}$`, code)
+}
@@ -7,6 +7,8 @@ import (
 
 	entities "github.com/lgxju/gogretago/internal/domain/entities"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockAuthRepository is an autogenerated mock type for the AuthRepository type
@@ -22,6 +24,54 @@ func (_m *MockAuthRepository) EXPECT() *MockAuthRepository_Expecter {
 	return &MockAuthRepository_Expecter{mock: &_m.Mock}
 }
 
+// Ban provides a mock function with given fields: ctx, refID, reason
+func (_m *MockAuthRepository) Ban(ctx context.Context, refID int64, reason string) error {
+	ret := _m.Called(ctx, refID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ban")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, refID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_Ban_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ban'
+type MockAuthRepository_Ban_Call struct {
+	*mock.Call
+}
+
+// Ban is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - reason string
+func (_e *MockAuthRepository_Expecter) Ban(ctx interface{}, refID interface{}, reason interface{}) *MockAuthRepository_Ban_Call {
+	return &MockAuthRepository_Ban_Call{Call: _e.mock.On("Ban", ctx, refID, reason)}
+}
+
+func (_c *MockAuthRepository_Ban_Call) Run(run func(ctx context.Context, refID int64, reason string)) *MockAuthRepository_Ban_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_Ban_Call) Return(_a0 error) *MockAuthRepository_Ban_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_Ban_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockAuthRepository_Ban_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWithUser provides a mock function with given fields: ctx, authData, userData
 func (_m *MockAuthRepository) CreateWithUser(ctx context.Context, authData entities.CreateAuthData, userData entities.CreateUserData) (*entities.Auth, *entities.PublicUser, error) {
 	ret := _m.Called(ctx, authData, userData)
@@ -91,6 +141,53 @@ func (_c *MockAuthRepository_CreateWithUser_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// EnableTotp provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) EnableTotp(ctx context.Context, refID int64) error {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableTotp")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_EnableTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableTotp'
+type MockAuthRepository_EnableTotp_Call struct {
+	*mock.Call
+}
+
+// EnableTotp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) EnableTotp(ctx interface{}, refID interface{}) *MockAuthRepository_EnableTotp_Call {
+	return &MockAuthRepository_EnableTotp_Call{Call: _e.mock.On("EnableTotp", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_EnableTotp_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_EnableTotp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_EnableTotp_Call) Return(_a0 error) *MockAuthRepository_EnableTotp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_EnableTotp_Call) RunAndReturn(run func(context.Context, int64) error) *MockAuthRepository_EnableTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsByEmail provides a mock function with given fields: ctx, email
 func (_m *MockAuthRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	ret := _m.Called(ctx, email)
@@ -207,6 +304,515 @@ func (_c *MockAuthRepository_FindByEmail_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// FindByRefID provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Auth, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRefID")
+	}
+
+	var r0 *entities.Auth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*entities.Auth, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *entities.Auth); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Auth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthRepository_FindByRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRefID'
+type MockAuthRepository_FindByRefID_Call struct {
+	*mock.Call
+}
+
+// FindByRefID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) FindByRefID(ctx interface{}, refID interface{}) *MockAuthRepository_FindByRefID_Call {
+	return &MockAuthRepository_FindByRefID_Call{Call: _e.mock.On("FindByRefID", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_FindByRefID_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_FindByRefID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_FindByRefID_Call) Return(_a0 *entities.Auth, _a1 error) *MockAuthRepository_FindByRefID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthRepository_FindByRefID_Call) RunAndReturn(run func(context.Context, int64) (*entities.Auth, error)) *MockAuthRepository_FindByRefID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementFailedLogins provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) IncrementFailedLogins(ctx context.Context, refID int64) (int, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementFailedLogins")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthRepository_IncrementFailedLogins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementFailedLogins'
+type MockAuthRepository_IncrementFailedLogins_Call struct {
+	*mock.Call
+}
+
+// IncrementFailedLogins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) IncrementFailedLogins(ctx interface{}, refID interface{}) *MockAuthRepository_IncrementFailedLogins_Call {
+	return &MockAuthRepository_IncrementFailedLogins_Call{Call: _e.mock.On("IncrementFailedLogins", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_IncrementFailedLogins_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_IncrementFailedLogins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_IncrementFailedLogins_Call) Return(_a0 int, _a1 error) *MockAuthRepository_IncrementFailedLogins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthRepository_IncrementFailedLogins_Call) RunAndReturn(run func(context.Context, int64) (int, error)) *MockAuthRepository_IncrementFailedLogins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockUntil provides a mock function with given fields: ctx, refID, until
+func (_m *MockAuthRepository) LockUntil(ctx context.Context, refID int64, until time.Time) error {
+	ret := _m.Called(ctx, refID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockUntil")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, refID, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_LockUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUntil'
+type MockAuthRepository_LockUntil_Call struct {
+	*mock.Call
+}
+
+// LockUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - until time.Time
+func (_e *MockAuthRepository_Expecter) LockUntil(ctx interface{}, refID interface{}, until interface{}) *MockAuthRepository_LockUntil_Call {
+	return &MockAuthRepository_LockUntil_Call{Call: _e.mock.On("LockUntil", ctx, refID, until)}
+}
+
+func (_c *MockAuthRepository_LockUntil_Call) Run(run func(ctx context.Context, refID int64, until time.Time)) *MockAuthRepository_LockUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_LockUntil_Call) Return(_a0 error) *MockAuthRepository_LockUntil_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_LockUntil_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *MockAuthRepository_LockUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailVerified provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) MarkEmailVerified(ctx context.Context, refID int64) error {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockAuthRepository_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) MarkEmailVerified(ctx interface{}, refID interface{}) *MockAuthRepository_MarkEmailVerified_Call {
+	return &MockAuthRepository_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_MarkEmailVerified_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_MarkEmailVerified_Call) Return(_a0 error) *MockAuthRepository_MarkEmailVerified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_MarkEmailVerified_Call) RunAndReturn(run func(context.Context, int64) error) *MockAuthRepository_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reinstate provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) Reinstate(ctx context.Context, refID int64) error {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reinstate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_Reinstate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reinstate'
+type MockAuthRepository_Reinstate_Call struct {
+	*mock.Call
+}
+
+// Reinstate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) Reinstate(ctx interface{}, refID interface{}) *MockAuthRepository_Reinstate_Call {
+	return &MockAuthRepository_Reinstate_Call{Call: _e.mock.On("Reinstate", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_Reinstate_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_Reinstate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_Reinstate_Call) Return(_a0 error) *MockAuthRepository_Reinstate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_Reinstate_Call) RunAndReturn(run func(context.Context, int64) error) *MockAuthRepository_Reinstate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePasswordHash provides a mock function with given fields: ctx, refID, oldHash, newHash
+func (_m *MockAuthRepository) ReplacePasswordHash(ctx context.Context, refID int64, oldHash string, newHash string) (bool, error) {
+	ret := _m.Called(ctx, refID, oldHash, newHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePasswordHash")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (bool, error)); ok {
+		return rf(ctx, refID, oldHash, newHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) bool); ok {
+		r0 = rf(ctx, refID, oldHash, newHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, refID, oldHash, newHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthRepository_ReplacePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePasswordHash'
+type MockAuthRepository_ReplacePasswordHash_Call struct {
+	*mock.Call
+}
+
+// ReplacePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - oldHash string
+//   - newHash string
+func (_e *MockAuthRepository_Expecter) ReplacePasswordHash(ctx interface{}, refID interface{}, oldHash interface{}, newHash interface{}) *MockAuthRepository_ReplacePasswordHash_Call {
+	return &MockAuthRepository_ReplacePasswordHash_Call{Call: _e.mock.On("ReplacePasswordHash", ctx, refID, oldHash, newHash)}
+}
+
+func (_c *MockAuthRepository_ReplacePasswordHash_Call) Run(run func(ctx context.Context, refID int64, oldHash string, newHash string)) *MockAuthRepository_ReplacePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_ReplacePasswordHash_Call) Return(_a0 bool, _a1 error) *MockAuthRepository_ReplacePasswordHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthRepository_ReplacePasswordHash_Call) RunAndReturn(run func(context.Context, int64, string, string) (bool, error)) *MockAuthRepository_ReplacePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetFailedLogins provides a mock function with given fields: ctx, refID
+func (_m *MockAuthRepository) ResetFailedLogins(ctx context.Context, refID int64) error {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetFailedLogins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_ResetFailedLogins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetFailedLogins'
+type MockAuthRepository_ResetFailedLogins_Call struct {
+	*mock.Call
+}
+
+// ResetFailedLogins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockAuthRepository_Expecter) ResetFailedLogins(ctx interface{}, refID interface{}) *MockAuthRepository_ResetFailedLogins_Call {
+	return &MockAuthRepository_ResetFailedLogins_Call{Call: _e.mock.On("ResetFailedLogins", ctx, refID)}
+}
+
+func (_c *MockAuthRepository_ResetFailedLogins_Call) Run(run func(ctx context.Context, refID int64)) *MockAuthRepository_ResetFailedLogins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_ResetFailedLogins_Call) Return(_a0 error) *MockAuthRepository_ResetFailedLogins_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_ResetFailedLogins_Call) RunAndReturn(run func(context.Context, int64) error) *MockAuthRepository_ResetFailedLogins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTotpEnrollment provides a mock function with given fields: ctx, refID, secret
+func (_m *MockAuthRepository) StartTotpEnrollment(ctx context.Context, refID int64, secret string) error {
+	ret := _m.Called(ctx, refID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTotpEnrollment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, refID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_StartTotpEnrollment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTotpEnrollment'
+type MockAuthRepository_StartTotpEnrollment_Call struct {
+	*mock.Call
+}
+
+// StartTotpEnrollment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - secret string
+func (_e *MockAuthRepository_Expecter) StartTotpEnrollment(ctx interface{}, refID interface{}, secret interface{}) *MockAuthRepository_StartTotpEnrollment_Call {
+	return &MockAuthRepository_StartTotpEnrollment_Call{Call: _e.mock.On("StartTotpEnrollment", ctx, refID, secret)}
+}
+
+func (_c *MockAuthRepository_StartTotpEnrollment_Call) Run(run func(ctx context.Context, refID int64, secret string)) *MockAuthRepository_StartTotpEnrollment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_StartTotpEnrollment_Call) Return(_a0 error) *MockAuthRepository_StartTotpEnrollment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_StartTotpEnrollment_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockAuthRepository_StartTotpEnrollment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suspend provides a mock function with given fields: ctx, refID, until, reason
+func (_m *MockAuthRepository) Suspend(ctx context.Context, refID int64, until time.Time, reason string) error {
+	ret := _m.Called(ctx, refID, until, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suspend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) error); ok {
+		r0 = rf(ctx, refID, until, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_Suspend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suspend'
+type MockAuthRepository_Suspend_Call struct {
+	*mock.Call
+}
+
+// Suspend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - until time.Time
+//   - reason string
+func (_e *MockAuthRepository_Expecter) Suspend(ctx interface{}, refID interface{}, until interface{}, reason interface{}) *MockAuthRepository_Suspend_Call {
+	return &MockAuthRepository_Suspend_Call{Call: _e.mock.On("Suspend", ctx, refID, until, reason)}
+}
+
+func (_c *MockAuthRepository_Suspend_Call) Run(run func(ctx context.Context, refID int64, until time.Time, reason string)) *MockAuthRepository_Suspend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_Suspend_Call) Return(_a0 error) *MockAuthRepository_Suspend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_Suspend_Call) RunAndReturn(run func(context.Context, int64, time.Time, string) error) *MockAuthRepository_Suspend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, refID, passwordHash
+func (_m *MockAuthRepository) UpdatePassword(ctx context.Context, refID int64, passwordHash string) error {
+	ret := _m.Called(ctx, refID, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, refID, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockAuthRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - passwordHash string
+func (_e *MockAuthRepository_Expecter) UpdatePassword(ctx interface{}, refID interface{}, passwordHash interface{}) *MockAuthRepository_UpdatePassword_Call {
+	return &MockAuthRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, refID, passwordHash)}
+}
+
+func (_c *MockAuthRepository_UpdatePassword_Call) Run(run func(ctx context.Context, refID int64, passwordHash string)) *MockAuthRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_UpdatePassword_Call) Return(_a0 error) *MockAuthRepository_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthRepository_UpdatePassword_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockAuthRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRole provides a mock function with given fields: ctx, refID, role
 func (_m *MockAuthRepository) UpdateRole(ctx context.Context, refID int64, role string) error {
 	ret := _m.Called(ctx, refID, role)
@@ -255,6 +861,64 @@ func (_c *MockAuthRepository_UpdateRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UseTotpStep provides a mock function with given fields: ctx, refID, step
+func (_m *MockAuthRepository) UseTotpStep(ctx context.Context, refID int64, step int64) (bool, error) {
+	ret := _m.Called(ctx, refID, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseTotpStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, refID, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, refID, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, refID, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthRepository_UseTotpStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseTotpStep'
+type MockAuthRepository_UseTotpStep_Call struct {
+	*mock.Call
+}
+
+// UseTotpStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+//   - step int64
+func (_e *MockAuthRepository_Expecter) UseTotpStep(ctx interface{}, refID interface{}, step interface{}) *MockAuthRepository_UseTotpStep_Call {
+	return &MockAuthRepository_UseTotpStep_Call{Call: _e.mock.On("UseTotpStep", ctx, refID, step)}
+}
+
+func (_c *MockAuthRepository_UseTotpStep_Call) Run(run func(ctx context.Context, refID int64, step int64)) *MockAuthRepository_UseTotpStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuthRepository_UseTotpStep_Call) Return(_a0 bool, _a1 error) *MockAuthRepository_UseTotpStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthRepository_UseTotpStep_Call) RunAndReturn(run func(context.Context, int64, int64) (bool, error)) *MockAuthRepository_UseTotpStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAuthRepository creates a new instance of MockAuthRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAuthRepository(t interface {
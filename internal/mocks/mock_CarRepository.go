@@ -311,6 +311,65 @@ func (_c *MockCarRepository_FindByID_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FindByRefID provides a mock function with given fields: ctx, refID
+func (_m *MockCarRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Car, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRefID")
+	}
+
+	var r0 *entities.Car
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*entities.Car, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *entities.Car); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCarRepository_FindByRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRefID'
+type MockCarRepository_FindByRefID_Call struct {
+	*mock.Call
+}
+
+// FindByRefID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockCarRepository_Expecter) FindByRefID(ctx interface{}, refID interface{}) *MockCarRepository_FindByRefID_Call {
+	return &MockCarRepository_FindByRefID_Call{Call: _e.mock.On("FindByRefID", ctx, refID)}
+}
+
+func (_c *MockCarRepository_FindByRefID_Call) Run(run func(ctx context.Context, refID int64)) *MockCarRepository_FindByRefID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCarRepository_FindByRefID_Call) Return(_a0 *entities.Car, _a1 error) *MockCarRepository_FindByRefID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCarRepository_FindByRefID_Call) RunAndReturn(run func(context.Context, int64) (*entities.Car, error)) *MockCarRepository_FindByRefID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, data
 func (_m *MockCarRepository) Update(ctx context.Context, id string, data entities.UpdateCarData) (*entities.Car, error) {
 	ret := _m.Called(ctx, id, data)
@@ -313,6 +313,66 @@ func (_c *MockCityRepository_FindByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Update provides a mock function with given fields: ctx, id, data
+func (_m *MockCityRepository) Update(ctx context.Context, id string, data entities.UpdateCityData) (*entities.City, error) {
+	ret := _m.Called(ctx, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *entities.City
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.UpdateCityData) (*entities.City, error)); ok {
+		return rf(ctx, id, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.UpdateCityData) *entities.City); ok {
+		r0 = rf(ctx, id, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.City)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entities.UpdateCityData) error); ok {
+		r1 = rf(ctx, id, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCityRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCityRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - data entities.UpdateCityData
+func (_e *MockCityRepository_Expecter) Update(ctx interface{}, id interface{}, data interface{}) *MockCityRepository_Update_Call {
+	return &MockCityRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, data)}
+}
+
+func (_c *MockCityRepository_Update_Call) Run(run func(ctx context.Context, id string, data entities.UpdateCityData)) *MockCityRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entities.UpdateCityData))
+	})
+	return _c
+}
+
+func (_c *MockCityRepository_Update_Call) Return(_a0 *entities.City, _a1 error) *MockCityRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCityRepository_Update_Call) RunAndReturn(run func(context.Context, string, entities.UpdateCityData) (*entities.City, error)) *MockCityRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCityRepository creates a new instance of MockCityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCityRepository(t interface {
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	services "github.com/lgxju/gogretago/internal/domain/services"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -66,6 +68,296 @@ func (_c *MockEmailService_Send_Call) RunAndReturn(run func(services.SendEmailOp
 	return _c
 }
 
+// SendMagicLinkEmail provides a mock function with given fields: to, token
+func (_m *MockEmailService) SendMagicLinkEmail(to string, token string) error {
+	ret := _m.Called(to, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMagicLinkEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(to, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendMagicLinkEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMagicLinkEmail'
+type MockEmailService_SendMagicLinkEmail_Call struct {
+	*mock.Call
+}
+
+// SendMagicLinkEmail is a helper method to define mock.On call
+//   - to string
+//   - token string
+func (_e *MockEmailService_Expecter) SendMagicLinkEmail(to interface{}, token interface{}) *MockEmailService_SendMagicLinkEmail_Call {
+	return &MockEmailService_SendMagicLinkEmail_Call{Call: _e.mock.On("SendMagicLinkEmail", to, token)}
+}
+
+func (_c *MockEmailService_SendMagicLinkEmail_Call) Run(run func(to string, token string)) *MockEmailService_SendMagicLinkEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendMagicLinkEmail_Call) Return(_a0 error) *MockEmailService_SendMagicLinkEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendMagicLinkEmail_Call) RunAndReturn(run func(string, string) error) *MockEmailService_SendMagicLinkEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendPasswordResetEmail provides a mock function with given fields: to, token
+func (_m *MockEmailService) SendPasswordResetEmail(to string, token string) error {
+	ret := _m.Called(to, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPasswordResetEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(to, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendPasswordResetEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPasswordResetEmail'
+type MockEmailService_SendPasswordResetEmail_Call struct {
+	*mock.Call
+}
+
+// SendPasswordResetEmail is a helper method to define mock.On call
+//   - to string
+//   - token string
+func (_e *MockEmailService_Expecter) SendPasswordResetEmail(to interface{}, token interface{}) *MockEmailService_SendPasswordResetEmail_Call {
+	return &MockEmailService_SendPasswordResetEmail_Call{Call: _e.mock.On("SendPasswordResetEmail", to, token)}
+}
+
+func (_c *MockEmailService_SendPasswordResetEmail_Call) Run(run func(to string, token string)) *MockEmailService_SendPasswordResetEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendPasswordResetEmail_Call) Return(_a0 error) *MockEmailService_SendPasswordResetEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendPasswordResetEmail_Call) RunAndReturn(run func(string, string) error) *MockEmailService_SendPasswordResetEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTripCancelledEmail provides a mock function with given fields: to, firstName, dateTrip, reason
+func (_m *MockEmailService) SendTripCancelledEmail(to string, firstName string, dateTrip time.Time, reason string) error {
+	ret := _m.Called(to, firstName, dateTrip, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTripCancelledEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, string) error); ok {
+		r0 = rf(to, firstName, dateTrip, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendTripCancelledEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTripCancelledEmail'
+type MockEmailService_SendTripCancelledEmail_Call struct {
+	*mock.Call
+}
+
+// SendTripCancelledEmail is a helper method to define mock.On call
+//   - to string
+//   - firstName string
+//   - dateTrip time.Time
+//   - reason string
+func (_e *MockEmailService_Expecter) SendTripCancelledEmail(to interface{}, firstName interface{}, dateTrip interface{}, reason interface{}) *MockEmailService_SendTripCancelledEmail_Call {
+	return &MockEmailService_SendTripCancelledEmail_Call{Call: _e.mock.On("SendTripCancelledEmail", to, firstName, dateTrip, reason)}
+}
+
+func (_c *MockEmailService_SendTripCancelledEmail_Call) Run(run func(to string, firstName string, dateTrip time.Time, reason string)) *MockEmailService_SendTripCancelledEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendTripCancelledEmail_Call) Return(_a0 error) *MockEmailService_SendTripCancelledEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendTripCancelledEmail_Call) RunAndReturn(run func(string, string, time.Time, string) error) *MockEmailService_SendTripCancelledEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTripChangedEmail provides a mock function with given fields: to, firstName, dateTrip, changes, reconfirm
+func (_m *MockEmailService) SendTripChangedEmail(to string, firstName string, dateTrip time.Time, changes []string, reconfirm bool) error {
+	ret := _m.Called(to, firstName, dateTrip, changes, reconfirm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTripChangedEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, []string, bool) error); ok {
+		r0 = rf(to, firstName, dateTrip, changes, reconfirm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendTripChangedEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTripChangedEmail'
+type MockEmailService_SendTripChangedEmail_Call struct {
+	*mock.Call
+}
+
+// SendTripChangedEmail is a helper method to define mock.On call
+//   - to string
+//   - firstName string
+//   - dateTrip time.Time
+//   - changes []string
+//   - reconfirm bool
+func (_e *MockEmailService_Expecter) SendTripChangedEmail(to interface{}, firstName interface{}, dateTrip interface{}, changes interface{}, reconfirm interface{}) *MockEmailService_SendTripChangedEmail_Call {
+	return &MockEmailService_SendTripChangedEmail_Call{Call: _e.mock.On("SendTripChangedEmail", to, firstName, dateTrip, changes, reconfirm)}
+}
+
+func (_c *MockEmailService_SendTripChangedEmail_Call) Run(run func(to string, firstName string, dateTrip time.Time, changes []string, reconfirm bool)) *MockEmailService_SendTripChangedEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].([]string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendTripChangedEmail_Call) Return(_a0 error) *MockEmailService_SendTripChangedEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendTripChangedEmail_Call) RunAndReturn(run func(string, string, time.Time, []string, bool) error) *MockEmailService_SendTripChangedEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTripSeriesCancelledEmail provides a mock function with given fields: to, firstName, departureCity, arrivalCity, reason
+func (_m *MockEmailService) SendTripSeriesCancelledEmail(to string, firstName string, departureCity string, arrivalCity string, reason string) error {
+	ret := _m.Called(to, firstName, departureCity, arrivalCity, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTripSeriesCancelledEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string) error); ok {
+		r0 = rf(to, firstName, departureCity, arrivalCity, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendTripSeriesCancelledEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTripSeriesCancelledEmail'
+type MockEmailService_SendTripSeriesCancelledEmail_Call struct {
+	*mock.Call
+}
+
+// SendTripSeriesCancelledEmail is a helper method to define mock.On call
+//   - to string
+//   - firstName string
+//   - departureCity string
+//   - arrivalCity string
+//   - reason string
+func (_e *MockEmailService_Expecter) SendTripSeriesCancelledEmail(to interface{}, firstName interface{}, departureCity interface{}, arrivalCity interface{}, reason interface{}) *MockEmailService_SendTripSeriesCancelledEmail_Call {
+	return &MockEmailService_SendTripSeriesCancelledEmail_Call{Call: _e.mock.On("SendTripSeriesCancelledEmail", to, firstName, departureCity, arrivalCity, reason)}
+}
+
+func (_c *MockEmailService_SendTripSeriesCancelledEmail_Call) Run(run func(to string, firstName string, departureCity string, arrivalCity string, reason string)) *MockEmailService_SendTripSeriesCancelledEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendTripSeriesCancelledEmail_Call) Return(_a0 error) *MockEmailService_SendTripSeriesCancelledEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendTripSeriesCancelledEmail_Call) RunAndReturn(run func(string, string, string, string, string) error) *MockEmailService_SendTripSeriesCancelledEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendVerificationEmail provides a mock function with given fields: to, token
+func (_m *MockEmailService) SendVerificationEmail(to string, token string) error {
+	ret := _m.Called(to, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendVerificationEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(to, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendVerificationEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendVerificationEmail'
+type MockEmailService_SendVerificationEmail_Call struct {
+	*mock.Call
+}
+
+// SendVerificationEmail is a helper method to define mock.On call
+//   - to string
+//   - token string
+func (_e *MockEmailService_Expecter) SendVerificationEmail(to interface{}, token interface{}) *MockEmailService_SendVerificationEmail_Call {
+	return &MockEmailService_SendVerificationEmail_Call{Call: _e.mock.On("SendVerificationEmail", to, token)}
+}
+
+func (_c *MockEmailService_SendVerificationEmail_Call) Run(run func(to string, token string)) *MockEmailService_SendVerificationEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendVerificationEmail_Call) Return(_a0 error) *MockEmailService_SendVerificationEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendVerificationEmail_Call) RunAndReturn(run func(string, string) error) *MockEmailService_SendVerificationEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendWelcomeEmail provides a mock function with given fields: to, firstName
 func (_m *MockEmailService) SendWelcomeEmail(to string, firstName string) error {
 	ret := _m.Called(to, firstName)
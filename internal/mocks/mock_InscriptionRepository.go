@@ -22,27 +22,29 @@ func (_m *MockInscriptionRepository) EXPECT() *MockInscriptionRepository_Expecte
 	return &MockInscriptionRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountByTripRefID provides a mock function with given fields: ctx, tripRefID
-func (_m *MockInscriptionRepository) CountByTripRefID(ctx context.Context, tripRefID int64) (int, error) {
-	ret := _m.Called(ctx, tripRefID)
+// Book provides a mock function with given fields: ctx, trip, data
+func (_m *MockInscriptionRepository) Book(ctx context.Context, trip *entities.Trip, data entities.CreateInscriptionData) (*entities.Inscription, error) {
+	ret := _m.Called(ctx, trip, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountByTripRefID")
+		panic("no return value specified for Book")
 	}
 
-	var r0 int
+	var r0 *entities.Inscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
-		return rf(ctx, tripRefID)
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Trip, entities.CreateInscriptionData) (*entities.Inscription, error)); ok {
+		return rf(ctx, trip, data)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
-		r0 = rf(ctx, tripRefID)
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Trip, entities.CreateInscriptionData) *entities.Inscription); ok {
+		r0 = rf(ctx, trip, data)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Inscription)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, tripRefID)
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.Trip, entities.CreateInscriptionData) error); ok {
+		r1 = rf(ctx, trip, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,58 +52,57 @@ func (_m *MockInscriptionRepository) CountByTripRefID(ctx context.Context, tripR
 	return r0, r1
 }
 
-// MockInscriptionRepository_CountByTripRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByTripRefID'
-type MockInscriptionRepository_CountByTripRefID_Call struct {
+// MockInscriptionRepository_Book_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Book'
+type MockInscriptionRepository_Book_Call struct {
 	*mock.Call
 }
 
-// CountByTripRefID is a helper method to define mock.On call
+// Book is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tripRefID int64
-func (_e *MockInscriptionRepository_Expecter) CountByTripRefID(ctx interface{}, tripRefID interface{}) *MockInscriptionRepository_CountByTripRefID_Call {
-	return &MockInscriptionRepository_CountByTripRefID_Call{Call: _e.mock.On("CountByTripRefID", ctx, tripRefID)}
+//   - trip *entities.Trip
+//   - data entities.CreateInscriptionData
+func (_e *MockInscriptionRepository_Expecter) Book(ctx interface{}, trip interface{}, data interface{}) *MockInscriptionRepository_Book_Call {
+	return &MockInscriptionRepository_Book_Call{Call: _e.mock.On("Book", ctx, trip, data)}
 }
 
-func (_c *MockInscriptionRepository_CountByTripRefID_Call) Run(run func(ctx context.Context, tripRefID int64)) *MockInscriptionRepository_CountByTripRefID_Call {
+func (_c *MockInscriptionRepository_Book_Call) Run(run func(ctx context.Context, trip *entities.Trip, data entities.CreateInscriptionData)) *MockInscriptionRepository_Book_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(*entities.Trip), args[2].(entities.CreateInscriptionData))
 	})
 	return _c
 }
 
-func (_c *MockInscriptionRepository_CountByTripRefID_Call) Return(_a0 int, _a1 error) *MockInscriptionRepository_CountByTripRefID_Call {
+func (_c *MockInscriptionRepository_Book_Call) Return(_a0 *entities.Inscription, _a1 error) *MockInscriptionRepository_Book_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInscriptionRepository_CountByTripRefID_Call) RunAndReturn(run func(context.Context, int64) (int, error)) *MockInscriptionRepository_CountByTripRefID_Call {
+func (_c *MockInscriptionRepository_Book_Call) RunAndReturn(run func(context.Context, *entities.Trip, entities.CreateInscriptionData) (*entities.Inscription, error)) *MockInscriptionRepository_Book_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, data
-func (_m *MockInscriptionRepository) Create(ctx context.Context, data entities.CreateInscriptionData) (*entities.Inscription, error) {
-	ret := _m.Called(ctx, data)
+// CountByTripRefID provides a mock function with given fields: ctx, tripRefID
+func (_m *MockInscriptionRepository) CountByTripRefID(ctx context.Context, tripRefID int64) (int, error) {
+	ret := _m.Called(ctx, tripRefID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for CountByTripRefID")
 	}
 
-	var r0 *entities.Inscription
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.CreateInscriptionData) (*entities.Inscription, error)); ok {
-		return rf(ctx, data)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return rf(ctx, tripRefID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.CreateInscriptionData) *entities.Inscription); ok {
-		r0 = rf(ctx, data)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = rf(ctx, tripRefID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Inscription)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.CreateInscriptionData) error); ok {
-		r1 = rf(ctx, data)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tripRefID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,31 +110,31 @@ func (_m *MockInscriptionRepository) Create(ctx context.Context, data entities.C
 	return r0, r1
 }
 
-// MockInscriptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockInscriptionRepository_Create_Call struct {
+// MockInscriptionRepository_CountByTripRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByTripRefID'
+type MockInscriptionRepository_CountByTripRefID_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// CountByTripRefID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data entities.CreateInscriptionData
-func (_e *MockInscriptionRepository_Expecter) Create(ctx interface{}, data interface{}) *MockInscriptionRepository_Create_Call {
-	return &MockInscriptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, data)}
+//   - tripRefID int64
+func (_e *MockInscriptionRepository_Expecter) CountByTripRefID(ctx interface{}, tripRefID interface{}) *MockInscriptionRepository_CountByTripRefID_Call {
+	return &MockInscriptionRepository_CountByTripRefID_Call{Call: _e.mock.On("CountByTripRefID", ctx, tripRefID)}
 }
 
-func (_c *MockInscriptionRepository_Create_Call) Run(run func(ctx context.Context, data entities.CreateInscriptionData)) *MockInscriptionRepository_Create_Call {
+func (_c *MockInscriptionRepository_CountByTripRefID_Call) Run(run func(ctx context.Context, tripRefID int64)) *MockInscriptionRepository_CountByTripRefID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.CreateInscriptionData))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockInscriptionRepository_Create_Call) Return(_a0 *entities.Inscription, _a1 error) *MockInscriptionRepository_Create_Call {
+func (_c *MockInscriptionRepository_CountByTripRefID_Call) Return(_a0 int, _a1 error) *MockInscriptionRepository_CountByTripRefID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInscriptionRepository_Create_Call) RunAndReturn(run func(context.Context, entities.CreateInscriptionData) (*entities.Inscription, error)) *MockInscriptionRepository_Create_Call {
+func (_c *MockInscriptionRepository_CountByTripRefID_Call) RunAndReturn(run func(context.Context, int64) (int, error)) *MockInscriptionRepository_CountByTripRefID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -547,6 +548,65 @@ func (_c *MockInscriptionRepository_FindByUserID_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, id, from, to
+func (_m *MockInscriptionRepository) UpdateStatus(ctx context.Context, id string, from string, to string) (bool, error) {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return rf(ctx, id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInscriptionRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockInscriptionRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - from string
+//   - to string
+func (_e *MockInscriptionRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, from interface{}, to interface{}) *MockInscriptionRepository_UpdateStatus_Call {
+	return &MockInscriptionRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, from, to)}
+}
+
+func (_c *MockInscriptionRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id string, from string, to string)) *MockInscriptionRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockInscriptionRepository_UpdateStatus_Call) Return(_a0 bool, _a1 error) *MockInscriptionRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInscriptionRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, string, string) (bool, error)) *MockInscriptionRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockInscriptionRepository creates a new instance of MockInscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockInscriptionRepository(t interface {
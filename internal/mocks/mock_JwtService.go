@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	services "github.com/lgxju/gogretago/internal/domain/services"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,6 +22,141 @@ func (_m *MockJwtService) EXPECT() *MockJwtService_Expecter {
 	return &MockJwtService_Expecter{mock: &_m.Mock}
 }
 
+// AccessTokenTTL provides a mock function with no fields
+func (_m *MockJwtService) AccessTokenTTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccessTokenTTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// MockJwtService_AccessTokenTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessTokenTTL'
+type MockJwtService_AccessTokenTTL_Call struct {
+	*mock.Call
+}
+
+// AccessTokenTTL is a helper method to define mock.On call
+func (_e *MockJwtService_Expecter) AccessTokenTTL() *MockJwtService_AccessTokenTTL_Call {
+	return &MockJwtService_AccessTokenTTL_Call{Call: _e.mock.On("AccessTokenTTL")}
+}
+
+func (_c *MockJwtService_AccessTokenTTL_Call) Run(run func()) *MockJwtService_AccessTokenTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJwtService_AccessTokenTTL_Call) Return(_a0 time.Duration) *MockJwtService_AccessTokenTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJwtService_AccessTokenTTL_Call) RunAndReturn(run func() time.Duration) *MockJwtService_AccessTokenTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublicKeys provides a mock function with no fields
+func (_m *MockJwtService) PublicKeys() services.JSONWebKeySet {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublicKeys")
+	}
+
+	var r0 services.JSONWebKeySet
+	if rf, ok := ret.Get(0).(func() services.JSONWebKeySet); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(services.JSONWebKeySet)
+	}
+
+	return r0
+}
+
+// MockJwtService_PublicKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicKeys'
+type MockJwtService_PublicKeys_Call struct {
+	*mock.Call
+}
+
+// PublicKeys is a helper method to define mock.On call
+func (_e *MockJwtService_Expecter) PublicKeys() *MockJwtService_PublicKeys_Call {
+	return &MockJwtService_PublicKeys_Call{Call: _e.mock.On("PublicKeys")}
+}
+
+func (_c *MockJwtService_PublicKeys_Call) Run(run func()) *MockJwtService_PublicKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJwtService_PublicKeys_Call) Return(_a0 services.JSONWebKeySet) *MockJwtService_PublicKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJwtService_PublicKeys_Call) RunAndReturn(run func() services.JSONWebKeySet) *MockJwtService_PublicKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshTokenTTL provides a mock function with no fields
+func (_m *MockJwtService) RefreshTokenTTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTokenTTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// MockJwtService_RefreshTokenTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokenTTL'
+type MockJwtService_RefreshTokenTTL_Call struct {
+	*mock.Call
+}
+
+// RefreshTokenTTL is a helper method to define mock.On call
+func (_e *MockJwtService_Expecter) RefreshTokenTTL() *MockJwtService_RefreshTokenTTL_Call {
+	return &MockJwtService_RefreshTokenTTL_Call{Call: _e.mock.On("RefreshTokenTTL")}
+}
+
+func (_c *MockJwtService_RefreshTokenTTL_Call) Run(run func()) *MockJwtService_RefreshTokenTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJwtService_RefreshTokenTTL_Call) Return(_a0 time.Duration) *MockJwtService_RefreshTokenTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJwtService_RefreshTokenTTL_Call) RunAndReturn(run func() time.Duration) *MockJwtService_RefreshTokenTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Sign provides a mock function with given fields: payload
 func (_m *MockJwtService) Sign(payload services.JwtPayload) (string, error) {
 	ret := _m.Called(payload)
@@ -73,6 +73,52 @@ func (_c *MockPasswordService_Hash_Call) RunAndReturn(run func(string) (string,
 	return _c
 }
 
+// NeedsRehash provides a mock function with given fields: hash
+func (_m *MockPasswordService) NeedsRehash(hash string) bool {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsRehash")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockPasswordService_NeedsRehash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsRehash'
+type MockPasswordService_NeedsRehash_Call struct {
+	*mock.Call
+}
+
+// NeedsRehash is a helper method to define mock.On call
+//   - hash string
+func (_e *MockPasswordService_Expecter) NeedsRehash(hash interface{}) *MockPasswordService_NeedsRehash_Call {
+	return &MockPasswordService_NeedsRehash_Call{Call: _e.mock.On("NeedsRehash", hash)}
+}
+
+func (_c *MockPasswordService_NeedsRehash_Call) Run(run func(hash string)) *MockPasswordService_NeedsRehash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPasswordService_NeedsRehash_Call) Return(_a0 bool) *MockPasswordService_NeedsRehash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPasswordService_NeedsRehash_Call) RunAndReturn(run func(string) bool) *MockPasswordService_NeedsRehash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Verify provides a mock function with given fields: password, hash
 func (_m *MockPasswordService) Verify(password string, hash string) (bool, error) {
 	ret := _m.Called(password, hash)
@@ -22,6 +22,64 @@ func (_m *MockTripRepository) EXPECT() *MockTripRepository_Expecter {
 	return &MockTripRepository_Expecter{mock: &_m.Mock}
 }
 
+// Cancel provides a mock function with given fields: ctx, id, reason
+func (_m *MockTripRepository) Cancel(ctx context.Context, id string, reason string) (bool, error) {
+	ret := _m.Called(ctx, id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, id, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, id, reason)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockTripRepository_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - reason string
+func (_e *MockTripRepository_Expecter) Cancel(ctx interface{}, id interface{}, reason interface{}) *MockTripRepository_Cancel_Call {
+	return &MockTripRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, id, reason)}
+}
+
+func (_c *MockTripRepository_Cancel_Call) Run(run func(ctx context.Context, id string, reason string)) *MockTripRepository_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_Cancel_Call) Return(_a0 bool, _a1 error) *MockTripRepository_Cancel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_Cancel_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockTripRepository_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, data
 func (_m *MockTripRepository) Create(ctx context.Context, data entities.CreateTripData) (*entities.Trip, error) {
 	ret := _m.Called(ctx, data)
@@ -313,6 +371,302 @@ func (_c *MockTripRepository_FindByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FindByRefID provides a mock function with given fields: ctx, refID
+func (_m *MockTripRepository) FindByRefID(ctx context.Context, refID int64) (*entities.Trip, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRefID")
+	}
+
+	var r0 *entities.Trip
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*entities.Trip, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *entities.Trip); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Trip)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_FindByRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRefID'
+type MockTripRepository_FindByRefID_Call struct {
+	*mock.Call
+}
+
+// FindByRefID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockTripRepository_Expecter) FindByRefID(ctx interface{}, refID interface{}) *MockTripRepository_FindByRefID_Call {
+	return &MockTripRepository_FindByRefID_Call{Call: _e.mock.On("FindByRefID", ctx, refID)}
+}
+
+func (_c *MockTripRepository_FindByRefID_Call) Run(run func(ctx context.Context, refID int64)) *MockTripRepository_FindByRefID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_FindByRefID_Call) Return(_a0 *entities.Trip, _a1 error) *MockTripRepository_FindByRefID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_FindByRefID_Call) RunAndReturn(run func(context.Context, int64) (*entities.Trip, error)) *MockTripRepository_FindByRefID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindChanges provides a mock function with given fields: ctx, tripRefID
+func (_m *MockTripRepository) FindChanges(ctx context.Context, tripRefID int64) ([]entities.TripChange, error) {
+	ret := _m.Called(ctx, tripRefID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindChanges")
+	}
+
+	var r0 []entities.TripChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entities.TripChange, error)); ok {
+		return rf(ctx, tripRefID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entities.TripChange); ok {
+		r0 = rf(ctx, tripRefID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.TripChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tripRefID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_FindChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindChanges'
+type MockTripRepository_FindChanges_Call struct {
+	*mock.Call
+}
+
+// FindChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tripRefID int64
+func (_e *MockTripRepository_Expecter) FindChanges(ctx interface{}, tripRefID interface{}) *MockTripRepository_FindChanges_Call {
+	return &MockTripRepository_FindChanges_Call{Call: _e.mock.On("FindChanges", ctx, tripRefID)}
+}
+
+func (_c *MockTripRepository_FindChanges_Call) Run(run func(ctx context.Context, tripRefID int64)) *MockTripRepository_FindChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_FindChanges_Call) Return(_a0 []entities.TripChange, _a1 error) *MockTripRepository_FindChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_FindChanges_Call) RunAndReturn(run func(context.Context, int64) ([]entities.TripChange, error)) *MockTripRepository_FindChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUpcomingBySeries provides a mock function with given fields: ctx, seriesRefID
+func (_m *MockTripRepository) FindUpcomingBySeries(ctx context.Context, seriesRefID int64) ([]entities.Trip, error) {
+	ret := _m.Called(ctx, seriesRefID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUpcomingBySeries")
+	}
+
+	var r0 []entities.Trip
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entities.Trip, error)); ok {
+		return rf(ctx, seriesRefID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entities.Trip); ok {
+		r0 = rf(ctx, seriesRefID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Trip)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, seriesRefID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_FindUpcomingBySeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUpcomingBySeries'
+type MockTripRepository_FindUpcomingBySeries_Call struct {
+	*mock.Call
+}
+
+// FindUpcomingBySeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesRefID int64
+func (_e *MockTripRepository_Expecter) FindUpcomingBySeries(ctx interface{}, seriesRefID interface{}) *MockTripRepository_FindUpcomingBySeries_Call {
+	return &MockTripRepository_FindUpcomingBySeries_Call{Call: _e.mock.On("FindUpcomingBySeries", ctx, seriesRefID)}
+}
+
+func (_c *MockTripRepository_FindUpcomingBySeries_Call) Run(run func(ctx context.Context, seriesRefID int64)) *MockTripRepository_FindUpcomingBySeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_FindUpcomingBySeries_Call) Return(_a0 []entities.Trip, _a1 error) *MockTripRepository_FindUpcomingBySeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_FindUpcomingBySeries_Call) RunAndReturn(run func(context.Context, int64) ([]entities.Trip, error)) *MockTripRepository_FindUpcomingBySeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, data
+func (_m *MockTripRepository) Update(ctx context.Context, id string, data entities.UpdateTripData) (*entities.Trip, error) {
+	ret := _m.Called(ctx, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *entities.Trip
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.UpdateTripData) (*entities.Trip, error)); ok {
+		return rf(ctx, id, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.UpdateTripData) *entities.Trip); ok {
+		r0 = rf(ctx, id, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Trip)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entities.UpdateTripData) error); ok {
+		r1 = rf(ctx, id, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTripRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - data entities.UpdateTripData
+func (_e *MockTripRepository_Expecter) Update(ctx interface{}, id interface{}, data interface{}) *MockTripRepository_Update_Call {
+	return &MockTripRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, data)}
+}
+
+func (_c *MockTripRepository_Update_Call) Run(run func(ctx context.Context, id string, data entities.UpdateTripData)) *MockTripRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entities.UpdateTripData))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_Update_Call) Return(_a0 *entities.Trip, _a1 error) *MockTripRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_Update_Call) RunAndReturn(run func(context.Context, string, entities.UpdateTripData) (*entities.Trip, error)) *MockTripRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, from, to
+func (_m *MockTripRepository) UpdateStatus(ctx context.Context, id string, from string, to string) (bool, error) {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return rf(ctx, id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTripRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockTripRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - from string
+//   - to string
+func (_e *MockTripRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, from interface{}, to interface{}) *MockTripRepository_UpdateStatus_Call {
+	return &MockTripRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, from, to)}
+}
+
+func (_c *MockTripRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id string, from string, to string)) *MockTripRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockTripRepository_UpdateStatus_Call) Return(_a0 bool, _a1 error) *MockTripRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTripRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, string, string) (bool, error)) *MockTripRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTripRepository creates a new instance of MockTripRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTripRepository(t interface {
@@ -292,6 +292,65 @@ func (_c *MockUserRepository_FindByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FindByRefID provides a mock function with given fields: ctx, refID
+func (_m *MockUserRepository) FindByRefID(ctx context.Context, refID int64) (*entities.PublicUser, error) {
+	ret := _m.Called(ctx, refID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRefID")
+	}
+
+	var r0 *entities.PublicUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*entities.PublicUser, error)); ok {
+		return rf(ctx, refID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *entities.PublicUser); ok {
+		r0 = rf(ctx, refID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PublicUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, refID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_FindByRefID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRefID'
+type MockUserRepository_FindByRefID_Call struct {
+	*mock.Call
+}
+
+// FindByRefID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refID int64
+func (_e *MockUserRepository_Expecter) FindByRefID(ctx interface{}, refID interface{}) *MockUserRepository_FindByRefID_Call {
+	return &MockUserRepository_FindByRefID_Call{Call: _e.mock.On("FindByRefID", ctx, refID)}
+}
+
+func (_c *MockUserRepository_FindByRefID_Call) Run(run func(ctx context.Context, refID int64)) *MockUserRepository_FindByRefID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_FindByRefID_Call) Return(_a0 *entities.PublicUser, _a1 error) *MockUserRepository_FindByRefID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_FindByRefID_Call) RunAndReturn(run func(context.Context, int64) (*entities.PublicUser, error)) *MockUserRepository_FindByRefID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, data
 func (_m *MockUserRepository) Update(ctx context.Context, id string, data entities.UpdateUserData) (*entities.PublicUser, error) {
 	ret := _m.Called(ctx, id, data)
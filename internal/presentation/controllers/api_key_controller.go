@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/apikey"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/presentation/validators"
+)
+
+// ApiKeyController handles personal API keys and service accounts with their keys
+type ApiKeyController struct {
+	createApiKeyUseCase         *apikey.CreateApiKeyUseCase
+	listApiKeysUseCase          *apikey.ListApiKeysUseCase
+	revokeApiKeyUseCase         *apikey.RevokeApiKeyUseCase
+	createServiceAccountUseCase *apikey.CreateServiceAccountUseCase
+	listServiceAccountsUseCase  *apikey.ListServiceAccountsUseCase
+}
+
+// NewApiKeyController creates a new ApiKeyController
+func NewApiKeyController(
+	createApiKeyUseCase *apikey.CreateApiKeyUseCase,
+	listApiKeysUseCase *apikey.ListApiKeysUseCase,
+	revokeApiKeyUseCase *apikey.RevokeApiKeyUseCase,
+	createServiceAccountUseCase *apikey.CreateServiceAccountUseCase,
+	listServiceAccountsUseCase *apikey.ListServiceAccountsUseCase,
+) *ApiKeyController {
+	return &ApiKeyController{
+		createApiKeyUseCase:         createApiKeyUseCase,
+		listApiKeysUseCase:          listApiKeysUseCase,
+		revokeApiKeyUseCase:         revokeApiKeyUseCase,
+		createServiceAccountUseCase: createServiceAccountUseCase,
+		listServiceAccountsUseCase:  listServiceAccountsUseCase,
+	}
+}
+
+// CreateMyApiKey handles POST /users/me/api-keys
+func (ctrl *ApiKeyController) CreateMyApiKey(c *gin.Context) {
+	ctrl.createApiKey(c, entities.ApiKeyOwner{UserID: c.GetString("userId")})
+}
+
+// ListMyApiKeys handles GET /users/me/api-keys
+func (ctrl *ApiKeyController) ListMyApiKeys(c *gin.Context) {
+	ctrl.listApiKeys(c, entities.ApiKeyOwner{UserID: c.GetString("userId")})
+}
+
+// RevokeMyApiKey handles DELETE /users/me/api-keys/:keyId
+func (ctrl *ApiKeyController) RevokeMyApiKey(c *gin.Context) {
+	ctrl.revokeApiKey(c, entities.ApiKeyOwner{UserID: c.GetString("userId")})
+}
+
+// CreateServiceAccountApiKey handles POST /service-accounts/:id/api-keys
+func (ctrl *ApiKeyController) CreateServiceAccountApiKey(c *gin.Context) {
+	ctrl.createApiKey(c, entities.ApiKeyOwner{ServiceAccountID: c.Param("id")})
+}
+
+// ListServiceAccountApiKeys handles GET /service-accounts/:id/api-keys
+func (ctrl *ApiKeyController) ListServiceAccountApiKeys(c *gin.Context) {
+	ctrl.listApiKeys(c, entities.ApiKeyOwner{ServiceAccountID: c.Param("id")})
+}
+
+// RevokeServiceAccountApiKey handles DELETE /service-accounts/:id/api-keys/:keyId
+func (ctrl *ApiKeyController) RevokeServiceAccountApiKey(c *gin.Context) {
+	ctrl.revokeApiKey(c, entities.ApiKeyOwner{ServiceAccountID: c.Param("id")})
+}
+
+// CreateServiceAccount handles POST /service-accounts
+func (ctrl *ApiKeyController) CreateServiceAccount(c *gin.Context) {
+	var input dtos.CreateServiceAccountInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.createServiceAccountUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Set("auditTargetId", result.ID)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListServiceAccounts handles GET /service-accounts
+func (ctrl *ApiKeyController) ListServiceAccounts(c *gin.Context) {
+	result, err := ctrl.listServiceAccountsUseCase.Execute(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (ctrl *ApiKeyController) createApiKey(c *gin.Context, owner entities.ApiKeyOwner) {
+	var input dtos.CreateApiKeyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.createApiKeyUseCase.Execute(c.Request.Context(), owner, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (ctrl *ApiKeyController) listApiKeys(c *gin.Context, owner entities.ApiKeyOwner) {
+	result, err := ctrl.listApiKeysUseCase.Execute(c.Request.Context(), owner)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (ctrl *ApiKeyController) revokeApiKey(c *gin.Context, owner entities.ApiKeyOwner) {
+	err := ctrl.revokeApiKeyUseCase.Execute(c.Request.Context(), owner, c.Param("keyId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,228 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/apikey"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyControllerDeps struct {
+	ctrl       *ApiKeyController
+	apiKeyRepo *mocks.MockApiKeyRepository
+	saRepo     *mocks.MockServiceAccountRepository
+	roleRepo   *mocks.MockRoleRepository
+	tokenSvc   *mocks.MockTokenService
+}
+
+func setupApiKeyController(t *testing.T) apiKeyControllerDeps {
+	d := apiKeyControllerDeps{
+		apiKeyRepo: mocks.NewMockApiKeyRepository(t),
+		saRepo:     mocks.NewMockServiceAccountRepository(t),
+		roleRepo:   mocks.NewMockRoleRepository(t),
+		tokenSvc:   mocks.NewMockTokenService(t),
+	}
+	d.ctrl = NewApiKeyController(
+		apikey.NewCreateApiKeyUseCase(d.apiKeyRepo, d.saRepo, d.tokenSvc),
+		apikey.NewListApiKeysUseCase(d.apiKeyRepo, d.saRepo),
+		apikey.NewRevokeApiKeyUseCase(d.apiKeyRepo),
+		apikey.NewCreateServiceAccountUseCase(d.saRepo, d.roleRepo),
+		apikey.NewListServiceAccountsUseCase(d.saRepo),
+	)
+	return d
+}
+
+func withUserID(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("userId", userID)
+		c.Next()
+	}
+}
+
+func TestApiKeyController_CreateMyApiKey_Success(t *testing.T) {
+	d := setupApiKeyController(t)
+	userID := "user-1"
+
+	d.tokenSvc.EXPECT().Generate().Return("abcdefghijklmnop", nil).Twice()
+	d.tokenSvc.EXPECT().Hash("cvk_abcdefgh_abcdefghijklmnop").Return("key-hash")
+	d.apiKeyRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(data entities.CreateApiKeyData) bool {
+		return data.UserID != nil && *data.UserID == userID && data.ServiceAccountID == nil
+	})).Return(&entities.ApiKey{
+		ID: "key-1", Name: "backup script", Prefix: "cvk_abcdefgh",
+		Scopes: []string{"read"}, UserID: &userID, CreatedAt: time.Now(),
+	}, nil)
+
+	router := gin.New()
+	router.POST("/users/me/api-keys", withUserID(userID), d.ctrl.CreateMyApiKey)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "backup script", "scopes": []string{"read"}})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/me/api-keys", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "cvk_abcdefgh_abcdefghijklmnop", data["key"])
+	assert.Equal(t, "cvk_abcdefgh", data["prefix"])
+	assert.NotContains(t, data, "keyHash")
+}
+
+func TestApiKeyController_CreateMyApiKey_InvalidScope(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	router := gin.New()
+	router.POST("/users/me/api-keys", withUserID("user-1"), d.ctrl.CreateMyApiKey)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "backup script", "scopes": []string{"admin"}})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/me/api-keys", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+}
+
+func TestApiKeyController_ListMyApiKeys(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	d.apiKeyRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return([]entities.ApiKey{
+		{ID: "key-1", Name: "one", Prefix: "cvk_aaaaaaaa"},
+		{ID: "key-2", Name: "two", Prefix: "cvk_bbbbbbbb"},
+	}, nil)
+
+	router := gin.New()
+	router.GET("/users/me/api-keys", withUserID("user-1"), d.ctrl.ListMyApiKeys)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users/me/api-keys", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp["data"], 2)
+}
+
+func TestApiKeyController_RevokeMyApiKey_Success(t *testing.T) {
+	d := setupApiKeyController(t)
+	userID := "user-1"
+
+	d.apiKeyRepo.EXPECT().FindByID(mock.Anything, "key-1").Return(&entities.ApiKey{ID: "key-1", UserID: &userID}, nil)
+	d.apiKeyRepo.EXPECT().Revoke(mock.Anything, "key-1").Return(true, nil)
+
+	router := gin.New()
+	router.DELETE("/users/me/api-keys/:keyId", withUserID(userID), d.ctrl.RevokeMyApiKey)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/api-keys/key-1", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestApiKeyController_RevokeMyApiKey_OtherUsersKey(t *testing.T) {
+	d := setupApiKeyController(t)
+	otherID := "user-2"
+
+	d.apiKeyRepo.EXPECT().FindByID(mock.Anything, "key-1").Return(&entities.ApiKey{ID: "key-1", UserID: &otherID}, nil)
+
+	router := gin.New()
+	router.DELETE("/users/me/api-keys/:keyId", withUserID("user-1"), d.ctrl.RevokeMyApiKey)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/api-keys/key-1", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestApiKeyController_CreateServiceAccount_Success(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	d.roleRepo.EXPECT().FindByName(mock.Anything, "ADMIN").Return(&entities.Role{Name: "ADMIN"}, nil)
+	d.saRepo.EXPECT().Create(mock.Anything, entities.CreateServiceAccountData{Name: "billing-export", Role: "ADMIN"}).
+		Return(&entities.ServiceAccount{ID: "sa-1", Name: "billing-export", Role: "ADMIN"}, nil)
+
+	router := gin.New()
+	router.POST("/service-accounts", d.ctrl.CreateServiceAccount)
+
+	body, _ := json.Marshal(map[string]string{"name": "billing-export", "role": "ADMIN"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/service-accounts", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "sa-1", data["id"])
+	assert.Equal(t, "ADMIN", data["role"])
+}
+
+func TestApiKeyController_CreateServiceAccount_MissingRole(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	router := gin.New()
+	router.POST("/service-accounts", d.ctrl.CreateServiceAccount)
+
+	body, _ := json.Marshal(map[string]string{"name": "billing-export"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/service-accounts", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestApiKeyController_CreateServiceAccount_UnknownRole(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	d.roleRepo.EXPECT().FindByName(mock.Anything, "ROOT").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/service-accounts", d.ctrl.CreateServiceAccount)
+
+	body, _ := json.Marshal(map[string]string{"name": "billing-export", "role": "ROOT"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/service-accounts", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestApiKeyController_ListServiceAccountApiKeys_UnknownAccount(t *testing.T) {
+	d := setupApiKeyController(t)
+
+	d.saRepo.EXPECT().FindByID(mock.Anything, "sa-404").Return(nil, nil)
+
+	router := gin.New()
+	router.GET("/service-accounts/:id/api-keys", d.ctrl.ListServiceAccountApiKeys)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/service-accounts/sa-404/api-keys", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/audit"
+)
+
+// AuditLogController handles audit log endpoints
+type AuditLogController struct {
+	listUseCase *audit.ListAuditLogsUseCase
+}
+
+// NewAuditLogController creates a new AuditLogController
+func NewAuditLogController(listUseCase *audit.ListAuditLogsUseCase) *AuditLogController {
+	return &AuditLogController{
+		listUseCase: listUseCase,
+	}
+}
+
+// ListAuditLogs handles GET /audit-logs
+func (ctrl *AuditLogController) ListAuditLogs(c *gin.Context) {
+	var query dtos.AuditLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid query parameters",
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.listUseCase.Execute(c.Request.Context(), query, parsePagination(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result.Data,
+		"meta":    result.Meta,
+	})
+}
@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/audit"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogController_ListAuditLogs(t *testing.T) {
+	repo := mocks.NewMockAuditLogRepository(t)
+	ctrl := NewAuditLogController(audit.NewListAuditLogsUseCase(repo))
+
+	repo.EXPECT().FindByFilters(mock.Anything, mock.MatchedBy(func(f entities.AuditLogFilters) bool {
+		return f.Action != nil && *f.Action == entities.AuditActionLogin &&
+			f.Success != nil && !*f.Success && f.From != nil
+	}), 0, 20).Return([]entities.AuditLog{
+		{ID: "log-1", Action: entities.AuditActionLogin, Success: false},
+	}, 1, nil)
+
+	router := gin.New()
+	router.GET("/audit-logs", ctrl.ListAuditLogs)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/audit-logs?action=auth.login&success=false&from=2026-01-01T00:00:00Z", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].([]interface{})
+	require.Len(t, data, 1)
+	assert.Equal(t, "log-1", data[0].(map[string]interface{})["id"])
+	assert.Equal(t, float64(1), resp["meta"].(map[string]interface{})["total"])
+}
+
+func TestAuditLogController_ListAuditLogs_InvalidQuery(t *testing.T) {
+	repo := mocks.NewMockAuditLogRepository(t)
+	ctrl := NewAuditLogController(audit.NewListAuditLogsUseCase(repo))
+
+	router := gin.New()
+	router.GET("/audit-logs", ctrl.ListAuditLogs)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/audit-logs?from=yesterday", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
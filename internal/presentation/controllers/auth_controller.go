@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,18 +13,51 @@ import (
 
 // AuthController handles authentication endpoints
 type AuthController struct {
-	registerUseCase *auth.RegisterUseCase
-	loginUseCase    *auth.LoginUseCase
+	registerUseCase           *auth.RegisterUseCase
+	loginUseCase              *auth.LoginUseCase
+	refreshTokenUseCase       *auth.RefreshTokenUseCase
+	logoutUseCase             *auth.LogoutUseCase
+	forgotPasswordUseCase     *auth.ForgotPasswordUseCase
+	resetPasswordUseCase      *auth.ResetPasswordUseCase
+	verifyEmailUseCase        *auth.VerifyEmailUseCase
+	resendVerificationUseCase *auth.ResendVerificationUseCase
+	twoFactorLoginUseCase     *auth.TwoFactorLoginUseCase
+	enrollTotpUseCase         *auth.EnrollTotpUseCase
+	confirmTotpUseCase        *auth.ConfirmTotpUseCase
+	requestMagicLinkUseCase   *auth.RequestMagicLinkUseCase
+	magicLinkLoginUseCase     *auth.MagicLinkLoginUseCase
 }
 
 // NewAuthController creates a new AuthController
 func NewAuthController(
 	registerUseCase *auth.RegisterUseCase,
 	loginUseCase *auth.LoginUseCase,
+	refreshTokenUseCase *auth.RefreshTokenUseCase,
+	logoutUseCase *auth.LogoutUseCase,
+	forgotPasswordUseCase *auth.ForgotPasswordUseCase,
+	resetPasswordUseCase *auth.ResetPasswordUseCase,
+	verifyEmailUseCase *auth.VerifyEmailUseCase,
+	resendVerificationUseCase *auth.ResendVerificationUseCase,
+	twoFactorLoginUseCase *auth.TwoFactorLoginUseCase,
+	enrollTotpUseCase *auth.EnrollTotpUseCase,
+	confirmTotpUseCase *auth.ConfirmTotpUseCase,
+	requestMagicLinkUseCase *auth.RequestMagicLinkUseCase,
+	magicLinkLoginUseCase *auth.MagicLinkLoginUseCase,
 ) *AuthController {
 	return &AuthController{
-		registerUseCase: registerUseCase,
-		loginUseCase:    loginUseCase,
+		registerUseCase:           registerUseCase,
+		loginUseCase:              loginUseCase,
+		refreshTokenUseCase:       refreshTokenUseCase,
+		logoutUseCase:             logoutUseCase,
+		forgotPasswordUseCase:     forgotPasswordUseCase,
+		resetPasswordUseCase:      resetPasswordUseCase,
+		verifyEmailUseCase:        verifyEmailUseCase,
+		resendVerificationUseCase: resendVerificationUseCase,
+		twoFactorLoginUseCase:     twoFactorLoginUseCase,
+		enrollTotpUseCase:         enrollTotpUseCase,
+		confirmTotpUseCase:        confirmTotpUseCase,
+		requestMagicLinkUseCase:   requestMagicLinkUseCase,
+		magicLinkLoginUseCase:     magicLinkLoginUseCase,
 	}
 }
 
@@ -57,11 +92,13 @@ func (ctrl *AuthController) Register(c *gin.Context) {
 	}
 
 	// Execute use case
-	result, err := ctrl.registerUseCase.Execute(c.Request.Context(), input)
+	c.Set("auditEmail", input.Email)
+	result, err := ctrl.registerUseCase.Execute(c.Request.Context(), sessionClient(c), input)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+	c.Set("auditTargetId", result.UserID)
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -100,14 +137,425 @@ func (ctrl *AuthController) Login(c *gin.Context) {
 	}
 
 	// Execute use case
-	result, err := ctrl.loginUseCase.Execute(c.Request.Context(), input)
+	c.Set("auditEmail", input.Email)
+	result, err := ctrl.loginUseCase.Execute(c.Request.Context(), sessionClient(c), input)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+	auditLogin(c, result)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"data":    result,
 	})
 }
+
+// Refresh handles POST /auth/refresh
+func (ctrl *AuthController) Refresh(c *gin.Context) {
+	var input dtos.RefreshTokenInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.refreshTokenUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Logout handles POST /auth/logout
+func (ctrl *AuthController) Logout(c *gin.Context) {
+	var input dtos.LogoutInput
+
+	// The body is optional; only a malformed one is rejected
+	if err := c.ShouldBindJSON(&input); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	userID := c.GetString("userId")
+	tokenID := c.GetString("tokenId")
+	expiresAt := c.GetTime("tokenExpiresAt")
+
+	if err := ctrl.logoutUseCase.Execute(c.Request.Context(), userID, tokenID, expiresAt, input); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ForgotPassword handles POST /auth/password/forgot
+func (ctrl *AuthController) ForgotPassword(c *gin.Context) {
+	var input dtos.ForgotPasswordInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	if err := ctrl.forgotPasswordUseCase.Execute(c.Request.Context(), input); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// Same response whether or not the account exists
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data": gin.H{
+			"message": "If an account exists for this email, a reset link has been sent",
+		},
+	})
+}
+
+// ResetPassword handles POST /auth/password/reset
+func (ctrl *AuthController) ResetPassword(c *gin.Context) {
+	var input dtos.ResetPasswordInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	if err := ctrl.resetPasswordUseCase.Execute(c.Request.Context(), input); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// VerifyEmail handles POST /auth/verify-email
+func (ctrl *AuthController) VerifyEmail(c *gin.Context) {
+	var input dtos.VerifyEmailInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	if err := ctrl.verifyEmailUseCase.Execute(c.Request.Context(), input); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ResendVerification handles POST /auth/verify-email/resend
+func (ctrl *AuthController) ResendVerification(c *gin.Context) {
+	userID := c.GetString("userId")
+
+	if err := ctrl.resendVerificationUseCase.Execute(c.Request.Context(), userID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// LoginTwoFactor handles POST /auth/login/2fa
+func (ctrl *AuthController) LoginTwoFactor(c *gin.Context) {
+	var input dtos.TwoFactorLoginInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.twoFactorLoginUseCase.Execute(c.Request.Context(), sessionClient(c), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	auditLogin(c, result)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// EnrollTotp handles POST /auth/2fa/totp/enroll
+func (ctrl *AuthController) EnrollTotp(c *gin.Context) {
+	userID := c.GetString("userId")
+
+	result, err := ctrl.enrollTotpUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ConfirmTotp handles POST /auth/2fa/totp/confirm
+func (ctrl *AuthController) ConfirmTotp(c *gin.Context) {
+	var input dtos.ConfirmTotpInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	userID := c.GetString("userId")
+
+	// Execute use case
+	result, err := ctrl.confirmTotpUseCase.Execute(c.Request.Context(), userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RequestMagicLink handles POST /auth/magic-link
+func (ctrl *AuthController) RequestMagicLink(c *gin.Context) {
+	var input dtos.MagicLinkInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	if err := ctrl.requestMagicLinkUseCase.Execute(c.Request.Context(), input); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// Same response whether or not the account exists
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data": gin.H{
+			"message": "If an account exists for this email, a sign-in link has been sent",
+		},
+	})
+}
+
+// MagicLinkLogin handles POST /auth/magic-link/verify
+func (ctrl *AuthController) MagicLinkLogin(c *gin.Context) {
+	var input dtos.MagicLinkLoginInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	c.Set("auditEmail", input.Email)
+	result, err := ctrl.magicLinkLoginUseCase.Execute(c.Request.Context(), sessionClient(c), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	auditLogin(c, result)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// auditLogin names the signed-in user for the audit trail. Logins stopped at an MFA challenge
+// do not identify the user yet; completing the challenge is recorded on its own.
+func auditLogin(c *gin.Context, result *dtos.LoginResponse) {
+	if result.AuthResponse != nil {
+		c.Set("auditTargetId", result.UserID)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/internal/application/usecases/auth"
@@ -23,12 +24,19 @@ func init() {
 }
 
 type authControllerDeps struct {
-	ctrl        *AuthController
-	authRepo    *mocks.MockAuthRepository
-	userRepo    *mocks.MockUserRepository
-	passwordSvc *mocks.MockPasswordService
-	emailSvc    *mocks.MockEmailService
-	jwtSvc      *mocks.MockJwtService
+	ctrl         *AuthController
+	authRepo     *mocks.MockAuthRepository
+	userRepo     *mocks.MockUserRepository
+	passwordSvc  *mocks.MockPasswordService
+	emailSvc     *mocks.MockEmailService
+	jwtSvc       *mocks.MockJwtService
+	refreshRepo  *mocks.MockRefreshTokenRepository
+	sessionRepo  *mocks.MockSessionRepository
+	tokenSvc     *mocks.MockTokenService
+	revokedRepo  *mocks.MockRevokedTokenRepository
+	oneTimeRepo  *mocks.MockOneTimeTokenRepository
+	recoveryRepo *mocks.MockRecoveryCodeRepository
+	totpSvc      *mocks.MockTotpService
 }
 
 func setupAuthController(t *testing.T) authControllerDeps {
@@ -37,12 +45,41 @@ func setupAuthController(t *testing.T) authControllerDeps {
 	passwordSvc := mocks.NewMockPasswordService(t)
 	emailSvc := mocks.NewMockEmailService(t)
 	jwtSvc := mocks.NewMockJwtService(t)
+	refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+	sessionRepo := mocks.NewMockSessionRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	revokedRepo := mocks.NewMockRevokedTokenRepository(t)
+	oneTimeRepo := mocks.NewMockOneTimeTokenRepository(t)
+	recoveryRepo := mocks.NewMockRecoveryCodeRepository(t)
+	totpSvc := mocks.NewMockTotpService(t)
+
+	registerUC := auth.NewRegisterUseCase(authRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, emailSvc, jwtSvc, tokenSvc)
+	loginUC := auth.NewLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, passwordSvc, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy(), entities.TwoFactorPolicy{})
+	refreshUC := auth.NewRefreshTokenUseCase(authRepo, userRepo, refreshRepo, sessionRepo, jwtSvc, tokenSvc, entities.TwoFactorPolicy{})
+	logoutUC := auth.NewLogoutUseCase(userRepo, refreshRepo, sessionRepo, revokedRepo, tokenSvc)
+	forgotUC := auth.NewForgotPasswordUseCase(authRepo, oneTimeRepo, emailSvc, tokenSvc)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute).Once()
+	resetUC := auth.NewResetPasswordUseCase(authRepo, userRepo, oneTimeRepo, refreshRepo, sessionRepo, revokedRepo, mocks.NewMockTokenVersionRepository(t), passwordSvc, tokenSvc, jwtSvc)
+	verifyUC := auth.NewVerifyEmailUseCase(authRepo, userRepo, oneTimeRepo, emailSvc, tokenSvc)
+	resendUC := auth.NewResendVerificationUseCase(authRepo, userRepo, oneTimeRepo, emailSvc, tokenSvc)
+	twoFactorLoginUC := auth.NewTwoFactorLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, recoveryRepo, jwtSvc, tokenSvc, totpSvc, entities.DefaultLoginLockoutPolicy())
+	enrollTotpUC := auth.NewEnrollTotpUseCase(authRepo, userRepo, totpSvc)
+	confirmTotpUC := auth.NewConfirmTotpUseCase(authRepo, userRepo, recoveryRepo, totpSvc, tokenSvc)
+	requestMagicLinkUC := auth.NewRequestMagicLinkUseCase(authRepo, oneTimeRepo, emailSvc, tokenSvc)
+	magicLinkLoginUC := auth.NewMagicLinkLoginUseCase(authRepo, userRepo, refreshRepo, sessionRepo, oneTimeRepo, jwtSvc, tokenSvc, totpSvc, entities.TwoFactorPolicy{})
+	ctrl := NewAuthController(registerUC, loginUC, refreshUC, logoutUC, forgotUC, resetUC, verifyUC, resendUC, twoFactorLoginUC, enrollTotpUC, confirmTotpUC, requestMagicLinkUC, magicLinkLoginUC)
+
+	return authControllerDeps{ctrl, authRepo, userRepo, passwordSvc, emailSvc, jwtSvc, refreshRepo, sessionRepo, tokenSvc, revokedRepo, oneTimeRepo, recoveryRepo, totpSvc}
+}
 
-	registerUC := auth.NewRegisterUseCase(authRepo, passwordSvc, emailSvc, jwtSvc)
-	loginUC := auth.NewLoginUseCase(authRepo, userRepo, passwordSvc, jwtSvc)
-	ctrl := NewAuthController(registerUC, loginUC)
-
-	return authControllerDeps{ctrl, authRepo, userRepo, passwordSvc, emailSvc, jwtSvc}
+// expectRefreshTokenIssued sets up the token expectations that follow a successful Sign
+func (d authControllerDeps) expectRefreshTokenIssued() {
+	d.jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	d.sessionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&entities.Session{ID: "session-1"}, nil)
+	d.tokenSvc.EXPECT().Generate().Return("refresh-token", nil)
+	d.tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	d.refreshRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&entities.RefreshToken{ID: "rt-1"}, nil)
 }
 
 func TestAuthController_Register_Success(t *testing.T) {
@@ -61,8 +98,12 @@ func TestAuthController_Register_Success(t *testing.T) {
 		entities.CreateAuthData{Email: "test@example.com", Password: "hashed"},
 		entities.CreateUserData{},
 	).Return(authEntity, userEntity, nil)
-	emailSvc.EXPECT().SendWelcomeEmail("test@example.com", "there").Return(nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	d.tokenSvc.EXPECT().Generate().Return("verify-token", nil).Once()
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	emailSvc.EXPECT().SendVerificationEmail("test@example.com", "verify-token").Return(nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	d.expectRefreshTokenIssued()
 
 	router := gin.New()
 	router.POST("/register", ctrl.Register)
@@ -156,8 +197,10 @@ func TestAuthController_Login_Success(t *testing.T) {
 
 	authRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(authEntity, nil)
 	passwordSvc.EXPECT().Verify("Password1", "hashed").Return(true, nil)
+	passwordSvc.EXPECT().NeedsRehash("hashed").Return(false)
 	userRepo.EXPECT().FindByAuthRefID(mock.Anything, int64(1)).Return(userEntity, nil)
-	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER"}).Return("jwt-token", nil)
+	jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "session-1"}).Return("jwt-token", nil)
+	d.expectRefreshTokenIssued()
 
 	router := gin.New()
 	router.POST("/login", ctrl.Login)
@@ -228,3 +271,388 @@ func TestAuthController_Login_UseCaseError(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestAuthController_Refresh_Success(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.tokenSvc.EXPECT().Hash("old-token").Return("old-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(mock.Anything, "old-hash").Return(&entities.RefreshToken{
+		ID: "rt-1", AuthRefID: 1, FamilyID: "family-1", ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	d.authRepo.EXPECT().FindByRefID(mock.Anything, int64(1)).Return(&entities.Auth{RefID: 1, Role: "USER"}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(mock.Anything, int64(1)).Return(&entities.PublicUser{User: entities.User{ID: "user-1"}}, nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", SessionID: "family-1"}).Return("jwt-token", nil)
+	d.jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.jwtSvc.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+	d.tokenSvc.EXPECT().Generate().Return("new-token", nil)
+	d.tokenSvc.EXPECT().Hash("new-token").Return("new-hash")
+	d.refreshRepo.EXPECT().Rotate(mock.Anything, "rt-1", mock.Anything).Return(&entities.RefreshToken{ID: "rt-2"}, nil)
+	d.sessionRepo.EXPECT().Touch(mock.Anything, "family-1", mock.Anything, mock.Anything).Return(nil)
+
+	router := gin.New()
+	router.POST("/refresh", d.ctrl.Refresh)
+
+	body := `{"refreshToken":"old-token"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "jwt-token", data["token"])
+	assert.Equal(t, "new-token", data["refreshToken"])
+}
+
+func TestAuthController_Refresh_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/refresh", d.ctrl.Refresh)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+}
+
+// withTokenContext simulates what AuthMiddleware sets for an authenticated request
+func withTokenContext(userID, tokenID string, expiresAt time.Time) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("userId", userID)
+		c.Set("tokenId", tokenID)
+		c.Set("tokenExpiresAt", expiresAt)
+		c.Next()
+	}
+}
+
+func TestAuthController_Logout_Success(t *testing.T) {
+	d := setupAuthController(t)
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(mock.Anything, "jti-1", expiresAt).Return(nil)
+	d.tokenSvc.EXPECT().Hash("refresh-token").Return("refresh-hash")
+	d.refreshRepo.EXPECT().FindByTokenHash(mock.Anything, "refresh-hash").Return(&entities.RefreshToken{ID: "rt-1", AuthRefID: 1, FamilyID: "family-1"}, nil)
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 1}}, nil)
+	d.refreshRepo.EXPECT().RevokeFamily(mock.Anything, "family-1").Return(nil)
+	d.sessionRepo.EXPECT().Revoke(mock.Anything, "family-1").Return(true, nil)
+
+	router := gin.New()
+	router.POST("/logout", withTokenContext("user-1", "jti-1", expiresAt), d.ctrl.Logout)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(`{"refreshToken":"refresh-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestAuthController_Logout_EmptyBody(t *testing.T) {
+	d := setupAuthController(t)
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	d.revokedRepo.EXPECT().Revoke(mock.Anything, "jti-1", expiresAt).Return(nil)
+
+	router := gin.New()
+	router.POST("/logout", withTokenContext("user-1", "jti-1", expiresAt), d.ctrl.Logout)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/logout", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestAuthController_Logout_InvalidJSON(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/logout", withTokenContext("user-1", "jti-1", time.Now()), d.ctrl.Logout)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(`{invalid`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthController_ForgotPassword_UnknownEmail(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.authRepo.EXPECT().FindByEmail(mock.Anything, "nobody@example.com").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/password/forgot", d.ctrl.ForgotPassword)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(`{"email":"nobody@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, true, resp["success"])
+}
+
+func TestAuthController_ForgotPassword_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/password/forgot", d.ctrl.ForgotPassword)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(`{"email":"not-an-email"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthController_ResetPassword_Success(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.tokenSvc.EXPECT().Hash("reset-token").Return("reset-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(mock.Anything, entities.OneTimeTokenPasswordReset, "reset-hash").Return(&entities.OneTimeToken{
+		ID: "ott-1", AuthRefID: 1, ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	d.authRepo.EXPECT().FindByRefID(mock.Anything, int64(1)).Return(&entities.Auth{RefID: 1}, nil)
+	d.oneTimeRepo.EXPECT().Consume(mock.Anything, "ott-1").Return(true, nil)
+	d.passwordSvc.EXPECT().Hash("NewPassword1").Return("new-hash", nil)
+	d.authRepo.EXPECT().UpdatePassword(mock.Anything, int64(1), "new-hash").Return(nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(mock.Anything, int64(1), entities.OneTimeTokenPasswordReset).Return(nil)
+	d.userRepo.EXPECT().FindByAuthRefID(mock.Anything, int64(1)).Return(nil, nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(mock.Anything, int64(1)).Return(nil)
+	d.sessionRepo.EXPECT().RevokeAllForAuth(mock.Anything, int64(1)).Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/password/reset", d.ctrl.ResetPassword)
+
+	body := `{"token":"reset-token","password":"NewPassword1","confirmPassword":"NewPassword1"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestAuthController_ResetPassword_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/password/reset", d.ctrl.ResetPassword)
+
+	body := `{"token":"reset-token","password":"NewPassword1","confirmPassword":"Different1"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+}
+
+func TestAuthController_VerifyEmail_InvalidToken(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.tokenSvc.EXPECT().Hash("bad-token").Return("bad-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(mock.Anything, entities.OneTimeTokenEmailVerification, "bad-hash").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/verify-email", d.ctrl.VerifyEmail)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/verify-email", bytes.NewBufferString(`{"token":"bad-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthController_VerifyEmail_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/verify-email", d.ctrl.VerifyEmail)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/verify-email", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthController_ResendVerification_Success(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 1}}, nil)
+	d.authRepo.EXPECT().FindByRefID(mock.Anything, int64(1)).Return(&entities.Auth{RefID: 1, Email: "test@example.com"}, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(mock.Anything, int64(1), entities.OneTimeTokenEmailVerification).Return(nil)
+	d.tokenSvc.EXPECT().Generate().Return("verify-token", nil)
+	d.tokenSvc.EXPECT().Hash("verify-token").Return("verify-hash")
+	d.oneTimeRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&entities.OneTimeToken{ID: "ott-1"}, nil)
+	d.emailSvc.EXPECT().SendVerificationEmail("test@example.com", "verify-token").Return(nil)
+
+	router := gin.New()
+	router.POST("/verify-email/resend", withTokenContext("user-1", "jti-1", time.Now().Add(time.Minute)), d.ctrl.ResendVerification)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/verify-email/resend", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+}
+
+func TestAuthController_LoginTwoFactor_InvalidChallenge(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.tokenSvc.EXPECT().Hash("mfa-token").Return("mfa-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(mock.Anything, entities.OneTimeTokenMfaChallenge, "mfa-hash").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/login/2fa", d.ctrl.LoginTwoFactor)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/login/2fa", bytes.NewBufferString(`{"mfaToken":"mfa-token","code":"123456"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthController_LoginTwoFactor_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/login/2fa", d.ctrl.LoginTwoFactor)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/login/2fa", bytes.NewBufferString(`{"mfaToken":"mfa-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthController_EnrollTotp_Success(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 1}}, nil)
+	d.authRepo.EXPECT().FindByRefID(mock.Anything, int64(1)).Return(&entities.Auth{RefID: 1, Email: "test@example.com"}, nil)
+	d.totpSvc.EXPECT().GenerateSecret().Return("SECRET", nil)
+	d.authRepo.EXPECT().StartTotpEnrollment(mock.Anything, int64(1), "SECRET").Return(nil)
+	d.totpSvc.EXPECT().ProvisioningURI("SECRET", "test@example.com").Return("otpauth://totp/x")
+
+	router := gin.New()
+	router.POST("/2fa/totp/enroll", withTokenContext("user-1", "jti-1", time.Now().Add(time.Minute)), d.ctrl.EnrollTotp)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/2fa/totp/enroll", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "SECRET", data["secret"])
+	assert.Equal(t, "otpauth://totp/x", data["otpauthUri"])
+}
+
+func TestAuthController_ConfirmTotp_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/2fa/totp/confirm", withTokenContext("user-1", "jti-1", time.Now().Add(time.Minute)), d.ctrl.ConfirmTotp)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/2fa/totp/confirm", bytes.NewBufferString(`{"code":"12ab"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthController_RequestMagicLink_UnknownEmail(t *testing.T) {
+	d := setupAuthController(t)
+
+	d.authRepo.EXPECT().FindByEmail(mock.Anything, "nobody@example.com").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/magic-link", d.ctrl.RequestMagicLink)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/magic-link", bytes.NewBufferString(`{"email":"nobody@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, true, resp["success"])
+}
+
+func TestAuthController_MagicLinkLogin_Success(t *testing.T) {
+	d := setupAuthController(t)
+	verifiedAt := time.Now().Add(-time.Hour)
+
+	d.tokenSvc.EXPECT().Hash("magic-token").Return("magic-hash")
+	d.oneTimeRepo.EXPECT().FindByTokenHash(mock.Anything, entities.OneTimeTokenMagicLink, "magic-hash").
+		Return(&entities.OneTimeToken{ID: "ott-1", AuthRefID: 1, ExpiresAt: time.Now().Add(10 * time.Minute)}, nil)
+	d.authRepo.EXPECT().FindByRefID(mock.Anything, int64(1)).Return(&entities.Auth{RefID: 1, Email: "test@example.com", Role: "USER", EmailVerifiedAt: &verifiedAt}, nil)
+	d.userRepo.EXPECT().FindByAuthRefID(mock.Anything, int64(1)).Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 1}}, nil)
+	d.oneTimeRepo.EXPECT().Consume(mock.Anything, "ott-1").Return(true, nil)
+	d.oneTimeRepo.EXPECT().InvalidateAll(mock.Anything, int64(1), entities.OneTimeTokenMagicLink).Return(nil)
+	d.jwtSvc.EXPECT().Sign(services.JwtPayload{UserID: "user-1", Role: "USER", EmailVerified: true, SessionID: "session-1"}).Return("jwt-token", nil)
+	d.expectRefreshTokenIssued()
+
+	router := gin.New()
+	router.POST("/magic-link/verify", d.ctrl.MagicLinkLogin)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/magic-link/verify", bytes.NewBufferString(`{"email":"test@example.com","token":"magic-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "jwt-token", data["token"])
+}
+
+func TestAuthController_MagicLinkLogin_ValidationError(t *testing.T) {
+	d := setupAuthController(t)
+
+	router := gin.New()
+	router.POST("/magic-link/verify", d.ctrl.MagicLinkLogin)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/magic-link/verify", bytes.NewBufferString(`{"email":"test@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -127,9 +127,10 @@ func (ctrl *CarController) UpdateCar(c *gin.Context) {
 
 	// Convert to UpdateCarData (all fields set for PUT)
 	data := car.UpdateCarData{
-		Model:        &input.Model,
-		BrandID:      &input.BrandID,
-		LicensePlate: &input.LicensePlate,
+		Model:           &input.Model,
+		BrandID:         &input.BrandID,
+		LicensePlate:    &input.LicensePlate,
+		FuelConsumption: input.FuelConsumption,
 	}
 
 	// Execute use case
@@ -179,9 +180,10 @@ func (ctrl *CarController) PatchCar(c *gin.Context) {
 
 	// Convert to UpdateCarData (only set fields for PATCH)
 	data := car.UpdateCarData{
-		Model:        input.Model,
-		BrandID:      input.BrandID,
-		LicensePlate: input.LicensePlate,
+		Model:           input.Model,
+		BrandID:         input.BrandID,
+		LicensePlate:    input.LicensePlate,
+		FuelConsumption: input.FuelConsumption,
 	}
 
 	// Execute use case
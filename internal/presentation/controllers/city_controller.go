@@ -13,6 +13,7 @@ import (
 type CityController struct {
 	listUseCase   *city.ListCitiesUseCase
 	createUseCase *city.CreateCityUseCase
+	updateUseCase *city.UpdateCityUseCase
 	deleteUseCase *city.DeleteCityUseCase
 }
 
@@ -20,11 +21,13 @@ type CityController struct {
 func NewCityController(
 	listUseCase *city.ListCitiesUseCase,
 	createUseCase *city.CreateCityUseCase,
+	updateUseCase *city.UpdateCityUseCase,
 	deleteUseCase *city.DeleteCityUseCase,
 ) *CityController {
 	return &CityController{
 		listUseCase:   listUseCase,
 		createUseCase: createUseCase,
+		updateUseCase: updateUseCase,
 		deleteUseCase: deleteUseCase,
 	}
 }
@@ -88,6 +91,50 @@ func (ctrl *CityController) CreateCity(c *gin.Context) {
 	})
 }
 
+// UpdateCity handles PATCH /cities/:id
+func (ctrl *CityController) UpdateCity(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dtos.UpdateCityInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.updateUseCase.Execute(c.Request.Context(), id, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // DeleteCity handles DELETE /cities/:id
 func (ctrl *CityController) DeleteCity(c *gin.Context) {
 	id := c.Param("id")
@@ -22,8 +22,9 @@ func setupCityController(t *testing.T) (*CityController, *mocks.MockCityReposito
 
 	listUC := city.NewListCitiesUseCase(cityRepo)
 	createUC := city.NewCreateCityUseCase(cityRepo)
+	updateUC := city.NewUpdateCityUseCase(cityRepo)
 	deleteUC := city.NewDeleteCityUseCase(cityRepo)
-	ctrl := NewCityController(listUC, createUC, deleteUC)
+	ctrl := NewCityController(listUC, createUC, updateUC, deleteUC)
 
 	return ctrl, cityRepo
 }
@@ -84,7 +85,7 @@ func TestCityController_CreateCity_Success(t *testing.T) {
 	ctrl, cityRepo := setupCityController(t)
 
 	newCity := &entities.City{ID: "city-1", CityName: "Lyon", Zipcode: "69000"}
-	cityRepo.EXPECT().Create(mock.Anything, entities.CreateCityData{CityName: "Lyon", Zipcode: "69000"}).Return(newCity, nil)
+	cityRepo.EXPECT().Create(mock.Anything, entities.CreateCityData{CityName: "Lyon", Zipcode: "69000", TimeZone: entities.DefaultTimeZone}).Return(newCity, nil)
 
 	router := gin.New()
 	router.POST("/cities", ctrl.CreateCity)
@@ -127,6 +128,71 @@ func TestCityController_CreateCity_ValidationError(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+func TestCityController_UpdateCity_Success(t *testing.T) {
+	ctrl, cityRepo := setupCityController(t)
+
+	lat, lng := 45.7640, 4.8357
+	existing := &entities.City{ID: "city-1", CityName: "Lyon", Zipcode: "69000"}
+	cityRepo.EXPECT().FindByID(mock.Anything, "city-1").Return(existing, nil)
+	updated := &entities.City{ID: "city-1", CityName: "Lyon", Zipcode: "69000", Latitude: &lat, Longitude: &lng}
+	cityRepo.EXPECT().Update(mock.Anything, "city-1", entities.UpdateCityData{Latitude: &lat, Longitude: &lng}).Return(updated, nil)
+
+	router := gin.New()
+	router.PATCH("/cities/:id", ctrl.UpdateCity)
+
+	body := `{"latitude":45.764,"longitude":4.8357}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/cities/city-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestCityController_UpdateCity_InvalidTimeZone(t *testing.T) {
+	ctrl, _ := setupCityController(t)
+
+	router := gin.New()
+	router.PATCH("/cities/:id", ctrl.UpdateCity)
+
+	body := `{"timeZone":"Mars/Olympus"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/cities/city-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCityController_UpdateCity_InvalidJSON(t *testing.T) {
+	ctrl, _ := setupCityController(t)
+
+	router := gin.New()
+	router.PATCH("/cities/:id", ctrl.UpdateCity)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/cities/city-1", bytes.NewBufferString(`{bad`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCityController_UpdateCity_ValidationError(t *testing.T) {
+	ctrl, _ := setupCityController(t)
+
+	router := gin.New()
+	router.PATCH("/cities/:id", ctrl.UpdateCity)
+
+	body := `{"latitude":95}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/cities/city-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestCityController_DeleteCity_Success(t *testing.T) {
 	ctrl, cityRepo := setupCityController(t)
 
@@ -55,7 +55,8 @@ func (ctrl *DriverController) CreateDriver(c *gin.Context) {
 	}
 
 	// Execute use case
-	result, err := ctrl.createUseCase.Execute(c.Request.Context(), userID, input)
+	c.Set("auditTargetId", userID)
+	result, err := ctrl.createUseCase.Execute(c.Request.Context(), userID, c.GetString("sessionId"), input)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/internal/application/usecases/driver"
@@ -21,19 +22,23 @@ func setupDriverController(t *testing.T) (
 	*mocks.MockDriverRepository,
 	*mocks.MockUserRepository,
 	*mocks.MockAuthRepository,
+	*mocks.MockJwtService,
 ) {
 	driverRepo := mocks.NewMockDriverRepository(t)
 	userRepo := mocks.NewMockUserRepository(t)
 	authRepo := mocks.NewMockAuthRepository(t)
+	tokenVersions := mocks.NewMockTokenVersionRepository(t)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, mock.Anything).Return(nil).Maybe()
+	jwtSvc := mocks.NewMockJwtService(t)
 
-	createUC := driver.NewCreateDriverUseCase(driverRepo, userRepo, authRepo)
+	createUC := driver.NewCreateDriverUseCase(driverRepo, userRepo, authRepo, tokenVersions, jwtSvc)
 	ctrl := NewDriverController(createUC)
 
-	return ctrl, driverRepo, userRepo, authRepo
+	return ctrl, driverRepo, userRepo, authRepo, jwtSvc
 }
 
 func TestDriverController_CreateDriver_Success(t *testing.T) {
-	ctrl, driverRepo, userRepo, authRepo := setupDriverController(t)
+	ctrl, driverRepo, userRepo, authRepo, jwtSvc := setupDriverController(t)
 
 	userEntity := &entities.PublicUser{
 		User:  entities.User{ID: "user-1", RefID: 1, AuthRefID: 10},
@@ -48,6 +53,9 @@ func TestDriverController_CreateDriver_Success(t *testing.T) {
 		UserRefID:     1,
 	}).Return(driverEntity, nil)
 	authRepo.EXPECT().UpdateRole(mock.Anything, int64(10), "DRIVER").Return(nil)
+	authRepo.EXPECT().FindByRefID(mock.Anything, int64(10)).Return(&entities.Auth{RefID: 10, Role: "DRIVER", TokenVersion: 1}, nil)
+	jwtSvc.EXPECT().Sign(mock.Anything).Return("driver-token", nil)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -67,10 +75,13 @@ func TestDriverController_CreateDriver_Success(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
 	assert.Equal(t, true, resp["success"])
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "drv-1", data["ID"])
+	assert.Equal(t, "driver-token", data["token"])
 }
 
 func TestDriverController_CreateDriver_InvalidJSON(t *testing.T) {
-	ctrl, _, _, _ := setupDriverController(t)
+	ctrl, _, _, _, _ := setupDriverController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -88,7 +99,7 @@ func TestDriverController_CreateDriver_InvalidJSON(t *testing.T) {
 }
 
 func TestDriverController_CreateDriver_ValidationError(t *testing.T) {
-	ctrl, _, _, _ := setupDriverController(t)
+	ctrl, _, _, _, _ := setupDriverController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -107,7 +118,7 @@ func TestDriverController_CreateDriver_ValidationError(t *testing.T) {
 }
 
 func TestDriverController_CreateDriver_AlreadyExists(t *testing.T) {
-	ctrl, driverRepo, userRepo, _ := setupDriverController(t)
+	ctrl, driverRepo, userRepo, _, _ := setupDriverController(t)
 
 	userEntity := &entities.PublicUser{
 		User:  entities.User{ID: "user-1", RefID: 1, AuthRefID: 10},
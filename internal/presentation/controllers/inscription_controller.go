@@ -16,6 +16,7 @@ type InscriptionController struct {
 	deleteUseCase              *inscription.DeleteInscriptionUseCase
 	listUserInscriptionsUseCase *inscription.ListUserInscriptionsUseCase
 	listTripPassengersUseCase   *inscription.ListTripPassengersUseCase
+	confirmUseCase              *inscription.ConfirmInscriptionUseCase
 }
 
 // NewInscriptionController creates a new InscriptionController
@@ -25,6 +26,7 @@ func NewInscriptionController(
 	deleteUseCase *inscription.DeleteInscriptionUseCase,
 	listUserInscriptionsUseCase *inscription.ListUserInscriptionsUseCase,
 	listTripPassengersUseCase *inscription.ListTripPassengersUseCase,
+	confirmUseCase *inscription.ConfirmInscriptionUseCase,
 ) *InscriptionController {
 	return &InscriptionController{
 		listUseCase:                listUseCase,
@@ -32,6 +34,7 @@ func NewInscriptionController(
 		deleteUseCase:              deleteUseCase,
 		listUserInscriptionsUseCase: listUserInscriptionsUseCase,
 		listTripPassengersUseCase:   listTripPassengersUseCase,
+		confirmUseCase:              confirmUseCase,
 	}
 }
 
@@ -110,6 +113,23 @@ func (ctrl *InscriptionController) DeleteInscription(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ConfirmInscription handles POST /inscriptions/:id/confirm
+func (ctrl *InscriptionController) ConfirmInscription(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	result, err := ctrl.confirmUseCase.Execute(c.Request.Context(), id, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // ListUserInscriptions handles GET /users/:id/inscriptions
 func (ctrl *InscriptionController) ListUserInscriptions(c *gin.Context) {
 	userID := c.Param("id")
@@ -29,10 +29,11 @@ func setupInscriptionController(t *testing.T) (
 
 	listUC := inscription.NewListInscriptionsUseCase(inscRepo)
 	createUC := inscription.NewCreateInscriptionUseCase(inscRepo, userRepo, tripRepo)
-	deleteUC := inscription.NewDeleteInscriptionUseCase(inscRepo)
+	deleteUC := inscription.NewDeleteInscriptionUseCase(inscRepo, tripRepo)
 	listUserUC := inscription.NewListUserInscriptionsUseCase(inscRepo)
 	listPassengersUC := inscription.NewListTripPassengersUseCase(inscRepo)
-	ctrl := NewInscriptionController(listUC, createUC, deleteUC, listUserUC, listPassengersUC)
+	confirmUC := inscription.NewConfirmInscriptionUseCase(inscRepo)
+	ctrl := NewInscriptionController(listUC, createUC, deleteUC, listUserUC, listPassengersUC, confirmUC)
 
 	return ctrl, inscRepo, userRepo, tripRepo
 }
@@ -96,16 +97,18 @@ func TestInscriptionController_CreateInscription_Success(t *testing.T) {
 		User:  entities.User{ID: "user-1", RefID: 1},
 		Email: "test@example.com",
 	}
-	tripEntity := &entities.Trip{ID: "trip-1", RefID: 10, Seats: 3}
+	tripEntity := &entities.Trip{ID: "trip-1", RefID: 10, Seats: 3, Status: entities.TripStatusScheduled}
 	newInsc := &entities.Inscription{ID: "insc-1", UserRefID: 1, TripRefID: 10}
 
 	userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(userEntity, nil)
 	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(tripEntity, nil)
 	inscRepo.EXPECT().ExistsByUserAndTrip(mock.Anything, int64(1), int64(10)).Return(false, nil)
-	inscRepo.EXPECT().CountByTripRefID(mock.Anything, int64(10)).Return(0, nil)
-	inscRepo.EXPECT().Create(mock.Anything, entities.CreateInscriptionData{
-		UserRefID: 1,
-		TripRefID: 10,
+	inscRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{}, nil)
+	inscRepo.EXPECT().Book(mock.Anything, tripEntity, entities.CreateInscriptionData{
+		UserRefID:    1,
+		TripRefID:    10,
+		FromPosition: 0,
+		ToPosition:   1,
 	}).Return(newInsc, nil)
 
 	router := gin.New()
@@ -162,11 +165,12 @@ func TestInscriptionController_CreateInscription_ValidationError(t *testing.T) {
 }
 
 func TestInscriptionController_DeleteInscription_Success(t *testing.T) {
-	ctrl, inscRepo, _, _ := setupInscriptionController(t)
+	ctrl, inscRepo, _, tripRepo := setupInscriptionController(t)
 
-	existing := &entities.Inscription{ID: "insc-1", UserRefID: 1}
+	existing := &entities.Inscription{ID: "insc-1", UserRefID: 1, TripRefID: 2}
 	inscRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(existing, nil)
 	inscRepo.EXPECT().Delete(mock.Anything, "insc-1").Return(nil)
+	tripRepo.EXPECT().FindByRefID(mock.Anything, int64(2)).Return(nil, nil)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -182,6 +186,31 @@ func TestInscriptionController_DeleteInscription_Success(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, w.Code)
 }
 
+func TestInscriptionController_ConfirmInscription_Success(t *testing.T) {
+	ctrl, inscRepo, _, _ := setupInscriptionController(t)
+
+	existing := &entities.Inscription{ID: "insc-1", Status: entities.InscriptionStatusPendingConfirmation}
+	inscRepo.EXPECT().FindByIDAndUserID(mock.Anything, "insc-1", "user-1").Return(existing, nil)
+	inscRepo.EXPECT().UpdateStatus(mock.Anything, "insc-1", entities.InscriptionStatusPendingConfirmation, entities.InscriptionStatusActive).Return(true, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/inscriptions/:id/confirm", ctrl.ConfirmInscription)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/inscriptions/insc-1/confirm", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, entities.InscriptionStatusActive, data["Status"])
+}
+
 func TestInscriptionController_DeleteInscription_NotFound(t *testing.T) {
 	ctrl, inscRepo, _, _ := setupInscriptionController(t)
 
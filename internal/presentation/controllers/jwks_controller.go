@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+)
+
+// JwksController publishes the keys access tokens can be verified with
+type JwksController struct {
+	getJwksUseCase *auth.GetJwksUseCase
+}
+
+// NewJwksController creates a new JwksController
+func NewJwksController(getJwksUseCase *auth.GetJwksUseCase) *JwksController {
+	return &JwksController{
+		getJwksUseCase: getJwksUseCase,
+	}
+}
+
+// GetJWKS handles GET /.well-known/jwks.json. The document is served bare, as JWKS clients expect.
+func (ctrl *JwksController) GetJWKS(c *gin.Context) {
+	// Short cache so rotated keys are picked up quickly
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, ctrl.getJwksUseCase.Execute())
+}
@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+	"github.com/lgxju/gogretago/internal/domain/services"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJwksController_GetJWKS(t *testing.T) {
+	jwtSvc := mocks.NewMockJwtService(t)
+	jwtSvc.EXPECT().PublicKeys().Return(services.JSONWebKeySet{Keys: []services.JSONWebKey{
+		{Kty: "OKP", Kid: "key-1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "abc"},
+	}})
+	ctrl := NewJwksController(auth.NewGetJwksUseCase(jwtSvc))
+
+	router := gin.New()
+	router.GET("/.well-known/jwks.json", ctrl.GetJWKS)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Cache-Control"), "max-age")
+
+	var resp map[string][]map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp["keys"], 1)
+	assert.Equal(t, "key-1", resp["keys"][0]["kid"])
+	assert.Equal(t, "Ed25519", resp["keys"][0]["crv"])
+	assert.NotContains(t, resp["keys"][0], "n")
+}
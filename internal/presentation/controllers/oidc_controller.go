@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+	"github.com/lgxju/gogretago/internal/presentation/validators"
+)
+
+// OidcController handles sign-in through external OpenID Connect providers
+type OidcController struct {
+	startOidcLoginUseCase *auth.StartOidcLoginUseCase
+	oidcCallbackUseCase   *auth.OidcCallbackUseCase
+}
+
+// NewOidcController creates a new OidcController
+func NewOidcController(
+	startOidcLoginUseCase *auth.StartOidcLoginUseCase,
+	oidcCallbackUseCase *auth.OidcCallbackUseCase,
+) *OidcController {
+	return &OidcController{
+		startOidcLoginUseCase: startOidcLoginUseCase,
+		oidcCallbackUseCase:   oidcCallbackUseCase,
+	}
+}
+
+// Authorize handles GET /auth/oidc/:provider/authorize. The client sends the user to the returned URL.
+func (ctrl *OidcController) Authorize(c *gin.Context) {
+	result, err := ctrl.startOidcLoginUseCase.Execute(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Callback handles POST /auth/oidc/:provider/callback with the code and state the provider redirected back with
+func (ctrl *OidcController) Callback(c *gin.Context) {
+	var input dtos.OidcCallbackInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	result, err := ctrl.oidcCallbackUseCase.Execute(c.Request.Context(), c.Param("provider"), sessionClient(c), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	auditLogin(c, result)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
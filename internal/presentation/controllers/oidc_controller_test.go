@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type oidcControllerDeps struct {
+	ctrl      *OidcController
+	oidcSvc   *mocks.MockOidcService
+	stateRepo *mocks.MockOidcLoginStateRepository
+	tokenSvc  *mocks.MockTokenService
+}
+
+func setupOidcController(t *testing.T) oidcControllerDeps {
+	d := oidcControllerDeps{
+		oidcSvc:   mocks.NewMockOidcService(t),
+		stateRepo: mocks.NewMockOidcLoginStateRepository(t),
+		tokenSvc:  mocks.NewMockTokenService(t),
+	}
+	startUC := auth.NewStartOidcLoginUseCase(d.oidcSvc, d.stateRepo, d.tokenSvc)
+	callbackUC := auth.NewOidcCallbackUseCase(d.oidcSvc, d.stateRepo,
+		mocks.NewMockExternalIdentityRepository(t), mocks.NewMockAuthRepository(t), mocks.NewMockUserRepository(t),
+		mocks.NewMockRefreshTokenRepository(t), mocks.NewMockSessionRepository(t), mocks.NewMockOneTimeTokenRepository(t), mocks.NewMockPasswordService(t),
+		mocks.NewMockJwtService(t), d.tokenSvc, mocks.NewMockTotpService(t), entities.TwoFactorPolicy{})
+	d.ctrl = NewOidcController(startUC, callbackUC)
+	return d
+}
+
+func TestOidcController_Authorize_Success(t *testing.T) {
+	d := setupOidcController(t)
+
+	d.oidcSvc.EXPECT().HasProvider("google").Return(true)
+	d.tokenSvc.EXPECT().Generate().Return("random", nil).Times(3)
+	d.oidcSvc.EXPECT().AuthorizationURL(mock.Anything, "google", "random", "random", "random").Return("https://idp.example.com/authorize", nil)
+	d.tokenSvc.EXPECT().Hash("random").Return("random-hash")
+	d.stateRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	router := gin.New()
+	router.GET("/auth/oidc/:provider/authorize", d.ctrl.Authorize)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/google/authorize", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "https://idp.example.com/authorize", data["authorizationUrl"])
+	assert.NotEmpty(t, data["expiresAt"])
+}
+
+func TestOidcController_Authorize_UnknownProvider(t *testing.T) {
+	d := setupOidcController(t)
+
+	d.oidcSvc.EXPECT().HasProvider("myspace").Return(false)
+
+	router := gin.New()
+	router.GET("/auth/oidc/:provider/authorize", d.ctrl.Authorize)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/myspace/authorize", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestOidcController_Callback_UnknownState(t *testing.T) {
+	d := setupOidcController(t)
+
+	d.tokenSvc.EXPECT().Hash("state").Return("state-hash")
+	d.stateRepo.EXPECT().Consume(mock.Anything, "state-hash").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/auth/oidc/:provider/callback", d.ctrl.Callback)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(`{"code":"auth-code","state":"state"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestOidcController_Callback_ValidationError(t *testing.T) {
+	d := setupOidcController(t)
+
+	router := gin.New()
+	router.POST("/auth/oidc/:provider/callback", d.ctrl.Callback)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(`{"code":"auth-code"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+)
+
+// maxUserAgentLength caps the User-Agent header stored with a session
+const maxUserAgentLength = 512
+
+// SessionController handles the signed-in devices of the current user
+type SessionController struct {
+	listSessionsUseCase      *auth.ListSessionsUseCase
+	revokeSessionUseCase     *auth.RevokeSessionUseCase
+	revokeAllSessionsUseCase *auth.RevokeAllSessionsUseCase
+}
+
+// NewSessionController creates a new SessionController
+func NewSessionController(
+	listSessionsUseCase *auth.ListSessionsUseCase,
+	revokeSessionUseCase *auth.RevokeSessionUseCase,
+	revokeAllSessionsUseCase *auth.RevokeAllSessionsUseCase,
+) *SessionController {
+	return &SessionController{
+		listSessionsUseCase:      listSessionsUseCase,
+		revokeSessionUseCase:     revokeSessionUseCase,
+		revokeAllSessionsUseCase: revokeAllSessionsUseCase,
+	}
+}
+
+// ListMySessions handles GET /users/me/sessions
+func (ctrl *SessionController) ListMySessions(c *gin.Context) {
+	result, err := ctrl.listSessionsUseCase.Execute(c.Request.Context(), c.GetString("userId"), c.GetString("sessionId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// RevokeMySession handles DELETE /users/me/sessions/:sessionId
+func (ctrl *SessionController) RevokeMySession(c *gin.Context) {
+	err := ctrl.revokeSessionUseCase.Execute(c.Request.Context(), c.GetString("userId"), c.Param("sessionId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeAllMySessions handles DELETE /users/me/sessions
+func (ctrl *SessionController) RevokeAllMySessions(c *gin.Context) {
+	err := ctrl.revokeAllSessionsUseCase.Execute(c.Request.Context(), c.GetString("userId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// sessionClient describes the device a sign-in request comes from
+func sessionClient(c *gin.Context) entities.SessionClient {
+	userAgent := c.Request.UserAgent()
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	return entities.SessionClient{UserAgent: userAgent, IPAddress: c.ClientIP()}
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionControllerDeps struct {
+	ctrl        *SessionController
+	userRepo    *mocks.MockUserRepository
+	sessionRepo *mocks.MockSessionRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	revokedRepo *mocks.MockRevokedTokenRepository
+}
+
+func setupSessionController(t *testing.T) sessionControllerDeps {
+	d := sessionControllerDeps{
+		userRepo:    mocks.NewMockUserRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		revokedRepo: mocks.NewMockRevokedTokenRepository(t),
+	}
+	jwtSvc := mocks.NewMockJwtService(t)
+	jwtSvc.EXPECT().AccessTokenTTL().Return(15 * time.Minute)
+	d.ctrl = NewSessionController(
+		auth.NewListSessionsUseCase(d.userRepo, d.sessionRepo),
+		auth.NewRevokeSessionUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, jwtSvc),
+		auth.NewRevokeAllSessionsUseCase(d.userRepo, d.sessionRepo, d.refreshRepo, d.revokedRepo, jwtSvc),
+	)
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 1}}, nil).Maybe()
+	return d
+}
+
+func withSessionContext(userID, sessionID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("userId", userID)
+		c.Set("sessionId", sessionID)
+		c.Next()
+	}
+}
+
+func TestSessionController_ListMySessions(t *testing.T) {
+	d := setupSessionController(t)
+
+	d.sessionRepo.EXPECT().FindActiveByAuthRefID(mock.Anything, int64(1)).Return([]entities.Session{
+		{ID: "session-1", UserAgent: "Firefox"},
+		{ID: "session-2", UserAgent: "curl"},
+	}, nil)
+
+	router := gin.New()
+	router.GET("/users/me/sessions", withSessionContext("user-1", "session-2"), d.ctrl.ListMySessions)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users/me/sessions", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].([]interface{})
+	require.Len(t, data, 2)
+	assert.Equal(t, false, data[0].(map[string]interface{})["current"])
+	assert.Equal(t, true, data[1].(map[string]interface{})["current"])
+}
+
+func TestSessionController_RevokeMySession(t *testing.T) {
+	d := setupSessionController(t)
+
+	d.sessionRepo.EXPECT().FindByID(mock.Anything, "session-1").Return(&entities.Session{ID: "session-1", AuthRefID: 1}, nil)
+	d.sessionRepo.EXPECT().Revoke(mock.Anything, "session-1").Return(true, nil)
+	d.refreshRepo.EXPECT().RevokeFamily(mock.Anything, "session-1").Return(nil)
+	d.revokedRepo.EXPECT().Revoke(mock.Anything, "session-1", mock.Anything).Return(nil)
+
+	router := gin.New()
+	router.DELETE("/users/me/sessions/:sessionId", withSessionContext("user-1", "session-2"), d.ctrl.RevokeMySession)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/session-1", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestSessionController_RevokeMySession_NotFound(t *testing.T) {
+	d := setupSessionController(t)
+
+	d.sessionRepo.EXPECT().FindByID(mock.Anything, "missing").Return(nil, nil)
+
+	router := gin.New()
+	router.DELETE("/users/me/sessions/:sessionId", withSessionContext("user-1", "session-2"), d.ctrl.RevokeMySession)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/missing", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestSessionController_RevokeAllMySessions(t *testing.T) {
+	d := setupSessionController(t)
+
+	d.sessionRepo.EXPECT().RevokeAllForAuth(mock.Anything, int64(1)).Return([]string{"session-1"}, nil)
+	d.refreshRepo.EXPECT().RevokeAllForAuth(mock.Anything, int64(1)).Return(nil)
+	d.refreshRepo.EXPECT().RevokeFamily(mock.Anything, "session-1").Return(nil)
+	d.revokedRepo.EXPECT().Revoke(mock.Anything, "session-1", mock.Anything).Return(nil)
+
+	router := gin.New()
+	router.DELETE("/users/me/sessions", withSessionContext("user-1", "session-1"), d.ctrl.RevokeAllMySessions)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestSessionClient_TruncatesUserAgent(t *testing.T) {
+	var client entities.SessionClient
+	router := gin.New()
+	router.GET("/", func(c *gin.Context) { client = sessionClient(c) })
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.Header.Set("User-Agent", strings.Repeat("a", maxUserAgentLength+100))
+	req.RemoteAddr = "203.0.113.9:4321"
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Len(t, client.UserAgent, maxUserAgentLength)
+	assert.Equal(t, "203.0.113.9", client.IPAddress)
+}
@@ -6,16 +6,22 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/internal/application/dtos"
 	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/validators"
 )
 
 // TripController handles trip endpoints
 type TripController struct {
-	listUseCase   *trip.ListTripsUseCase
-	getUseCase    *trip.GetTripUseCase
-	findUseCase   *trip.FindTripsUseCase
-	createUseCase *trip.CreateTripUseCase
-	deleteUseCase *trip.DeleteTripUseCase
+	listUseCase    *trip.ListTripsUseCase
+	getUseCase     *trip.GetTripUseCase
+	findUseCase    *trip.FindTripsUseCase
+	createUseCase  *trip.CreateTripUseCase
+	deleteUseCase  *trip.DeleteTripUseCase
+	statusUseCase  *trip.ChangeTripStatusUseCase
+	cancelUseCase  *trip.CancelTripUseCase
+	quoteUseCase   *trip.QuoteTripPriceUseCase
+	updateUseCase  *trip.UpdateTripUseCase
+	changesUseCase *trip.ListTripChangesUseCase
 }
 
 // NewTripController creates a new TripController
@@ -25,13 +31,23 @@ func NewTripController(
 	findUseCase *trip.FindTripsUseCase,
 	createUseCase *trip.CreateTripUseCase,
 	deleteUseCase *trip.DeleteTripUseCase,
+	statusUseCase *trip.ChangeTripStatusUseCase,
+	cancelUseCase *trip.CancelTripUseCase,
+	quoteUseCase *trip.QuoteTripPriceUseCase,
+	updateUseCase *trip.UpdateTripUseCase,
+	changesUseCase *trip.ListTripChangesUseCase,
 ) *TripController {
 	return &TripController{
-		listUseCase:   listUseCase,
-		getUseCase:    getUseCase,
-		findUseCase:   findUseCase,
-		createUseCase: createUseCase,
-		deleteUseCase: deleteUseCase,
+		listUseCase:    listUseCase,
+		getUseCase:     getUseCase,
+		findUseCase:    findUseCase,
+		createUseCase:  createUseCase,
+		deleteUseCase:  deleteUseCase,
+		statusUseCase:  statusUseCase,
+		cancelUseCase:  cancelUseCase,
+		quoteUseCase:   quoteUseCase,
+		updateUseCase:  updateUseCase,
+		changesUseCase: changesUseCase,
 	}
 }
 
@@ -82,6 +98,20 @@ func (ctrl *TripController) FindTrip(c *gin.Context) {
 		return
 	}
 
+	validate := validators.GetValidator()
+	if err := validate.Struct(query); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
 	result, err := ctrl.findUseCase.Execute(c.Request.Context(), query)
 	if err != nil {
 		_ = c.Error(err)
@@ -94,6 +124,46 @@ func (ctrl *TripController) FindTrip(c *gin.Context) {
 	})
 }
 
+// QuotePrice handles GET /trips/price-quote
+func (ctrl *TripController) QuotePrice(c *gin.Context) {
+	var query dtos.PriceQuoteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid query parameters",
+			},
+		})
+		return
+	}
+
+	validate := validators.GetValidator()
+	if err := validate.Struct(query); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.quoteUseCase.Execute(c.Request.Context(), query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // CreateTrip handles POST /trips
 func (ctrl *TripController) CreateTrip(c *gin.Context) {
 	userID := c.GetString("userId")
@@ -138,12 +208,140 @@ func (ctrl *TripController) CreateTrip(c *gin.Context) {
 	})
 }
 
+// UpdateTrip handles PUT /trips/:id
+func (ctrl *TripController) UpdateTrip(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	var input dtos.UpdateTripInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Convert to UpdateTripData (all fields set for PUT)
+	data := trip.UpdateTripData{
+		Kms:           &input.Kms,
+		Date:          &input.Date,
+		DepartureCity: &input.DepartureCity,
+		ArrivalCity:   &input.ArrivalCity,
+		Seats:         &input.Seats,
+		CarID:         &input.CarID,
+		ArrivalTime:   input.ArrivalTime,
+		PricePerSeat:  input.PricePerSeat,
+	}
+
+	result, err := ctrl.updateUseCase.Execute(c.Request.Context(), id, userID, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// PatchTrip handles PATCH /trips/:id
+func (ctrl *TripController) PatchTrip(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	var input dtos.PatchTripInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Convert to UpdateTripData (only set fields for PATCH)
+	data := trip.UpdateTripData{
+		Kms:           input.Kms,
+		Date:          input.Date,
+		DepartureCity: input.DepartureCity,
+		ArrivalCity:   input.ArrivalCity,
+		Seats:         input.Seats,
+		CarID:         input.CarID,
+		ArrivalTime:   input.ArrivalTime,
+		PricePerSeat:  input.PricePerSeat,
+	}
+
+	result, err := ctrl.updateUseCase.Execute(c.Request.Context(), id, userID, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// ListTripChanges handles GET /trips/:id/changes
+func (ctrl *TripController) ListTripChanges(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	result, err := ctrl.changesUseCase.Execute(c.Request.Context(), id, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // DeleteTrip handles DELETE /trips/:id
 func (ctrl *TripController) DeleteTrip(c *gin.Context) {
 	id := c.Param("id")
-	userID := c.GetString("userId")
 
-	err := ctrl.deleteUseCase.Execute(c.Request.Context(), id, userID)
+	err := ctrl.deleteUseCase.Execute(c.Request.Context(), id)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -151,3 +349,73 @@ func (ctrl *TripController) DeleteTrip(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// StartTrip handles POST /trips/:id/start
+func (ctrl *TripController) StartTrip(c *gin.Context) {
+	ctrl.changeStatus(c, entities.TripStatusInProgress)
+}
+
+// CompleteTrip handles POST /trips/:id/complete
+func (ctrl *TripController) CompleteTrip(c *gin.Context) {
+	ctrl.changeStatus(c, entities.TripStatusCompleted)
+}
+
+// CancelTrip handles POST /trips/:id/cancel
+func (ctrl *TripController) CancelTrip(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	var input dtos.CancelTripInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.cancelUseCase.Execute(c.Request.Context(), id, userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (ctrl *TripController) changeStatus(c *gin.Context, status string) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	result, err := ctrl.statusUseCase.Execute(c.Request.Context(), id, userID, status)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
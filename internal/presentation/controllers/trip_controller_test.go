@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/internal/application/usecases/trip"
@@ -24,19 +25,46 @@ func setupTripController(t *testing.T) (
 	*mocks.MockCarRepository,
 	*mocks.MockCityRepository,
 ) {
-	tripRepo := mocks.NewMockTripRepository(t)
-	driverRepo := mocks.NewMockDriverRepository(t)
-	carRepo := mocks.NewMockCarRepository(t)
-	cityRepo := mocks.NewMockCityRepository(t)
+	d := setupTripControllerDeps(t)
+	return d.ctrl, d.tripRepo, d.driverRepo, d.carRepo, d.cityRepo
+}
+
+type tripControllerDeps struct {
+	ctrl            *TripController
+	tripRepo        *mocks.MockTripRepository
+	driverRepo      *mocks.MockDriverRepository
+	carRepo         *mocks.MockCarRepository
+	cityRepo        *mocks.MockCityRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+	userRepo        *mocks.MockUserRepository
+	emailService    *mocks.MockEmailService
+}
 
-	listUC := trip.NewListTripsUseCase(tripRepo)
-	getUC := trip.NewGetTripUseCase(tripRepo)
-	findUC := trip.NewFindTripsUseCase(tripRepo)
-	createUC := trip.NewCreateTripUseCase(tripRepo, driverRepo, carRepo, cityRepo)
-	deleteUC := trip.NewDeleteTripUseCase(tripRepo, driverRepo)
-	ctrl := NewTripController(listUC, getUC, findUC, createUC, deleteUC)
+func setupTripControllerDeps(t *testing.T) tripControllerDeps {
+	d := tripControllerDeps{
+		tripRepo:        mocks.NewMockTripRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		carRepo:         mocks.NewMockCarRepository(t),
+		cityRepo:        mocks.NewMockCityRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		emailService:    mocks.NewMockEmailService(t),
+	}
 
-	return ctrl, tripRepo, driverRepo, carRepo, cityRepo
+	listUC := trip.NewListTripsUseCase(d.tripRepo)
+	getUC := trip.NewGetTripUseCase(d.tripRepo)
+	findUC := trip.NewFindTripsUseCase(d.tripRepo, d.cityRepo)
+	pricing := entities.PricingRules{Currency: "EUR", CostPerKm: 20}
+	createUC := trip.NewCreateTripUseCase(d.tripRepo, d.driverRepo, d.carRepo, d.cityRepo, pricing)
+	deleteUC := trip.NewDeleteTripUseCase(d.tripRepo, d.inscriptionRepo)
+	statusUC := trip.NewChangeTripStatusUseCase(d.tripRepo, d.driverRepo)
+	cancelUC := trip.NewCancelTripUseCase(d.tripRepo, d.driverRepo, d.inscriptionRepo, d.userRepo, d.emailService)
+	quoteUC := trip.NewQuoteTripPriceUseCase(d.carRepo, pricing)
+	updateUC := trip.NewUpdateTripUseCase(d.tripRepo, d.driverRepo, d.carRepo, d.cityRepo, d.inscriptionRepo, d.userRepo, d.emailService, pricing)
+	changesUC := trip.NewListTripChangesUseCase(d.tripRepo, d.driverRepo, d.userRepo, d.inscriptionRepo)
+	d.ctrl = NewTripController(listUC, getUC, findUC, createUC, deleteUC, statusUC, cancelUC, quoteUC, updateUC, changesUC)
+
+	return d
 }
 
 func TestTripController_ListTrips_Success(t *testing.T) {
@@ -202,18 +230,18 @@ func TestTripController_CreateTrip_ValidationError(t *testing.T) {
 }
 
 func TestTripController_DeleteTrip_Success(t *testing.T) {
-	ctrl, tripRepo, driverRepo, _, _ := setupTripController(t)
+	d := setupTripControllerDeps(t)
+	ctrl := d.ctrl
 
 	existing := &entities.Trip{ID: "trip-1", DriverRefID: 1}
-	driver := &entities.Driver{ID: "drv-1", RefID: 1}
 
-	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
-	driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(driver, nil)
-	tripRepo.EXPECT().Delete(mock.Anything, "trip-1").Return(nil)
+	d.tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{}, nil)
+	d.tripRepo.EXPECT().Delete(mock.Anything, "trip-1").Return(nil)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
-		c.Set("userId", "user-1")
+		c.Set("userId", "admin-1")
 		c.Next()
 	})
 	router.DELETE("/trips/:id", ctrl.DeleteTrip)
@@ -243,3 +271,273 @@ func TestTripController_DeleteTrip_NotFound(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestTripController_StartTrip_Success(t *testing.T) {
+	ctrl, tripRepo, driverRepo, _, _ := setupTripController(t)
+
+	existing := &entities.Trip{ID: "trip-1", DriverRefID: 1, Status: entities.TripStatusScheduled}
+	driver := &entities.Driver{ID: "drv-1", RefID: 1}
+
+	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
+	driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(driver, nil)
+	tripRepo.EXPECT().UpdateStatus(mock.Anything, "trip-1", entities.TripStatusScheduled, entities.TripStatusInProgress).Return(true, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/trips/:id/start", ctrl.StartTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trips/trip-1/start", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, entities.TripStatusInProgress, resp["data"].(map[string]interface{})["Status"])
+}
+
+func TestTripController_CompleteTrip_IllegalTransition(t *testing.T) {
+	ctrl, tripRepo, driverRepo, _, _ := setupTripController(t)
+
+	existing := &entities.Trip{ID: "trip-1", DriverRefID: 1, Status: entities.TripStatusScheduled}
+	driver := &entities.Driver{ID: "drv-1", RefID: 1}
+
+	tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
+	driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(driver, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/trips/:id/complete", ctrl.CompleteTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trips/trip-1/complete", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTripController_CancelTrip_Success(t *testing.T) {
+	d := setupTripControllerDeps(t)
+
+	existing := &entities.Trip{ID: "trip-1", RefID: 5, DriverRefID: 1, Status: entities.TripStatusScheduled}
+	driver := &entities.Driver{ID: "drv-1", RefID: 1}
+
+	d.tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
+	d.driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(driver, nil)
+	d.tripRepo.EXPECT().Cancel(mock.Anything, "trip-1", "Car broke down").Return(true, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{}, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/trips/:id/cancel", d.ctrl.CancelTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trips/trip-1/cancel", bytes.NewBufferString(`{"reason":"Car broke down"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, entities.TripStatusCancelled, data["Status"])
+	assert.Equal(t, "Car broke down", data["CancellationReason"])
+}
+
+func TestTripController_CancelTrip_ReasonRequired(t *testing.T) {
+	d := setupTripControllerDeps(t)
+
+	router := gin.New()
+	router.POST("/trips/:id/cancel", d.ctrl.CancelTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trips/trip-1/cancel", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTripController_FindTrip_DepartureWindow(t *testing.T) {
+	ctrl, tripRepo, _, _, _ := setupTripController(t)
+
+	after, before := "07:00", "09:00"
+	tripRepo.EXPECT().FindByFilters(mock.Anything, entities.TripFilters{
+		DepartureAfter:  &after,
+		DepartureBefore: &before,
+		Statuses:        []string{entities.TripStatusScheduled},
+	}).Return([]entities.Trip{}, nil)
+
+	router := gin.New()
+	router.GET("/trips/search", ctrl.FindTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/search?departureAfter=07:00&departureBefore=09:00", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTripController_FindTrip_InvalidSort(t *testing.T) {
+	ctrl, _, _, _, _ := setupTripController(t)
+
+	router := gin.New()
+	router.GET("/trips/search", ctrl.FindTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/search?sort=seats", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+	assert.Contains(t, errObj["details"], "Sort")
+}
+
+func TestTripController_QuotePrice_Success(t *testing.T) {
+	ctrl, _, _, _, _ := setupTripController(t)
+
+	router := gin.New()
+	router.GET("/trips/price-quote", ctrl.QuotePrice)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/price-quote?kms=450&seats=3", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, float64(2250), data["SuggestedPrice"])
+	assert.Equal(t, float64(3000), data["MaxPrice"])
+}
+
+func TestTripController_QuotePrice_MissingSeats(t *testing.T) {
+	ctrl, _, _, _, _ := setupTripController(t)
+
+	router := gin.New()
+	router.GET("/trips/price-quote", ctrl.QuotePrice)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/price-quote?kms=450", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+	assert.Contains(t, errObj["details"], "Seats")
+}
+
+func TestTripController_PatchTrip_Success(t *testing.T) {
+	d := setupTripControllerDeps(t)
+
+	departure := time.Date(2026, 6, 15, 6, 0, 0, 0, time.UTC)
+	existing := &entities.Trip{
+		ID: "trip-1", RefID: 5, DriverRefID: 1, CarRefID: 7, Status: entities.TripStatusScheduled,
+		DateTrip: departure, EstimatedArrival: departure.Add(5 * time.Hour), TimeZone: "Europe/Paris",
+		Kms: 400, Seats: 3, PricePerSeat: 2000, Currency: "EUR",
+	}
+	updated := *existing
+	updated.Seats = 4
+	seats := 4
+
+	d.tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(existing, nil)
+	d.driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(&entities.Driver{ID: "drv-1", RefID: 1}, nil)
+	d.inscriptionRepo.EXPECT().FindByTripID(mock.Anything, "trip-1").Return([]entities.Inscription{}, nil)
+	d.carRepo.EXPECT().FindByRefID(mock.Anything, int64(7)).Return(&entities.Car{ID: "car-1", RefID: 7}, nil)
+	d.tripRepo.EXPECT().Update(mock.Anything, "trip-1", entities.UpdateTripData{
+		Seats:   &seats,
+		Changes: []entities.CreateTripChangeData{{Field: entities.TripChangeSeats, OldValue: "3", NewValue: "4"}},
+	}).Return(&updated, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.PATCH("/trips/:id", d.ctrl.PatchTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/trips/trip-1", bytes.NewBufferString(`{"seats":4}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, float64(4), data["Seats"])
+}
+
+func TestTripController_UpdateTrip_ValidationError(t *testing.T) {
+	ctrl, _, _, _, _ := setupTripController(t)
+
+	router := gin.New()
+	router.PUT("/trips/:id", ctrl.UpdateTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/trips/trip-1", bytes.NewBufferString(`{"seats":4}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTripController_ListTripChanges_Success(t *testing.T) {
+	d := setupTripControllerDeps(t)
+
+	d.tripRepo.EXPECT().FindByID(mock.Anything, "trip-1").Return(&entities.Trip{ID: "trip-1", RefID: 5, DriverRefID: 1}, nil)
+	d.driverRepo.EXPECT().FindByUserID(mock.Anything, "user-1").Return(&entities.Driver{ID: "drv-1", RefID: 1}, nil)
+	d.tripRepo.EXPECT().FindChanges(mock.Anything, int64(5)).Return([]entities.TripChange{
+		{ID: "change-1", TripRefID: 5, Field: entities.TripChangeSeats, OldValue: "3", NewValue: "4"},
+	}, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.GET("/trips/:id/changes", d.ctrl.ListTripChanges)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/trip-1/changes", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].([]interface{})
+	require.Len(t, data, 1)
+	assert.Equal(t, entities.TripChangeSeats, data[0].(map[string]interface{})["Field"])
+}
+
+func TestTripController_FindTrip_InvalidLatitude(t *testing.T) {
+	ctrl, _, _, _, _ := setupTripController(t)
+
+	router := gin.New()
+	router.GET("/trips/search", ctrl.FindTrip)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trips/search?departureLat=95&departureLng=4.8&departureRadius=10", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	errObj := resp["error"].(map[string]interface{})
+	assert.Contains(t, errObj["details"], "DepartureLat")
+}
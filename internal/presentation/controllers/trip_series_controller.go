@@ -0,0 +1,265 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/tripseries"
+	"github.com/lgxju/gogretago/internal/presentation/validators"
+)
+
+// TripSeriesController handles recurring trip endpoints
+type TripSeriesController struct {
+	createUseCase           *tripseries.CreateTripSeriesUseCase
+	getUseCase              *tripseries.GetTripSeriesUseCase
+	updateUseCase           *tripseries.UpdateTripSeriesUseCase
+	cancelUseCase           *tripseries.CancelTripSeriesUseCase
+	updateOccurrenceUseCase *tripseries.UpdateTripOccurrenceUseCase
+	subscribeUseCase        *tripseries.SubscribeTripSeriesUseCase
+	unsubscribeUseCase      *tripseries.UnsubscribeTripSeriesUseCase
+}
+
+// NewTripSeriesController creates a new TripSeriesController
+func NewTripSeriesController(
+	createUseCase *tripseries.CreateTripSeriesUseCase,
+	getUseCase *tripseries.GetTripSeriesUseCase,
+	updateUseCase *tripseries.UpdateTripSeriesUseCase,
+	cancelUseCase *tripseries.CancelTripSeriesUseCase,
+	updateOccurrenceUseCase *tripseries.UpdateTripOccurrenceUseCase,
+	subscribeUseCase *tripseries.SubscribeTripSeriesUseCase,
+	unsubscribeUseCase *tripseries.UnsubscribeTripSeriesUseCase,
+) *TripSeriesController {
+	return &TripSeriesController{
+		createUseCase:           createUseCase,
+		getUseCase:              getUseCase,
+		updateUseCase:           updateUseCase,
+		cancelUseCase:           cancelUseCase,
+		updateOccurrenceUseCase: updateOccurrenceUseCase,
+		subscribeUseCase:        subscribeUseCase,
+		unsubscribeUseCase:      unsubscribeUseCase,
+	}
+}
+
+// CreateTripSeries handles POST /trip-series
+func (ctrl *TripSeriesController) CreateTripSeries(c *gin.Context) {
+	userID := c.GetString("userId")
+
+	var input dtos.CreateTripSeriesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.createUseCase.Execute(c.Request.Context(), userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetTripSeries handles GET /trip-series/:id
+func (ctrl *TripSeriesController) GetTripSeries(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := ctrl.getUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// PatchTripSeries handles PATCH /trip-series/:id
+func (ctrl *TripSeriesController) PatchTripSeries(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	var input dtos.UpdateTripSeriesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.updateUseCase.Execute(c.Request.Context(), id, userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// CancelTripSeries handles POST /trip-series/:id/cancel
+func (ctrl *TripSeriesController) CancelTripSeries(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	var input dtos.CancelTripInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.cancelUseCase.Execute(c.Request.Context(), id, userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// PatchOccurrence handles PATCH /trip-series/:id/occurrences/:tripId
+func (ctrl *TripSeriesController) PatchOccurrence(c *gin.Context) {
+	id := c.Param("id")
+	tripID := c.Param("tripId")
+	userID := c.GetString("userId")
+
+	var input dtos.UpdateTripOccurrenceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	result, err := ctrl.updateOccurrenceUseCase.Execute(c.Request.Context(), id, tripID, userID, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Subscribe handles POST /trip-series/:id/subscription
+func (ctrl *TripSeriesController) Subscribe(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	result, err := ctrl.subscribeUseCase.Execute(c.Request.Context(), id, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// Unsubscribe handles DELETE /trip-series/:id/subscription
+func (ctrl *TripSeriesController) Unsubscribe(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.GetString("userId")
+
+	err := ctrl.unsubscribeUseCase.Execute(c.Request.Context(), id, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/trip"
+	"github.com/lgxju/gogretago/internal/application/usecases/tripseries"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type tripSeriesControllerDeps struct {
+	ctrl            *TripSeriesController
+	seriesRepo      *mocks.MockTripSeriesRepository
+	tripRepo        *mocks.MockTripRepository
+	driverRepo      *mocks.MockDriverRepository
+	userRepo        *mocks.MockUserRepository
+	inscriptionRepo *mocks.MockInscriptionRepository
+}
+
+func setupTripSeriesController(t *testing.T) tripSeriesControllerDeps {
+	d := tripSeriesControllerDeps{
+		seriesRepo:      mocks.NewMockTripSeriesRepository(t),
+		tripRepo:        mocks.NewMockTripRepository(t),
+		driverRepo:      mocks.NewMockDriverRepository(t),
+		userRepo:        mocks.NewMockUserRepository(t),
+		inscriptionRepo: mocks.NewMockInscriptionRepository(t),
+	}
+	carRepo := mocks.NewMockCarRepository(t)
+	cityRepo := mocks.NewMockCityRepository(t)
+	emailService := mocks.NewMockEmailService(t)
+
+	pricing := entities.PricingRules{Currency: entities.DefaultCurrency}
+	generator := tripseries.NewGenerateTripOccurrencesUseCase(d.seriesRepo, d.tripRepo, d.inscriptionRepo, 0)
+	createUC := tripseries.NewCreateTripSeriesUseCase(d.seriesRepo, d.driverRepo, carRepo, cityRepo, pricing, generator)
+	getUC := tripseries.NewGetTripSeriesUseCase(d.seriesRepo)
+	updateTripUC := trip.NewUpdateTripUseCase(d.tripRepo, d.driverRepo, carRepo, cityRepo, d.inscriptionRepo, d.userRepo, emailService, pricing)
+	updateUC := tripseries.NewUpdateTripSeriesUseCase(d.seriesRepo, d.driverRepo, d.tripRepo, d.inscriptionRepo, d.userRepo, emailService, updateTripUC, generator)
+	cancelUC := tripseries.NewCancelTripSeriesUseCase(d.seriesRepo, d.driverRepo, d.tripRepo, d.inscriptionRepo, d.userRepo, emailService)
+	occurrenceUC := tripseries.NewUpdateTripOccurrenceUseCase(d.seriesRepo, d.driverRepo, d.tripRepo, updateTripUC)
+	subscribeUC := tripseries.NewSubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+	unsubscribeUC := tripseries.NewUnsubscribeTripSeriesUseCase(d.seriesRepo, d.userRepo, d.tripRepo, d.inscriptionRepo)
+	d.ctrl = NewTripSeriesController(createUC, getUC, updateUC, cancelUC, occurrenceUC, subscribeUC, unsubscribeUC)
+
+	return d
+}
+
+func TestTripSeriesController_GetTripSeries_Success(t *testing.T) {
+	d := setupTripSeriesController(t)
+
+	series := &entities.TripSeries{ID: "series-1", DepartureCity: "Paris", ArrivalCity: "Lyon", Weekdays: []string{"MO", "FR"}}
+	d.seriesRepo.EXPECT().FindByID(mock.Anything, "series-1").Return(series, nil)
+
+	router := gin.New()
+	router.GET("/trip-series/:id", d.ctrl.GetTripSeries)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trip-series/series-1", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"MO", "FR"}, data["Weekdays"])
+}
+
+func TestTripSeriesController_CreateTripSeries_ValidationError(t *testing.T) {
+	d := setupTripSeriesController(t)
+
+	router := gin.New()
+	router.Use(withUserID("user-1"))
+	router.POST("/trip-series", d.ctrl.CreateTripSeries)
+
+	body := `{"kms":160,"seats":3,"carId":"car-1","departureCity":"Paris","arrivalCity":"Lyon","departureTime":"07:30","weekdays":["MONDAY"],"startDate":"2026-10-05"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trip-series", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTripSeriesController_CancelTripSeries_ReasonRequired(t *testing.T) {
+	d := setupTripSeriesController(t)
+
+	router := gin.New()
+	router.Use(withUserID("user-1"))
+	router.POST("/trip-series/:id/cancel", d.ctrl.CancelTripSeries)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trip-series/series-1/cancel", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTripSeriesController_Subscribe_Success(t *testing.T) {
+	d := setupTripSeriesController(t)
+
+	series := &entities.TripSeries{ID: "series-1", RefID: 40, Status: entities.TripSeriesStatusActive}
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", RefID: 10}}, nil)
+	d.seriesRepo.EXPECT().FindByID(mock.Anything, "series-1").Return(series, nil)
+	d.seriesRepo.EXPECT().IsSubscribed(mock.Anything, int64(40), int64(10)).Return(false, nil)
+	d.seriesRepo.EXPECT().Subscribe(mock.Anything, int64(40), int64(10)).Return(nil)
+	d.tripRepo.EXPECT().FindUpcomingBySeries(mock.Anything, int64(40)).Return([]entities.Trip{}, nil)
+
+	router := gin.New()
+	router.Use(withUserID("user-1"))
+	router.POST("/trip-series/:id/subscription", d.ctrl.Subscribe)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/trip-series/series-1/subscription", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestTripSeriesController_Unsubscribe_NotSubscribed(t *testing.T) {
+	d := setupTripSeriesController(t)
+
+	series := &entities.TripSeries{ID: "series-1", RefID: 40, Status: entities.TripSeriesStatusActive}
+	d.userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", RefID: 10}}, nil)
+	d.seriesRepo.EXPECT().FindByID(mock.Anything, "series-1").Return(series, nil)
+	d.seriesRepo.EXPECT().IsSubscribed(mock.Anything, int64(40), int64(10)).Return(false, nil)
+
+	router := gin.New()
+	router.Use(withUserID("user-1"))
+	router.DELETE("/trip-series/:id/subscription", d.ctrl.Unsubscribe)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/trip-series/series-1/subscription", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// Without the error handler middleware the domain error leaves the default status
+	assert.Equal(t, http.StatusOK, w.Code)
+}
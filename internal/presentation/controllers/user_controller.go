@@ -5,7 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/lgxju/gogretago/internal/application/dtos"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
 	"github.com/lgxju/gogretago/internal/application/usecases/user"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/presentation/validators"
 )
 
@@ -15,6 +17,12 @@ type UserController struct {
 	getUseCase       *user.GetUserUseCase
 	updateUseCase    *user.UpdateUserUseCase
 	anonymizeUseCase *user.AnonymizeUserUseCase
+	unlockUseCase    *user.UnlockUserUseCase
+	passwordUseCase  *auth.ChangePasswordUseCase
+	roleUseCase      *user.UpdateUserRoleUseCase
+	suspendUseCase   *user.SuspendUserUseCase
+	banUseCase       *user.BanUserUseCase
+	reinstateUseCase *user.ReinstateUserUseCase
 }
 
 // NewUserController creates a new UserController
@@ -23,12 +31,24 @@ func NewUserController(
 	getUseCase *user.GetUserUseCase,
 	updateUseCase *user.UpdateUserUseCase,
 	anonymizeUseCase *user.AnonymizeUserUseCase,
+	unlockUseCase *user.UnlockUserUseCase,
+	passwordUseCase *auth.ChangePasswordUseCase,
+	roleUseCase *user.UpdateUserRoleUseCase,
+	suspendUseCase *user.SuspendUserUseCase,
+	banUseCase *user.BanUserUseCase,
+	reinstateUseCase *user.ReinstateUserUseCase,
 ) *UserController {
 	return &UserController{
 		listUseCase:      listUseCase,
 		getUseCase:       getUseCase,
 		updateUseCase:    updateUseCase,
 		anonymizeUseCase: anonymizeUseCase,
+		unlockUseCase:    unlockUseCase,
+		passwordUseCase:  passwordUseCase,
+		roleUseCase:      roleUseCase,
+		suspendUseCase:   suspendUseCase,
+		banUseCase:       banUseCase,
+		reinstateUseCase: reinstateUseCase,
 	}
 }
 
@@ -47,13 +67,13 @@ func (ctrl *UserController) ListUsers(c *gin.Context) {
 }
 
 // GetUser handles GET /users/:id
-// Users can only view their own profile unless they have ADMIN role.
+// Users can only view their own profile unless their role grants users:read.
 func (ctrl *UserController) GetUser(c *gin.Context) {
 	id := c.Param("id")
 	requestingUserID := c.GetString("userId")
-	role := c.GetString("role")
+	permissions := c.GetStringSlice("permissions")
 
-	if id != requestingUserID && role != "ADMIN" {
+	if id != requestingUserID && !authorization.HasPermissions(permissions, authorization.PermissionUsersRead) {
 		c.JSON(http.StatusForbidden, gin.H{
 			"success": false,
 			"error": gin.H{
@@ -120,9 +140,55 @@ func (ctrl *UserController) UpdateProfile(c *gin.Context) {
 	})
 }
 
+// ChangePassword handles POST /users/me/password
+func (ctrl *UserController) ChangePassword(c *gin.Context) {
+	userID := c.GetString("userId")
+
+	var input dtos.ChangePasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	// Execute use case
+	c.Set("auditTargetId", userID)
+	result, err := ctrl.passwordUseCase.Execute(c.Request.Context(), userID, sessionClient(c), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
 // AnonymizeMe handles DELETE /users/me
 func (ctrl *UserController) AnonymizeMe(c *gin.Context) {
 	userID := c.GetString("userId")
+	c.Set("auditTargetId", userID)
 
 	err := ctrl.anonymizeUseCase.Execute(c.Request.Context(), userID)
 	if err != nil {
@@ -145,3 +211,149 @@ func (ctrl *UserController) AnonymizeUser(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// UnlockUser handles POST /users/:id/unlock
+func (ctrl *UserController) UnlockUser(c *gin.Context) {
+	id := c.Param("id")
+
+	err := ctrl.unlockUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateUserRole handles PUT /users/:id/role
+func (ctrl *UserController) UpdateUserRole(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dtos.UpdateUserRoleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	err := ctrl.roleUseCase.Execute(c.Request.Context(), c.GetString("userId"), id, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SuspendUser handles POST /users/:id/suspend
+func (ctrl *UserController) SuspendUser(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dtos.SuspendUserInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	err := ctrl.suspendUseCase.Execute(c.Request.Context(), c.GetString("userId"), id, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// BanUser handles POST /users/:id/ban
+func (ctrl *UserController) BanUser(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dtos.BanUserInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	// Validate input
+	validate := validators.GetValidator()
+	if err := validate.Struct(input); err != nil {
+		details := validators.FormatValidationErrors(err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Validation failed",
+				"details": details,
+			},
+		})
+		return
+	}
+
+	err := ctrl.banUseCase.Execute(c.Request.Context(), c.GetString("userId"), id, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReinstateUser handles DELETE /users/:id/suspension
+func (ctrl *UserController) ReinstateUser(c *gin.Context) {
+	id := c.Param("id")
+
+	err := ctrl.reinstateUseCase.Execute(c.Request.Context(), c.GetString("userId"), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
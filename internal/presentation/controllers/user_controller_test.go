@@ -9,7 +9,9 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/application/usecases/auth"
 	"github.com/lgxju/gogretago/internal/application/usecases/user"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/mocks"
 	"github.com/stretchr/testify/assert"
@@ -17,20 +19,55 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupUserController(t *testing.T) (*UserController, *mocks.MockUserRepository) {
+func setupUserController(t *testing.T) (*UserController, *mocks.MockUserRepository, *mocks.MockAuthRepository) {
+	ctrl, userRepo, authRepo, _ := setupUserControllerWithPasswords(t)
+	return ctrl, userRepo, authRepo
+}
+
+func setupUserControllerWithPasswords(t *testing.T) (*UserController, *mocks.MockUserRepository, *mocks.MockAuthRepository, *mocks.MockPasswordService) {
 	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	passwordSvc := mocks.NewMockPasswordService(t)
+	ctrl := newUserController(t, userRepo, authRepo, passwordSvc, mocks.NewMockRoleRepository(t), mocks.NewMockTokenVersionRepository(t))
 
+	return ctrl, userRepo, authRepo, passwordSvc
+}
+
+func setupUserModerationController(t *testing.T) (*UserController, *mocks.MockUserRepository, *mocks.MockAuthRepository, *mocks.MockRoleRepository, *mocks.MockTokenVersionRepository) {
+	userRepo := mocks.NewMockUserRepository(t)
+	authRepo := mocks.NewMockAuthRepository(t)
+	roleRepo := mocks.NewMockRoleRepository(t)
+	versions := mocks.NewMockTokenVersionRepository(t)
+	ctrl := newUserController(t, userRepo, authRepo, mocks.NewMockPasswordService(t), roleRepo, versions)
+
+	return ctrl, userRepo, authRepo, roleRepo, versions
+}
+
+func newUserController(
+	t *testing.T,
+	userRepo *mocks.MockUserRepository,
+	authRepo *mocks.MockAuthRepository,
+	passwordSvc *mocks.MockPasswordService,
+	roleRepo *mocks.MockRoleRepository,
+	versions *mocks.MockTokenVersionRepository,
+) *UserController {
 	listUC := user.NewListUsersUseCase(userRepo)
 	getUC := user.NewGetUserUseCase(userRepo)
 	updateUC := user.NewUpdateUserUseCase(userRepo)
 	anonymizeUC := user.NewAnonymizeUserUseCase(userRepo)
-	ctrl := NewUserController(listUC, getUC, updateUC, anonymizeUC)
-
-	return ctrl, userRepo
+	unlockUC := user.NewUnlockUserUseCase(userRepo, authRepo)
+	passwordUC := auth.NewChangePasswordUseCase(authRepo, userRepo, mocks.NewMockRefreshTokenRepository(t), mocks.NewMockSessionRepository(t),
+		mocks.NewMockPasswordHistoryRepository(t), mocks.NewMockTokenVersionRepository(t), passwordSvc,
+		mocks.NewMockJwtService(t), mocks.NewMockTokenService(t), 5)
+	roleUC := user.NewUpdateUserRoleUseCase(userRepo, authRepo, roleRepo, versions)
+	suspendUC := user.NewSuspendUserUseCase(userRepo, authRepo, versions)
+	banUC := user.NewBanUserUseCase(userRepo, authRepo, versions)
+	reinstateUC := user.NewReinstateUserUseCase(userRepo, authRepo, versions)
+	return NewUserController(listUC, getUC, updateUC, anonymizeUC, unlockUC, passwordUC, roleUC, suspendUC, banUC, reinstateUC)
 }
 
 func TestUserController_ListUsers_Success(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	firstName := "John"
 	users := []entities.PublicUser{
@@ -53,7 +90,7 @@ func TestUserController_ListUsers_Success(t *testing.T) {
 }
 
 func TestUserController_ListUsers_Error(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	userRepo.EXPECT().FindAll(mock.Anything).Return(nil, fmt.Errorf("db error"))
 
@@ -68,7 +105,7 @@ func TestUserController_ListUsers_Error(t *testing.T) {
 }
 
 func TestUserController_GetUser_OwnProfile(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	firstName := "John"
 	userEntity := &entities.PublicUser{
@@ -81,6 +118,7 @@ func TestUserController_GetUser_OwnProfile(t *testing.T) {
 	router.Use(func(c *gin.Context) {
 		c.Set("userId", "user-1")
 		c.Set("role", "USER")
+		c.Set("permissions", authorization.DefaultRoles["USER"])
 		c.Next()
 	})
 	router.GET("/users/:id", ctrl.GetUser)
@@ -97,7 +135,7 @@ func TestUserController_GetUser_OwnProfile(t *testing.T) {
 }
 
 func TestUserController_GetUser_AdminCanViewOther(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	firstName := "Jane"
 	userEntity := &entities.PublicUser{
@@ -110,6 +148,7 @@ func TestUserController_GetUser_AdminCanViewOther(t *testing.T) {
 	router.Use(func(c *gin.Context) {
 		c.Set("userId", "user-1")
 		c.Set("role", "ADMIN")
+		c.Set("permissions", authorization.DefaultRoles["ADMIN"])
 		c.Next()
 	})
 	router.GET("/users/:id", ctrl.GetUser)
@@ -122,12 +161,13 @@ func TestUserController_GetUser_AdminCanViewOther(t *testing.T) {
 }
 
 func TestUserController_GetUser_NonAdminCannotViewOther(t *testing.T) {
-	ctrl, _ := setupUserController(t)
+	ctrl, _, _ := setupUserController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
 		c.Set("userId", "user-1")
 		c.Set("role", "USER")
+		c.Set("permissions", authorization.DefaultRoles["USER"])
 		c.Next()
 	})
 	router.GET("/users/:id", ctrl.GetUser)
@@ -146,12 +186,13 @@ func TestUserController_GetUser_NonAdminCannotViewOther(t *testing.T) {
 }
 
 func TestUserController_GetUser_DriverCannotViewOther(t *testing.T) {
-	ctrl, _ := setupUserController(t)
+	ctrl, _, _ := setupUserController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
 		c.Set("userId", "user-1")
 		c.Set("role", "DRIVER")
+		c.Set("permissions", authorization.DefaultRoles["DRIVER"])
 		c.Next()
 	})
 	router.GET("/users/:id", ctrl.GetUser)
@@ -164,7 +205,7 @@ func TestUserController_GetUser_DriverCannotViewOther(t *testing.T) {
 }
 
 func TestUserController_UpdateProfile_Success(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	firstName := "Jane"
 	lastName := "Doe"
@@ -202,7 +243,7 @@ func TestUserController_UpdateProfile_Success(t *testing.T) {
 }
 
 func TestUserController_UpdateProfile_InvalidJSON(t *testing.T) {
-	ctrl, _ := setupUserController(t)
+	ctrl, _, _ := setupUserController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -220,7 +261,7 @@ func TestUserController_UpdateProfile_InvalidJSON(t *testing.T) {
 }
 
 func TestUserController_UpdateProfile_ValidationError(t *testing.T) {
-	ctrl, _ := setupUserController(t)
+	ctrl, _, _ := setupUserController(t)
 
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
@@ -239,7 +280,7 @@ func TestUserController_UpdateProfile_ValidationError(t *testing.T) {
 }
 
 func TestUserController_AnonymizeMe_Success(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	existing := &entities.PublicUser{
 		User:  entities.User{ID: "user-1"},
@@ -263,7 +304,7 @@ func TestUserController_AnonymizeMe_Success(t *testing.T) {
 }
 
 func TestUserController_AnonymizeUser_Success(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	existing := &entities.PublicUser{
 		User:  entities.User{ID: "user-2"},
@@ -283,7 +324,7 @@ func TestUserController_AnonymizeUser_Success(t *testing.T) {
 }
 
 func TestUserController_AnonymizeUser_Error(t *testing.T) {
-	ctrl, userRepo := setupUserController(t)
+	ctrl, userRepo, _ := setupUserController(t)
 
 	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(nil, nil)
 
@@ -297,3 +338,202 @@ func TestUserController_AnonymizeUser_Error(t *testing.T) {
 	// c.Error() is called, status not set explicitly
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestUserController_UnlockUser_Success(t *testing.T) {
+	ctrl, userRepo, authRepo := setupUserController(t)
+
+	existing := &entities.PublicUser{
+		User:  entities.User{ID: "user-2", AuthRefID: 20},
+		Email: "test@example.com",
+	}
+	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(existing, nil)
+	authRepo.EXPECT().ResetFailedLogins(mock.Anything, int64(20)).Return(nil)
+
+	router := gin.New()
+	router.POST("/users/:id/unlock", ctrl.UnlockUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/user-2/unlock", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestUserController_UnlockUser_Error(t *testing.T) {
+	ctrl, userRepo, _ := setupUserController(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(nil, nil)
+
+	router := gin.New()
+	router.POST("/users/:id/unlock", ctrl.UnlockUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/user-2/unlock", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	// c.Error() is called, status not set explicitly
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// asAdmin authenticates every request as admin-1
+func asAdmin(c *gin.Context) {
+	c.Set("userId", "admin-1")
+	c.Next()
+}
+
+func TestUserController_UpdateUserRole_Success(t *testing.T) {
+	ctrl, userRepo, authRepo, roleRepo, versions := setupUserModerationController(t)
+
+	existing := &entities.PublicUser{User: entities.User{ID: "user-2", AuthRefID: 20}}
+	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(existing, nil)
+	roleRepo.EXPECT().FindByName(mock.Anything, "DRIVER").Return(&entities.Role{Name: "DRIVER"}, nil)
+	authRepo.EXPECT().UpdateRole(mock.Anything, int64(20), "DRIVER").Return(nil)
+	versions.EXPECT().Invalidate(mock.Anything, "user-2").Return(nil)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.PUT("/users/:id/role", ctrl.UpdateUserRole)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/users/user-2/role", bytes.NewBufferString(`{"role":"DRIVER"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestUserController_UpdateUserRole_ValidationError(t *testing.T) {
+	ctrl, _, _, _, _ := setupUserModerationController(t)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.PUT("/users/:id/role", ctrl.UpdateUserRole)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/users/user-2/role", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestUserController_SuspendUser_Success(t *testing.T) {
+	ctrl, userRepo, authRepo, _, versions := setupUserModerationController(t)
+
+	existing := &entities.PublicUser{User: entities.User{ID: "user-2", AuthRefID: 20}}
+	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(existing, nil)
+	authRepo.EXPECT().Suspend(mock.Anything, int64(20), mock.AnythingOfType("time.Time"), "spam").Return(nil)
+	versions.EXPECT().Invalidate(mock.Anything, "user-2").Return(nil)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.POST("/users/:id/suspend", ctrl.SuspendUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/user-2/suspend", bytes.NewBufferString(`{"days":7,"reason":"spam"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestUserController_SuspendUser_ValidationError(t *testing.T) {
+	ctrl, _, _, _, _ := setupUserModerationController(t)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.POST("/users/:id/suspend", ctrl.SuspendUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/user-2/suspend", bytes.NewBufferString(`{"days":0,"reason":"spam"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestUserController_BanUser_OwnAccount(t *testing.T) {
+	ctrl, _, _, _, _ := setupUserModerationController(t)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.POST("/users/:id/ban", ctrl.BanUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/admin-1/ban", bytes.NewBufferString(`{"reason":"oops"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestUserController_ReinstateUser_Success(t *testing.T) {
+	ctrl, userRepo, authRepo, _, versions := setupUserModerationController(t)
+
+	existing := &entities.PublicUser{User: entities.User{ID: "user-2", AuthRefID: 20}}
+	userRepo.EXPECT().FindByID(mock.Anything, "user-2").Return(existing, nil)
+	authRepo.EXPECT().Reinstate(mock.Anything, int64(20)).Return(nil)
+	versions.EXPECT().Invalidate(mock.Anything, "user-2").Return(nil)
+
+	router := gin.New()
+	router.Use(asAdmin)
+	router.DELETE("/users/:id/suspension", ctrl.ReinstateUser)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/users/user-2/suspension", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestUserController_ChangePassword_ValidationError(t *testing.T) {
+	ctrl, _, _, _ := setupUserControllerWithPasswords(t)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/users/me/password", ctrl.ChangePassword)
+
+	body := `{"currentPassword":"OldPassword1","newPassword":"weakpass","confirmPassword":"different"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/me/password", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	errObj := resp["error"].(map[string]interface{})
+	assert.Equal(t, "VALIDATION_ERROR", errObj["code"])
+	details := errObj["details"].(map[string]interface{})
+	assert.Contains(t, details, "NewPassword")
+	assert.Contains(t, details, "ConfirmPassword")
+}
+
+func TestUserController_ChangePassword_WrongCurrentPassword(t *testing.T) {
+	ctrl, userRepo, authRepo, passwordSvc := setupUserControllerWithPasswords(t)
+
+	userRepo.EXPECT().FindByID(mock.Anything, "user-1").Return(&entities.PublicUser{User: entities.User{ID: "user-1", AuthRefID: 10}}, nil)
+	authRepo.EXPECT().FindByRefID(mock.Anything, int64(10)).Return(&entities.Auth{RefID: 10, Password: "old-hash"}, nil)
+	passwordSvc.EXPECT().Verify("WrongPassword1", "old-hash").Return(false, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Next()
+	})
+	router.POST("/users/me/password", ctrl.ChangePassword)
+
+	body := `{"currentPassword":"WrongPassword1","newPassword":"NewPassword1","confirmPassword":"NewPassword1"}`
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/me/password", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// The controller calls c.Error() which doesn't set status by itself
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+	"github.com/lgxju/gogretago/internal/domain/services"
+)
+
+// ApiKeyHeader carries API keys. Keys are never accepted as bearer tokens.
+const ApiKeyHeader = "X-API-Key"
+
+// apiKeyLastUsedResolution bounds how often a busy key's last-used time is written
+const apiKeyLastUsedResolution = time.Minute
+
+// authenticateApiKey resolves an API key and sets the context of the principal it acts for.
+// It writes the error response and returns false when the key is not accepted.
+func authenticateApiKey(
+	c *gin.Context,
+	key string,
+	apiKeyRepository repositories.ApiKeyRepository,
+	tokenService services.TokenService,
+) bool {
+	if !strings.HasPrefix(key, entities.ApiKeyPrefix) {
+		abortInvalidApiKey(c)
+		return false
+	}
+
+	principal, err := apiKeyRepository.FindPrincipalByHash(c.Request.Context(), tokenService.Hash(key))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "An unexpected error occurred",
+			},
+		})
+		c.Abort()
+		return false
+	}
+	now := time.Now()
+	if principal == nil || principal.Key.IsRevoked() || principal.Key.IsExpired(now) {
+		abortInvalidApiKey(c)
+		return false
+	}
+	if !ensureAccountActive(c, principal.AccountStatus) {
+		return false
+	}
+
+	apiKey := principal.Key
+	if !isSafeMethod(c.Request.Method) && !apiKey.HasScope(entities.ApiKeyScopeWrite) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INSUFFICIENT_SCOPE",
+				"message": "This API key is read-only",
+			},
+		})
+		c.Abort()
+		return false
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedResolution {
+		// Best effort: a failed bookkeeping write must not fail the request
+		_ = apiKeyRepository.TouchLastUsed(c.Request.Context(), apiKey.ID, now)
+	}
+
+	userID := ""
+	if apiKey.UserID != nil {
+		userID = *apiKey.UserID
+	}
+	serviceAccountID := ""
+	if apiKey.ServiceAccountID != nil {
+		serviceAccountID = *apiKey.ServiceAccountID
+	}
+	c.Set("userId", userID)
+	c.Set("serviceAccountId", serviceAccountID)
+	c.Set("role", principal.Role)
+	c.Set("emailVerified", principal.EmailVerified)
+	c.Set("apiKeyId", apiKey.ID)
+	c.Set("apiKeyScopes", apiKey.Scopes)
+	return true
+}
+
+func abortInvalidApiKey(c *gin.Context) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INVALID_API_KEY",
+			"message": "Invalid, revoked or expired API key",
+		},
+	})
+	c.Abort()
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testApiKey = "cvk_AbCdEfGh_secret"
+
+func setupApiKeyAuthTest(t *testing.T, apiKeyRepo *mocks.MockApiKeyRepository, tokenSvc *mocks.MockTokenService) *gin.Engine {
+	t.Helper()
+	router := gin.New()
+	// No JWT expectations: API key requests must never reach token verification
+	router.Use(AuthMiddleware(mocks.NewMockJwtService(t), mocks.NewMockRevokedTokenRepository(t),
+		mocks.NewMockTokenVersionRepository(t), anyRole(t), apiKeyRepo, tokenSvc))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"userId":           c.GetString("userId"),
+			"serviceAccountId": c.GetString("serviceAccountId"),
+			"role":             c.GetString("role"),
+			"apiKeyId":         c.GetString("apiKeyId"),
+		})
+	}
+	router.GET("/test", handler)
+	router.POST("/test", handler)
+	return router
+}
+
+func userKeyPrincipal(scopes ...string) *entities.ApiKeyPrincipal {
+	userID := "user-1"
+	return &entities.ApiKeyPrincipal{
+		Key:  entities.ApiKey{ID: "key-1", Scopes: scopes, UserID: &userID},
+		Role: "ADMIN",
+	}
+}
+
+func serveWithApiKey(router *gin.Engine, method, key string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, "/test", http.NoBody)
+	req.Header.Set(ApiKeyHeader, key)
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func apiKeyErrorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body["error"].(map[string]interface{})["code"].(string)
+}
+
+func TestApiKeyAuth_UserKey(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(userKeyPrincipal("read", "write"), nil)
+	apiKeyRepo.EXPECT().TouchLastUsed(mock.Anything, "key-1", mock.Anything).Return(nil)
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodPost, testApiKey)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "user-1", body["userId"])
+	assert.Equal(t, "ADMIN", body["role"])
+	assert.Equal(t, "key-1", body["apiKeyId"])
+	assert.Empty(t, body["serviceAccountId"])
+}
+
+func TestApiKeyAuth_ServiceAccountKey(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	accountID := "sa-1"
+	recently := time.Now().Add(-10 * time.Second)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(&entities.ApiKeyPrincipal{
+		Key:  entities.ApiKey{ID: "key-2", Scopes: []string{"read"}, ServiceAccountID: &accountID, LastUsedAt: &recently},
+		Role: "USER",
+	}, nil)
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodGet, testApiKey)
+
+	// Used within the last minute, so last-used is not written again
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Empty(t, body["userId"])
+	assert.Equal(t, "sa-1", body["serviceAccountId"])
+	assert.Equal(t, "USER", body["role"])
+}
+
+func TestApiKeyAuth_ReadOnlyKeyCannotWrite(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(userKeyPrincipal("read"), nil)
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodPost, testApiKey)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "INSUFFICIENT_SCOPE", apiKeyErrorCode(t, w))
+}
+
+func TestApiKeyAuth_SuspendedOwner(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	principal := userKeyPrincipal("read")
+	until := time.Now().Add(time.Hour)
+	principal.SuspendedUntil = &until
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(principal, nil)
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodGet, testApiKey)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "ACCOUNT_SUSPENDED", apiKeyErrorCode(t, w))
+}
+
+func TestApiKeyAuth_RejectedKeys(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	revoked := userKeyPrincipal("read")
+	revoked.Key.RevokedAt = &past
+	expired := userKeyPrincipal("read")
+	expired.Key.ExpiresAt = &past
+
+	tests := []struct {
+		name      string
+		principal *entities.ApiKeyPrincipal
+	}{
+		{"unknown", nil},
+		{"revoked", revoked},
+		{"expired", expired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+			tokenSvc := mocks.NewMockTokenService(t)
+			tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+			apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(tt.principal, nil)
+
+			w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodGet, testApiKey)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, "INVALID_API_KEY", apiKeyErrorCode(t, w))
+		})
+	}
+}
+
+func TestApiKeyAuth_WrongFormat(t *testing.T) {
+	w := serveWithApiKey(setupApiKeyAuthTest(t, mocks.NewMockApiKeyRepository(t), mocks.NewMockTokenService(t)), http.MethodGet, "eyJhbGciOi.jwt.token")
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "INVALID_API_KEY", apiKeyErrorCode(t, w))
+}
+
+func TestApiKeyAuth_LookupError(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(nil, errors.New("db down"))
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodGet, testApiKey)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestApiKeyAuth_TouchFailureDoesNotFailRequest(t *testing.T) {
+	apiKeyRepo := mocks.NewMockApiKeyRepository(t)
+	tokenSvc := mocks.NewMockTokenService(t)
+	tokenSvc.EXPECT().Hash(testApiKey).Return("key-hash")
+	apiKeyRepo.EXPECT().FindPrincipalByHash(mock.Anything, "key-hash").Return(userKeyPrincipal("read"), nil)
+	apiKeyRepo.EXPECT().TouchLastUsed(mock.Anything, "key-1", mock.Anything).Return(errors.New("db down"))
+
+	w := serveWithApiKey(setupApiKeyAuthTest(t, apiKeyRepo, tokenSvc), http.MethodGet, testApiKey)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
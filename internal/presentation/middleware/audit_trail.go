@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
+)
+
+// AuditTrail appends an entry to the audit log for every request through the routes it records
+type AuditTrail struct {
+	auditLogRepository repositories.AuditLogRepository
+}
+
+// NewAuditTrail creates an AuditTrail writing to the given repository
+func NewAuditTrail(auditLogRepository repositories.AuditLogRepository) *AuditTrail {
+	return &AuditTrail{auditLogRepository: auditLogRepository}
+}
+
+// Record creates a middleware that logs the action once the handler has run, whether it
+// succeeded or not. The actor is the authenticated caller and the target the :id route
+// parameter. Handlers of anonymous routes name the account instead by setting "auditEmail",
+// and "auditTargetId" once it is known.
+func (a *AuditTrail) Record(action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		data := entities.CreateAuditLogData{
+			Action:    action,
+			Success:   len(c.Errors) == 0 && c.Writer.Status() < 400,
+			ActorID:   optionalString(c.GetString("userId")),
+			TargetID:  optionalString(c.GetString("auditTargetId")),
+			Email:     optionalString(c.GetString("auditEmail")),
+			IPAddress: c.ClientIP(),
+			RequestID: c.GetString("requestId"),
+		}
+		if data.ActorID == nil {
+			data.ActorID = optionalString(c.GetString("serviceAccountId"))
+		}
+		if data.TargetID == nil {
+			data.TargetID = optionalString(c.Param("id"))
+		}
+		if len(c.Errors) > 0 {
+			code := buildErrorResponse(c.Errors.Last().Err).Error.Code
+			data.ErrorCode = &code
+		}
+
+		// The response is already decided, so a failed write is reported rather than returned. The
+		// write must not be lost when the client has disconnected and cancelled the request context
+		if err := a.auditLogRepository.Create(context.WithoutCancel(c.Request.Context()), data); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "audit log: failed to record %s (request %s): %v\n", action, data.RequestID, err)
+		}
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// captureAudit returns a repository that stores the entry it is given in captured
+func captureAudit(t *testing.T, captured *entities.CreateAuditLogData) *mocks.MockAuditLogRepository {
+	t.Helper()
+	repo := mocks.NewMockAuditLogRepository(t)
+	repo.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, data entities.CreateAuditLogData) { *captured = data }).
+		Return(nil).Once()
+	return repo
+}
+
+func TestAuditTrail_RecordsAdminAction(t *testing.T) {
+	var entry entities.CreateAuditLogData
+	trail := NewAuditTrail(captureAudit(t, &entry))
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("requestId", "req-1")
+		c.Set("userId", "admin-1")
+		c.Next()
+	})
+	router.POST("/users/:id/ban", trail.Record(entities.AuditActionBan), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users/user-2/ban", http.NoBody)
+	req.RemoteAddr = "198.51.100.1:1234"
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, entities.AuditActionBan, entry.Action)
+	assert.True(t, entry.Success)
+	require.NotNil(t, entry.ActorID)
+	assert.Equal(t, "admin-1", *entry.ActorID)
+	require.NotNil(t, entry.TargetID)
+	assert.Equal(t, "user-2", *entry.TargetID)
+	assert.Equal(t, "198.51.100.1", entry.IPAddress)
+	assert.Equal(t, "req-1", entry.RequestID)
+	assert.Nil(t, entry.ErrorCode)
+}
+
+func TestAuditTrail_RecordsFailedLogin(t *testing.T) {
+	var entry entities.CreateAuditLogData
+	trail := NewAuditTrail(captureAudit(t, &entry))
+
+	router := gin.New()
+	router.POST("/auth/login", trail.Record(entities.AuditActionLogin), func(c *gin.Context) {
+		c.Set("auditEmail", "user@example.com")
+		_ = c.Error(domainerrors.NewInvalidCredentialsError())
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/auth/login", http.NoBody))
+
+	assert.False(t, entry.Success)
+	assert.Nil(t, entry.ActorID)
+	assert.Nil(t, entry.TargetID)
+	require.NotNil(t, entry.Email)
+	assert.Equal(t, "user@example.com", *entry.Email)
+	require.NotNil(t, entry.ErrorCode)
+	assert.Equal(t, "INVALID_CREDENTIALS", *entry.ErrorCode)
+}
+
+func TestAuditTrail_RecordsDeniedRequest(t *testing.T) {
+	var entry entities.CreateAuditLogData
+	trail := NewAuditTrail(captureAudit(t, &entry))
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("userId", "user-1")
+		c.Set("permissions", []string{})
+		c.Next()
+	})
+	router.DELETE("/users/:id", trail.Record(entities.AuditActionAnonymize), RequirePermission("users:anonymize"), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/users/user-2", http.NoBody))
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.False(t, entry.Success)
+	require.NotNil(t, entry.ActorID)
+	assert.Equal(t, "user-1", *entry.ActorID)
+}
+
+func TestAuditTrail_WriteFailureDoesNotFailRequest(t *testing.T) {
+	repo := mocks.NewMockAuditLogRepository(t)
+	repo.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db down"))
+	trail := NewAuditTrail(repo)
+
+	router := gin.New()
+	router.POST("/users/:id/unlock", trail.Record(entities.AuditActionUnlock), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/user-2/unlock", http.NoBody))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestAuditTrail_RecordsAfterClientDisconnect(t *testing.T) {
+	repo := mocks.NewMockAuditLogRepository(t)
+	repo.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, _ entities.CreateAuditLogData) { assert.NoError(t, ctx.Err()) }).
+		Return(nil).Once()
+	trail := NewAuditTrail(repo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	router := gin.New()
+	router.POST("/drivers", trail.Record(entities.AuditActionRoleChange), func(c *gin.Context) {
+		cancel()
+		c.Status(http.StatusCreated)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/drivers", http.NoBody).WithContext(ctx)
+	router.ServeHTTP(w, req)
+}
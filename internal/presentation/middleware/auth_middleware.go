@@ -3,14 +3,35 @@ package middleware
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	domainerrors "github.com/lgxju/gogretago/internal/domain/errors"
+	"github.com/lgxju/gogretago/internal/domain/repositories"
 	"github.com/lgxju/gogretago/internal/domain/services"
 )
 
-// AuthMiddleware validates JWT tokens and sets user context
-func AuthMiddleware(jwtService services.JwtService) gin.HandlerFunc {
+// AuthMiddleware validates JWT tokens, rejects revoked ones, ones bound to a signed-out session,
+// ones issued before the account's last role change and ones of suspended or banned accounts, and sets
+// user context along with the permissions of the caller's role. Requests may instead carry an API key in the X-API-Key header.
+func AuthMiddleware(
+	jwtService services.JwtService,
+	revokedTokenRepository repositories.RevokedTokenRepository,
+	tokenVersionRepository repositories.TokenVersionRepository,
+	roleRepository repositories.RoleRepository,
+	apiKeyRepository repositories.ApiKeyRepository,
+	tokenService services.TokenService,
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(ApiKeyHeader); apiKey != "" {
+			if authenticateApiKey(c, apiKey, apiKeyRepository, tokenService) && resolvePermissions(c, roleRepository) {
+				c.Next()
+			}
+			return
+		}
+
 		// Try Authorization header first, then x-auth-token
 		authHeader := c.GetHeader("Authorization")
 		token := ""
@@ -49,8 +70,152 @@ func AuthMiddleware(jwtService services.JwtService) gin.HandlerFunc {
 			return
 		}
 
+		// Tokens issued before jti was introduced cannot be revoked individually
+		if payload.TokenID != "" {
+			revoked, err := revokedTokenRepository.IsRevoked(c.Request.Context(), payload.TokenID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "INTERNAL_ERROR",
+						"message": "An unexpected error occurred",
+					},
+				})
+				c.Abort()
+				return
+			}
+			if revoked {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "TOKEN_REVOKED",
+						"message": "Token has been revoked",
+					},
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		// Signing a session out puts its ID on the revocation list until its last access token expires
+		if payload.SessionID != "" {
+			revoked, err := revokedTokenRepository.IsRevoked(c.Request.Context(), payload.SessionID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "INTERNAL_ERROR",
+						"message": "An unexpected error occurred",
+					},
+				})
+				c.Abort()
+				return
+			}
+			if revoked {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "SESSION_REVOKED",
+						"message": "Session has been signed out",
+					},
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		// A role change bumps the account's token version, so its claims are stale
+		state, err := tokenVersionRepository.CurrentState(c.Request.Context(), payload.UserID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "An unexpected error occurred",
+				},
+			})
+			c.Abort()
+			return
+		}
+		if state == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INVALID_TOKEN",
+					"message": "Invalid or expired token",
+				},
+			})
+			c.Abort()
+			return
+		}
+		if !ensureAccountActive(c, state.AccountStatus) {
+			return
+		}
+		if payload.TokenVersion < state.Version {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "TOKEN_OUTDATED",
+					"message": "Account permissions changed, refresh the token",
+				},
+			})
+			c.Abort()
+			return
+		}
+
 		c.Set("userId", payload.UserID)
 		c.Set("role", payload.Role)
+		c.Set("emailVerified", payload.EmailVerified)
+		c.Set("tokenId", payload.TokenID)
+		c.Set("sessionId", payload.SessionID)
+		c.Set("tokenExpiresAt", payload.ExpiresAt)
+		if !resolvePermissions(c, roleRepository) {
+			return
+		}
 		c.Next()
 	}
 }
+
+// ensureAccountActive refuses suspended and banned accounts. It writes the error response and
+// returns false when the account may not be used.
+func ensureAccountActive(c *gin.Context, status entities.AccountStatus) bool {
+	err := authorization.EnsureAccountActive(status, time.Now())
+	if err == nil {
+		return true
+	}
+	domainErr, _ := domainerrors.AsDomainError(err)
+	c.JSON(domainerrors.GetHTTPStatus(domainErr.Code), gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    domainErr.Code,
+			"message": domainErr.Message,
+		},
+	})
+	c.Abort()
+	return false
+}
+
+// resolvePermissions looks up the permissions of the authenticated role and sets them in the
+// context. A role missing from the database grants nothing. It writes the error response and
+// returns false when the lookup fails.
+func resolvePermissions(c *gin.Context, roleRepository repositories.RoleRepository) bool {
+	role, err := roleRepository.FindByName(c.Request.Context(), c.GetString("role"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "An unexpected error occurred",
+			},
+		})
+		c.Abort()
+		return false
+	}
+
+	permissions := []string{}
+	if role != nil {
+		permissions = role.Permissions
+	}
+	c.Set("permissions", permissions)
+	return true
+}
@@ -6,11 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/domain/services"
 	"github.com/lgxju/gogretago/internal/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,18 +21,38 @@ func init() {
 	gin.SetMode(gin.TestMode)
 }
 
-func setupAuthTest(t *testing.T, mockJwt *mocks.MockJwtService) (*gin.Engine, *httptest.ResponseRecorder) {
+func setupAuthTest(t *testing.T, mockJwt *mocks.MockJwtService, mockRevoked *mocks.MockRevokedTokenRepository, mockVersions *mocks.MockTokenVersionRepository) (*gin.Engine, *httptest.ResponseRecorder) {
 	t.Helper()
 	router := gin.New()
-	router.Use(AuthMiddleware(mockJwt))
+	router.Use(AuthMiddleware(mockJwt, mockRevoked, mockVersions, anyRole(t), mocks.NewMockApiKeyRepository(t), mocks.NewMockTokenService(t)))
 	router.GET("/test", func(c *gin.Context) {
 		userId, _ := c.Get("userId")
 		role, _ := c.Get("role")
-		c.JSON(http.StatusOK, gin.H{"userId": userId, "role": role})
+		tokenId, _ := c.Get("tokenId")
+		sessionId, _ := c.Get("sessionId")
+		permissions, _ := c.Get("permissions")
+		c.JSON(http.StatusOK, gin.H{"userId": userId, "role": role, "tokenId": tokenId, "sessionId": sessionId, "permissions": permissions})
 	})
 	return router, httptest.NewRecorder()
 }
 
+// anyRole returns a role store that grants profile:read to whatever role is looked up
+func anyRole(t *testing.T) *mocks.MockRoleRepository {
+	t.Helper()
+	roles := mocks.NewMockRoleRepository(t)
+	roles.EXPECT().FindByName(mock.Anything, mock.Anything).
+		Return(&entities.Role{Permissions: []string{"profile:read"}}, nil).Maybe()
+	return roles
+}
+
+// currentVersion returns a token version store holding the given version for userID
+func currentVersion(t *testing.T, userID string, version int64) *mocks.MockTokenVersionRepository {
+	t.Helper()
+	versions := mocks.NewMockTokenVersionRepository(t)
+	versions.EXPECT().CurrentState(mock.Anything, userID).Return(&entities.TokenState{Version: version}, nil)
+	return versions
+}
+
 func TestAuthMiddleware_ValidBearerToken(t *testing.T) {
 	mockJwt := mocks.NewMockJwtService(t)
 	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
@@ -37,7 +60,7 @@ func TestAuthMiddleware_ValidBearerToken(t *testing.T) {
 		Role:   "ADMIN",
 	}, nil)
 
-	router, w := setupAuthTest(t, mockJwt)
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), currentVersion(t, "user-123", 0))
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	req.Header.Set("Authorization", "Bearer valid-token")
 	router.ServeHTTP(w, req)
@@ -58,7 +81,7 @@ func TestAuthMiddleware_ValidXAuthToken(t *testing.T) {
 		Role:   "DRIVER",
 	}, nil)
 
-	router, w := setupAuthTest(t, mockJwt)
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), currentVersion(t, "user-456", 0))
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	req.Header.Set("x-auth-token", "x-token-value")
 	router.ServeHTTP(w, req)
@@ -76,7 +99,7 @@ func TestAuthMiddleware_MissingToken(t *testing.T) {
 	mockJwt := mocks.NewMockJwtService(t)
 	// No Verify call expected since no token provided
 
-	router, w := setupAuthTest(t, mockJwt)
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mocks.NewMockTokenVersionRepository(t))
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
@@ -95,7 +118,7 @@ func TestAuthMiddleware_InvalidToken(t *testing.T) {
 	mockJwt := mocks.NewMockJwtService(t)
 	mockJwt.EXPECT().Verify("bad-token").Return(nil, fmt.Errorf("token is invalid"))
 
-	router, w := setupAuthTest(t, mockJwt)
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mocks.NewMockTokenVersionRepository(t))
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	req.Header.Set("Authorization", "Bearer bad-token")
 	router.ServeHTTP(w, req)
@@ -115,7 +138,7 @@ func TestAuthMiddleware_NilPayload(t *testing.T) {
 	mockJwt := mocks.NewMockJwtService(t)
 	mockJwt.EXPECT().Verify("nil-payload-token").Return(nil, nil)
 
-	router, w := setupAuthTest(t, mockJwt)
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mocks.NewMockTokenVersionRepository(t))
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	req.Header.Set("Authorization", "Bearer nil-payload-token")
 	router.ServeHTTP(w, req)
@@ -129,3 +152,312 @@ func TestAuthMiddleware_NilPayload(t *testing.T) {
 	errObj := body["error"].(map[string]interface{})
 	assert.Equal(t, "INVALID_TOKEN", errObj["code"])
 }
+
+func TestAuthMiddleware_TokenNotRevoked(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockRevoked := mocks.NewMockRevokedTokenRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
+		UserID:    "user-123",
+		Role:      "USER",
+		TokenID:   "jti-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "jti-1").Return(false, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mockRevoked, currentVersion(t, "user-123", 0))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	assert.Equal(t, "jti-1", body["tokenId"])
+}
+
+func TestAuthMiddleware_RevokedToken(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockRevoked := mocks.NewMockRevokedTokenRepository(t)
+	mockJwt.EXPECT().Verify("revoked-token").Return(&services.JwtPayload{
+		UserID:  "user-123",
+		Role:    "USER",
+		TokenID: "jti-revoked",
+	}, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "jti-revoked").Return(true, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mockRevoked, mocks.NewMockTokenVersionRepository(t))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer revoked-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "TOKEN_REVOKED", errObj["code"])
+}
+
+func TestAuthMiddleware_ActiveSession(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockRevoked := mocks.NewMockRevokedTokenRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
+		UserID:    "user-123",
+		Role:      "USER",
+		TokenID:   "jti-1",
+		SessionID: "session-1",
+	}, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "jti-1").Return(false, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "session-1").Return(false, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mockRevoked, currentVersion(t, "user-123", 0))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", body["sessionId"])
+}
+
+func TestAuthMiddleware_RevokedSession(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockRevoked := mocks.NewMockRevokedTokenRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
+		UserID:    "user-123",
+		Role:      "USER",
+		TokenID:   "jti-1",
+		SessionID: "session-1",
+	}, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "jti-1").Return(false, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "session-1").Return(true, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mockRevoked, mocks.NewMockTokenVersionRepository(t))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "SESSION_REVOKED", errObj["code"])
+}
+
+func TestAuthMiddleware_RevocationStoreError(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockRevoked := mocks.NewMockRevokedTokenRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
+		UserID:  "user-123",
+		Role:    "USER",
+		TokenID: "jti-1",
+	}, nil)
+	mockRevoked.EXPECT().IsRevoked(mock.Anything, "jti-1").Return(false, fmt.Errorf("redis down"))
+
+	router, w := setupAuthTest(t, mockJwt, mockRevoked, mocks.NewMockTokenVersionRepository(t))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAuthMiddleware_CurrentTokenVersion(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{
+		UserID:       "user-123",
+		Role:         "DRIVER",
+		TokenVersion: 2,
+	}, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), currentVersion(t, "user-123", 2))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthMiddleware_OutdatedTokenVersion(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockJwt.EXPECT().Verify("stale-token").Return(&services.JwtPayload{
+		UserID:       "user-123",
+		Role:         "USER",
+		TokenVersion: 0,
+	}, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), currentVersion(t, "user-123", 1))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer stale-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "TOKEN_OUTDATED", errObj["code"])
+}
+
+func TestAuthMiddleware_UnknownUser(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockVersions := mocks.NewMockTokenVersionRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "deleted-user", Role: "USER"}, nil)
+	mockVersions.EXPECT().CurrentState(mock.Anything, "deleted-user").Return(nil, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mockVersions)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "INVALID_TOKEN", errObj["code"])
+}
+
+func TestAuthMiddleware_TokenVersionStoreError(t *testing.T) {
+	mockJwt := mocks.NewMockJwtService(t)
+	mockVersions := mocks.NewMockTokenVersionRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "user-123", Role: "USER"}, nil)
+	mockVersions.EXPECT().CurrentState(mock.Anything, "user-123").Return(nil, fmt.Errorf("redis down"))
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mockVersions)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAuthMiddleware_SuspendedAccount(t *testing.T) {
+	until := time.Now().Add(time.Hour)
+	mockJwt := mocks.NewMockJwtService(t)
+	mockVersions := mocks.NewMockTokenVersionRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "user-123", Role: "USER"}, nil)
+	mockVersions.EXPECT().CurrentState(mock.Anything, "user-123").
+		Return(&entities.TokenState{AccountStatus: entities.AccountStatus{SuspendedUntil: &until}}, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mockVersions)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "ACCOUNT_SUSPENDED", errObj["code"])
+}
+
+func TestAuthMiddleware_ElapsedSuspension(t *testing.T) {
+	until := time.Now().Add(-time.Hour)
+	mockJwt := mocks.NewMockJwtService(t)
+	mockVersions := mocks.NewMockTokenVersionRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "user-123", Role: "USER"}, nil)
+	mockVersions.EXPECT().CurrentState(mock.Anything, "user-123").
+		Return(&entities.TokenState{AccountStatus: entities.AccountStatus{SuspendedUntil: &until}}, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mockVersions)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthMiddleware_BannedAccount(t *testing.T) {
+	bannedAt := time.Now().Add(-time.Hour)
+	mockJwt := mocks.NewMockJwtService(t)
+	mockVersions := mocks.NewMockTokenVersionRepository(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "user-123", Role: "USER"}, nil)
+	mockVersions.EXPECT().CurrentState(mock.Anything, "user-123").
+		Return(&entities.TokenState{AccountStatus: entities.AccountStatus{BannedAt: &bannedAt}}, nil)
+
+	router, w := setupAuthTest(t, mockJwt, mocks.NewMockRevokedTokenRepository(t), mockVersions)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "ACCOUNT_BANNED", errObj["code"])
+}
+
+// serveWithRoles runs a valid USER token for user-123 through the middleware with the given role store
+func serveWithRoles(t *testing.T, roles *mocks.MockRoleRepository) *httptest.ResponseRecorder {
+	t.Helper()
+	mockJwt := mocks.NewMockJwtService(t)
+	mockJwt.EXPECT().Verify("valid-token").Return(&services.JwtPayload{UserID: "user-123", Role: "USER"}, nil)
+
+	router := gin.New()
+	router.Use(AuthMiddleware(mockJwt, mocks.NewMockRevokedTokenRepository(t), currentVersion(t, "user-123", 0), roles,
+		mocks.NewMockApiKeyRepository(t), mocks.NewMockTokenService(t)))
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"permissions": c.GetStringSlice("permissions")})
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthMiddleware_ResolvesRolePermissions(t *testing.T) {
+	roles := mocks.NewMockRoleRepository(t)
+	roles.EXPECT().FindByName(mock.Anything, "USER").
+		Return(&entities.Role{Name: "USER", Permissions: []string{"profile:read", "trips:read"}}, nil)
+
+	w := serveWithRoles(t, roles)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string][]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, []string{"profile:read", "trips:read"}, body["permissions"])
+}
+
+func TestAuthMiddleware_UnknownRoleGrantsNothing(t *testing.T) {
+	roles := mocks.NewMockRoleRepository(t)
+	roles.EXPECT().FindByName(mock.Anything, "USER").Return(nil, nil)
+
+	w := serveWithRoles(t, roles)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string][]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Empty(t, body["permissions"])
+}
+
+func TestAuthMiddleware_RoleStoreError(t *testing.T) {
+	roles := mocks.NewMockRoleRepository(t)
+	roles.EXPECT().FindByName(mock.Anything, "USER").Return(nil, fmt.Errorf("db down"))
+
+	w := serveWithRoles(t, roles)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
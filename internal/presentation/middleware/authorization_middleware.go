@@ -7,11 +7,11 @@ import (
 	"github.com/lgxju/gogretago/internal/domain/authorization"
 )
 
-// RequireRole creates a middleware that checks the user's role against the required minimum role.
-// Uses hierarchical role system: USER(1) < DRIVER(2) < ADMIN(3).
-func RequireRole(roles ...string) gin.HandlerFunc {
+// RequirePermission creates a middleware that only lets through callers whose role grants
+// every given permission. Must run after AuthMiddleware, which resolves them.
+func RequirePermission(permissions ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userRole, exists := c.Get("role")
+		value, exists := c.Get("permissions")
 		if !exists {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
@@ -24,7 +24,7 @@ func RequireRole(roles ...string) gin.HandlerFunc {
 			return
 		}
 
-		roleStr, ok := userRole.(string)
+		granted, ok := value.([]string)
 		if !ok {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
@@ -37,23 +37,52 @@ func RequireRole(roles ...string) gin.HandlerFunc {
 			return
 		}
 
-		userLevel := authorization.GetRoleLevel(roleStr)
+		if !authorization.HasPermissions(granted, permissions...) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "FORBIDDEN",
+					"message": "Insufficient permissions",
+				},
+			})
+			c.Abort()
+			return
+		}
 
-		// Find minimum required level among the specified roles
-		minRequired := 999
-		for _, r := range roles {
-			level := authorization.GetRoleLevel(r)
-			if level < minRequired {
-				minRequired = level
-			}
+		c.Next()
+	}
+}
+
+// RequireVerifiedEmail creates a middleware that only lets through users who have
+// confirmed their email address. Must run after AuthMiddleware.
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("emailVerified") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "EMAIL_NOT_VERIFIED",
+					"message": "Please verify your email address first",
+				},
+			})
+			c.Abort()
+			return
 		}
 
-		if userLevel < minRequired {
+		c.Next()
+	}
+}
+
+// DenyApiKeys creates a middleware that refuses requests authenticated with an API key,
+// so a leaked key cannot mint new ones or take over its account. Must run after AuthMiddleware.
+func DenyApiKeys() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("apiKeyId") != "" {
 			c.JSON(http.StatusForbidden, gin.H{
 				"success": false,
 				"error": gin.H{
-					"code":    "FORBIDDEN",
-					"message": "Insufficient permissions",
+					"code":    "API_KEY_NOT_ALLOWED",
+					"message": "This action requires signing in",
 				},
 			})
 			c.Abort()
@@ -11,18 +11,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupAuthzRouter(role interface{}, setRole bool, requiredRoles ...string) (*gin.Engine, *httptest.ResponseRecorder) {
+func setupAuthzRouter(permissions interface{}, setPermissions bool, required ...string) (*gin.Engine, *httptest.ResponseRecorder) {
 	router := gin.New()
 
 	// Middleware to simulate auth context
 	router.Use(func(c *gin.Context) {
-		if setRole {
-			c.Set("role", role)
+		if setPermissions {
+			c.Set("permissions", permissions)
 		}
 		c.Next()
 	})
 
-	router.Use(RequireRole(requiredRoles...))
+	router.Use(RequirePermission(required...))
 
 	router.GET("/test", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"success": true})
@@ -31,24 +31,24 @@ func setupAuthzRouter(role interface{}, setRole bool, requiredRoles ...string) (
 	return router, httptest.NewRecorder()
 }
 
-func TestRequireRole_AdminAccessesAdminRoute(t *testing.T) {
-	router, w := setupAuthzRouter("ADMIN", true, "ADMIN")
+func TestRequirePermission_Granted(t *testing.T) {
+	router, w := setupAuthzRouter([]string{"users:read", "users:unlock"}, true, "users:read")
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
-func TestRequireRole_AdminAccessesDriverRoute(t *testing.T) {
-	router, w := setupAuthzRouter("ADMIN", true, "DRIVER")
+func TestRequirePermission_AllRequired(t *testing.T) {
+	router, w := setupAuthzRouter([]string{"users:read"}, true, "users:read", "users:anonymize")
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
-	assert.Equal(t, http.StatusOK, w.Code, "ADMIN (level 3) should access DRIVER (level 2) route")
+	assert.Equal(t, http.StatusForbidden, w.Code, "every listed permission must be granted")
 }
 
-func TestRequireRole_UserBlockedFromDriverRoute(t *testing.T) {
-	router, w := setupAuthzRouter("USER", true, "DRIVER")
+func TestRequirePermission_Missing(t *testing.T) {
+	router, w := setupAuthzRouter([]string{"profile:read"}, true, "catalog:write")
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
@@ -63,8 +63,8 @@ func TestRequireRole_UserBlockedFromDriverRoute(t *testing.T) {
 	assert.Equal(t, "FORBIDDEN", errObj["code"])
 }
 
-func TestRequireRole_NoRoleInContext(t *testing.T) {
-	router, w := setupAuthzRouter(nil, false, "USER")
+func TestRequirePermission_NoPermissionsInContext(t *testing.T) {
+	router, w := setupAuthzRouter(nil, false, "profile:read")
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
@@ -78,9 +78,9 @@ func TestRequireRole_NoRoleInContext(t *testing.T) {
 	assert.Equal(t, "UNAUTHORIZED", errObj["code"])
 }
 
-func TestRequireRole_InvalidRoleType(t *testing.T) {
-	// Set role as int instead of string
-	router, w := setupAuthzRouter(42, true, "USER")
+func TestRequirePermission_InvalidPermissionsType(t *testing.T) {
+	// Set permissions as a string instead of a slice
+	router, w := setupAuthzRouter("profile:read", true, "profile:read")
 	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
 	router.ServeHTTP(w, req)
 
@@ -93,3 +93,89 @@ func TestRequireRole_InvalidRoleType(t *testing.T) {
 	errObj := body["error"].(map[string]interface{})
 	assert.Equal(t, "UNAUTHORIZED", errObj["code"])
 }
+
+func setupVerifiedEmailRouter(verified interface{}, setVerified bool) (*gin.Engine, *httptest.ResponseRecorder) {
+	router := gin.New()
+
+	// Middleware to simulate auth context
+	router.Use(func(c *gin.Context) {
+		if setVerified {
+			c.Set("emailVerified", verified)
+		}
+		c.Next()
+	})
+
+	router.Use(RequireVerifiedEmail())
+
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+
+	return router, httptest.NewRecorder()
+}
+
+func TestRequireVerifiedEmail_Verified(t *testing.T) {
+	router, w := setupVerifiedEmailRouter(true, true)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRequireVerifiedEmail_NotVerified(t *testing.T) {
+	router, w := setupVerifiedEmailRouter(false, true)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var body map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "EMAIL_NOT_VERIFIED", errObj["code"])
+}
+
+func TestRequireVerifiedEmail_MissingContext(t *testing.T) {
+	router, w := setupVerifiedEmailRouter(nil, false)
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func setupDenyApiKeysRouter(apiKeyID string) (*gin.Engine, *httptest.ResponseRecorder) {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if apiKeyID != "" {
+			c.Set("apiKeyId", apiKeyID)
+		}
+		c.Next()
+	})
+	router.Use(DenyApiKeys())
+	router.POST("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	return router, httptest.NewRecorder()
+}
+
+func TestDenyApiKeys_TokenSession(t *testing.T) {
+	router, w := setupDenyApiKeysRouter("")
+	req := httptest.NewRequest(http.MethodPost, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestDenyApiKeys_ApiKey(t *testing.T) {
+	router, w := setupDenyApiKeysRouter("key-1")
+	req := httptest.NewRequest(http.MethodPost, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	errObj := body["error"].(map[string]interface{})
+	assert.Equal(t, "API_KEY_NOT_ALLOWED", errObj["code"])
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	apperrors "github.com/lgxju/gogretago/internal/application/errors"
@@ -27,6 +28,12 @@ func ErrorHandler() gin.HandlerFunc {
 			err := c.Errors.Last().Err
 			response := buildErrorResponse(err)
 			status := getStatusCode(err)
+
+			var lockedErr *domainerrors.AccountLockedError
+			if errors.As(err, &lockedErr) {
+				c.Header("Retry-After", strconv.Itoa(lockedErr.RetryAfterSeconds()))
+			}
+
 			c.JSON(status, response)
 		}
 	}
@@ -43,8 +50,7 @@ func buildErrorResponse(err error) ErrorResponse {
 		return response
 	}
 
-	var domainErr *domainerrors.DomainError
-	if errors.As(err, &domainErr) {
+	if domainErr, ok := domainerrors.AsDomainError(err); ok {
 		response.Error.Code = domainErr.Code
 		response.Error.Message = domainErr.Message
 		return response
@@ -63,8 +69,7 @@ func buildErrorResponse(err error) ErrorResponse {
 }
 
 func getStatusCode(err error) int {
-	var domainErr *domainerrors.DomainError
-	if errors.As(err, &domainErr) {
+	if domainErr, ok := domainerrors.AsDomainError(err); ok {
 		return domainerrors.GetHTTPStatus(domainErr.Code)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	apperrors "github.com/lgxju/gogretago/internal/application/errors"
@@ -29,9 +30,7 @@ func setupErrorHandlerRouter(errToAdd error) (*gin.Engine, *httptest.ResponseRec
 
 func TestErrorHandler_DomainError(t *testing.T) {
 	// Use a plain *DomainError to test the domain error handling path.
-	// Note: typed domain errors (e.g. *UserNotFoundError) embed DomainError
-	// by value, so errors.As(err, &domainErr) does not match them.
-	// The error handler's DomainError branch works with direct *DomainError values.
+	// Typed domain errors are covered by TestErrorHandler_TypedDomainError.
 	domainErr := &domainerrors.DomainError{
 		Message: "User not found: user-999",
 		Code:    "USER_NOT_FOUND",
@@ -50,6 +49,34 @@ func TestErrorHandler_DomainError(t *testing.T) {
 	assert.Contains(t, body.Error.Message, "user-999")
 }
 
+func TestErrorHandler_TypedDomainError(t *testing.T) {
+	router, w := setupErrorHandlerRouter(domainerrors.NewUserNotFoundError("user-999"))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	var body ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	assert.Equal(t, "USER_NOT_FOUND", body.Error.Code)
+	assert.Contains(t, body.Error.Message, "user-999")
+}
+
+func TestErrorHandler_AccountLockedSetsRetryAfter(t *testing.T) {
+	router, w := setupErrorHandlerRouter(domainerrors.NewAccountLockedError(90 * time.Second))
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "90", w.Header().Get("Retry-After"))
+
+	var body ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	assert.Equal(t, "ACCOUNT_LOCKED", body.Error.Code)
+}
+
 func TestErrorHandler_ValidationError(t *testing.T) {
 	details := map[string][]string{
 		"Email":    {"Invalid email format"},
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/presentation/controllers"
+	"github.com/lgxju/gogretago/internal/presentation/middleware"
+)
+
+// RegisterApiKeyRoutes registers personal API key and service account routes.
+// Keys cannot be managed with an API key, only with a user's access token.
+func RegisterApiKeyRoutes(router *gin.RouterGroup, apiKeyController *controllers.ApiKeyController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
+	myKeys := router.Group("/users/me/api-keys")
+	myKeys.Use(auth, middleware.DenyApiKeys())
+	myKeys.GET("", middleware.RequirePermission(authorization.PermissionProfileRead), apiKeyController.ListMyApiKeys)
+	myKeys.POST("", middleware.RequirePermission(authorization.PermissionProfileWrite), apiKeyController.CreateMyApiKey)
+	myKeys.DELETE("/:keyId", middleware.RequirePermission(authorization.PermissionProfileWrite), apiKeyController.RevokeMyApiKey)
+
+	serviceAccounts := router.Group("/service-accounts")
+	serviceAccounts.Use(auth, middleware.DenyApiKeys(), middleware.RequirePermission(authorization.PermissionServiceAccountsManage))
+	serviceAccounts.GET("", apiKeyController.ListServiceAccounts)
+	serviceAccounts.POST("", audit.Record(entities.AuditActionServiceAccountCreate), apiKeyController.CreateServiceAccount)
+	serviceAccounts.GET("/:id/api-keys", apiKeyController.ListServiceAccountApiKeys)
+	serviceAccounts.POST("/:id/api-keys", audit.Record(entities.AuditActionApiKeyCreate), apiKeyController.CreateServiceAccountApiKey)
+	serviceAccounts.DELETE("/:id/api-keys/:keyId", audit.Record(entities.AuditActionApiKeyRevoke), apiKeyController.RevokeServiceAccountApiKey)
+}
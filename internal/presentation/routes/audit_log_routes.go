@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/presentation/controllers"
+	"github.com/lgxju/gogretago/internal/presentation/middleware"
+)
+
+// RegisterAuditLogRoutes registers the audit log query route
+func RegisterAuditLogRoutes(router *gin.RouterGroup, auditLogController *controllers.AuditLogController, auth gin.HandlerFunc) {
+	auditLogs := router.Group("/audit-logs")
+	auditLogs.Use(auth)
+	auditLogs.GET("", middleware.RequirePermission(authorization.PermissionAuditRead), auditLogController.ListAuditLogs)
+}
@@ -2,13 +2,25 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterAuthRoutes registers all auth routes with rate limiting
-func RegisterAuthRoutes(router *gin.RouterGroup, authController *controllers.AuthController) {
+func RegisterAuthRoutes(router *gin.RouterGroup, authController *controllers.AuthController, authMiddleware gin.HandlerFunc, audit *middleware.AuditTrail) {
 	auth := router.Group("/auth")
-	auth.POST("/register", middleware.RateLimiter(3), authController.Register) // 3 req/min
-	auth.POST("/login", middleware.RateLimiter(5), authController.Login)       // 5 req/min
+	auth.POST("/register", middleware.RateLimiter(3), audit.Record(entities.AuditActionRegister), authController.Register)             // 3 req/min
+	auth.POST("/login", middleware.RateLimiter(5), audit.Record(entities.AuditActionLogin), authController.Login)                      // 5 req/min
+	auth.POST("/login/2fa", middleware.RateLimiter(5), audit.Record(entities.AuditActionLogin), authController.LoginTwoFactor)         // 5 req/min
+	auth.POST("/magic-link", middleware.RateLimiter(3), authController.RequestMagicLink)                                               // 3 req/min
+	auth.POST("/magic-link/verify", middleware.RateLimiter(5), audit.Record(entities.AuditActionLogin), authController.MagicLinkLogin) // 5 req/min
+	auth.POST("/refresh", middleware.RateLimiter(10), authController.Refresh)                                                          // 10 req/min
+	auth.POST("/logout", authMiddleware, authController.Logout)
+	auth.POST("/password/forgot", middleware.RateLimiter(3), authController.ForgotPassword)                                                // 3 req/min
+	auth.POST("/password/reset", middleware.RateLimiter(5), audit.Record(entities.AuditActionPasswordReset), authController.ResetPassword) // 5 req/min
+	auth.POST("/verify-email", middleware.RateLimiter(10), authController.VerifyEmail)                                                     // 10 req/min
+	auth.POST("/verify-email/resend", authMiddleware, middleware.RateLimiter(3), authController.ResendVerification)                        // 3 req/min
+	auth.POST("/2fa/totp/enroll", authMiddleware, middleware.DenyApiKeys(), authController.EnrollTotp)
+	auth.POST("/2fa/totp/confirm", authMiddleware, middleware.DenyApiKeys(), middleware.RateLimiter(5), authController.ConfirmTotp) // 5 req/min
 }
@@ -2,15 +2,17 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterBrandRoutes registers all brand routes
-func RegisterBrandRoutes(router *gin.RouterGroup, brandController *controllers.BrandController, auth gin.HandlerFunc) {
+func RegisterBrandRoutes(router *gin.RouterGroup, brandController *controllers.BrandController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	brands := router.Group("/brands")
 	brands.Use(auth)
-	brands.GET("", middleware.RequireRole("DRIVER"), brandController.ListBrands)
-	brands.POST("", middleware.RequireRole("ADMIN"), brandController.CreateBrand)
-	brands.DELETE("/:id", middleware.RequireRole("ADMIN"), brandController.DeleteBrand)
+	brands.GET("", middleware.RequirePermission(authorization.PermissionCatalogRead), brandController.ListBrands)
+	brands.POST("", audit.Record(entities.AuditActionCatalogCreate), middleware.RequirePermission(authorization.PermissionCatalogWrite), brandController.CreateBrand)
+	brands.DELETE("/:id", audit.Record(entities.AuditActionCatalogDelete), middleware.RequirePermission(authorization.PermissionCatalogWrite), brandController.DeleteBrand)
 }
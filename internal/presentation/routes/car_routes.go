@@ -2,6 +2,7 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
@@ -10,9 +11,9 @@ import (
 func RegisterCarRoutes(router *gin.RouterGroup, carController *controllers.CarController, auth gin.HandlerFunc) {
 	cars := router.Group("/cars")
 	cars.Use(auth)
-	cars.GET("", middleware.RequireRole("DRIVER"), carController.ListCars)
-	cars.POST("", middleware.RequireRole("DRIVER"), carController.CreateCar)
-	cars.PUT("/:id", middleware.RequireRole("DRIVER"), carController.UpdateCar)
-	cars.PATCH("/:id", middleware.RequireRole("DRIVER"), carController.PatchCar)
-	cars.DELETE("/:id", middleware.RequireRole("DRIVER"), carController.DeleteCar)
+	cars.GET("", middleware.RequirePermission(authorization.PermissionCarsRead), carController.ListCars)
+	cars.POST("", middleware.RequirePermission(authorization.PermissionCarsWrite), carController.CreateCar)
+	cars.PUT("/:id", middleware.RequirePermission(authorization.PermissionCarsWrite), carController.UpdateCar)
+	cars.PATCH("/:id", middleware.RequirePermission(authorization.PermissionCarsWrite), carController.PatchCar)
+	cars.DELETE("/:id", middleware.RequirePermission(authorization.PermissionCarsWrite), carController.DeleteCar)
 }
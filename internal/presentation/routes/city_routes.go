@@ -2,15 +2,18 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterCityRoutes registers all city routes
-func RegisterCityRoutes(router *gin.RouterGroup, cityController *controllers.CityController, auth gin.HandlerFunc) {
+func RegisterCityRoutes(router *gin.RouterGroup, cityController *controllers.CityController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	cities := router.Group("/cities")
 	cities.Use(auth)
-	cities.GET("", middleware.RequireRole("USER"), cityController.ListCities)
-	cities.POST("", middleware.RequireRole("USER"), cityController.CreateCity)
-	cities.DELETE("/:id", middleware.RequireRole("ADMIN"), cityController.DeleteCity)
+	cities.GET("", middleware.RequirePermission(authorization.PermissionCitiesRead), cityController.ListCities)
+	cities.POST("", middleware.RequirePermission(authorization.PermissionCitiesWrite), cityController.CreateCity)
+	cities.PATCH("/:id", audit.Record(entities.AuditActionCatalogUpdate), middleware.RequirePermission(authorization.PermissionCatalogWrite), cityController.UpdateCity)
+	cities.DELETE("/:id", audit.Record(entities.AuditActionCatalogDelete), middleware.RequirePermission(authorization.PermissionCatalogWrite), cityController.DeleteCity)
 }
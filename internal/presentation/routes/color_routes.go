@@ -2,16 +2,18 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterColorRoutes registers all color routes
-func RegisterColorRoutes(router *gin.RouterGroup, colorController *controllers.ColorController, auth gin.HandlerFunc) {
+func RegisterColorRoutes(router *gin.RouterGroup, colorController *controllers.ColorController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	colors := router.Group("/colors")
 	colors.Use(auth)
-	colors.GET("", middleware.RequireRole("DRIVER"), colorController.ListColors)
-	colors.POST("", middleware.RequireRole("ADMIN"), colorController.CreateColor)
-	colors.PATCH("/:id", middleware.RequireRole("ADMIN"), colorController.UpdateColor)
-	colors.DELETE("/:id", middleware.RequireRole("ADMIN"), colorController.DeleteColor)
+	colors.GET("", middleware.RequirePermission(authorization.PermissionCatalogRead), colorController.ListColors)
+	colors.POST("", audit.Record(entities.AuditActionCatalogCreate), middleware.RequirePermission(authorization.PermissionCatalogWrite), colorController.CreateColor)
+	colors.PATCH("/:id", audit.Record(entities.AuditActionCatalogUpdate), middleware.RequirePermission(authorization.PermissionCatalogWrite), colorController.UpdateColor)
+	colors.DELETE("/:id", audit.Record(entities.AuditActionCatalogDelete), middleware.RequirePermission(authorization.PermissionCatalogWrite), colorController.DeleteColor)
 }
@@ -2,13 +2,16 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterDriverRoutes registers all driver routes
-func RegisterDriverRoutes(router *gin.RouterGroup, driverController *controllers.DriverController, auth gin.HandlerFunc) {
+func RegisterDriverRoutes(router *gin.RouterGroup, driverController *controllers.DriverController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	drivers := router.Group("/drivers")
 	drivers.Use(auth)
-	drivers.POST("", middleware.RequireRole("USER"), driverController.CreateDriver)
+	// Becoming a driver changes the user's role, so it is audited like an admin role change
+	drivers.POST("", audit.Record(entities.AuditActionRoleChange), middleware.RequirePermission(authorization.PermissionDriversCreate), middleware.RequireVerifiedEmail(), driverController.CreateDriver)
 }
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
@@ -10,7 +11,8 @@ import (
 func RegisterInscriptionRoutes(router *gin.RouterGroup, inscriptionController *controllers.InscriptionController, auth gin.HandlerFunc) {
 	inscriptions := router.Group("/inscriptions")
 	inscriptions.Use(auth)
-	inscriptions.GET("", middleware.RequireRole("USER"), inscriptionController.ListInscriptions)
-	inscriptions.POST("", middleware.RequireRole("USER"), inscriptionController.CreateInscription)
-	inscriptions.DELETE("/:id", middleware.RequireRole("USER"), inscriptionController.DeleteInscription)
+	inscriptions.GET("", middleware.RequirePermission(authorization.PermissionInscriptionsRead), inscriptionController.ListInscriptions)
+	inscriptions.POST("", middleware.RequirePermission(authorization.PermissionInscriptionsWrite), middleware.RequireVerifiedEmail(), inscriptionController.CreateInscription)
+	inscriptions.POST("/:id/confirm", middleware.RequirePermission(authorization.PermissionInscriptionsWrite), inscriptionController.ConfirmInscription)
+	inscriptions.DELETE("/:id", middleware.RequirePermission(authorization.PermissionInscriptionsWrite), inscriptionController.DeleteInscription)
 }
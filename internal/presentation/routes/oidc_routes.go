@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/entities"
+	"github.com/lgxju/gogretago/internal/presentation/controllers"
+	"github.com/lgxju/gogretago/internal/presentation/middleware"
+)
+
+// RegisterOidcRoutes registers the OpenID Connect login routes with rate limiting
+func RegisterOidcRoutes(router *gin.RouterGroup, oidcController *controllers.OidcController, audit *middleware.AuditTrail) {
+	oidc := router.Group("/auth/oidc/:provider")
+	oidc.GET("/authorize", middleware.RateLimiter(10), oidcController.Authorize)                                         // 10 req/min
+	oidc.POST("/callback", middleware.RateLimiter(10), audit.Record(entities.AuditActionLogin), oidcController.Callback) // 10 req/min
+}
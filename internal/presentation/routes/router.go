@@ -39,26 +39,59 @@ func SetupRouter(container *di.Container) *gin.Engine {
 	apiBase.Use(cors.New(cors.Config{
 		AllowAllOrigins: true,
 		AllowMethods:    []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:    []string{"Origin", "Content-Type", "Authorization", "x-auth-token"},
+		AllowHeaders:    []string{"Origin", "Content-Type", "Authorization", "x-auth-token", middleware.ApiKeyHeader},
 		ExposeHeaders:   []string{"X-Request-Id"},
 	}))
 	apiBase.Use(middleware.RequestLogger())
 	apiBase.Use(middleware.BodyLimit(1024 * 1024)) // 1 MB
 
 	// Auth middleware handler function
-	auth := middleware.AuthMiddleware(container.JwtService)
+	auth := middleware.AuthMiddleware(container.JwtService, container.RevokedTokenRepository, container.TokenVersionRepository, container.RoleRepository, container.ApiKeyRepository, container.TokenService)
+
+	// Audit trail recorder for authentication and admin routes
+	audit := middleware.NewAuditTrail(container.AuditLogRepository)
 
 	// Create controllers
 	authController := controllers.NewAuthController(
 		container.RegisterUseCase,
 		container.LoginUseCase,
+		container.RefreshTokenUseCase,
+		container.LogoutUseCase,
+		container.ForgotPasswordUseCase,
+		container.ResetPasswordUseCase,
+		container.VerifyEmailUseCase,
+		container.ResendVerificationUseCase,
+		container.TwoFactorLoginUseCase,
+		container.EnrollTotpUseCase,
+		container.ConfirmTotpUseCase,
+		container.RequestMagicLinkUseCase,
+		container.MagicLinkLoginUseCase,
+	)
+
+	oidcController := controllers.NewOidcController(
+		container.StartOidcLoginUseCase,
+		container.OidcCallbackUseCase,
 	)
 
+	sessionController := controllers.NewSessionController(
+		container.ListSessionsUseCase,
+		container.RevokeSessionUseCase,
+		container.RevokeAllSessionsUseCase,
+	)
+
+	jwksController := controllers.NewJwksController(container.GetJwksUseCase)
+
 	userController := controllers.NewUserController(
 		container.ListUsersUseCase,
 		container.GetUserUseCase,
 		container.UpdateUserUseCase,
 		container.AnonymizeUserUseCase,
+		container.UnlockUserUseCase,
+		container.ChangePasswordUseCase,
+		container.UpdateUserRoleUseCase,
+		container.SuspendUserUseCase,
+		container.BanUserUseCase,
+		container.ReinstateUserUseCase,
 	)
 
 	driverController := controllers.NewDriverController(
@@ -81,6 +114,7 @@ func SetupRouter(container *di.Container) *gin.Engine {
 	cityController := controllers.NewCityController(
 		container.ListCitiesUseCase,
 		container.CreateCityUseCase,
+		container.UpdateCityUseCase,
 		container.DeleteCityUseCase,
 	)
 
@@ -97,6 +131,21 @@ func SetupRouter(container *di.Container) *gin.Engine {
 		container.FindTripsUseCase,
 		container.CreateTripUseCase,
 		container.DeleteTripUseCase,
+		container.ChangeTripStatusUseCase,
+		container.CancelTripUseCase,
+		container.QuoteTripPriceUseCase,
+		container.UpdateTripUseCase,
+		container.ListTripChangesUseCase,
+	)
+
+	tripSeriesController := controllers.NewTripSeriesController(
+		container.CreateTripSeriesUseCase,
+		container.GetTripSeriesUseCase,
+		container.UpdateTripSeriesUseCase,
+		container.CancelTripSeriesUseCase,
+		container.UpdateTripOccurrenceUseCase,
+		container.SubscribeTripSeriesUseCase,
+		container.UnsubscribeTripSeriesUseCase,
 	)
 
 	inscriptionController := controllers.NewInscriptionController(
@@ -105,20 +154,38 @@ func SetupRouter(container *di.Container) *gin.Engine {
 		container.DeleteInscriptionUseCase,
 		container.ListUserInscriptionsUseCase,
 		container.ListTripPassengersUseCase,
+		container.ConfirmInscriptionUseCase,
 	)
 
+	apiKeyController := controllers.NewApiKeyController(
+		container.CreateApiKeyUseCase,
+		container.ListApiKeysUseCase,
+		container.RevokeApiKeyUseCase,
+		container.CreateServiceAccountUseCase,
+		container.ListServiceAccountsUseCase,
+	)
+	auditLogController := controllers.NewAuditLogController(container.ListAuditLogsUseCase)
+
+	// Token verification keys, at the standard location outside /api
+	router.GET("/.well-known/jwks.json", jwksController.GetJWKS)
+
 	// Register routes under /api/v1
 	api := apiBase.Group("/v1")
 
-	RegisterAuthRoutes(api, authController)
-	RegisterUserRoutes(api, userController, inscriptionController, auth)
-	RegisterDriverRoutes(api, driverController, auth)
-	RegisterBrandRoutes(api, brandController, auth)
-	RegisterColorRoutes(api, colorController, auth)
-	RegisterCityRoutes(api, cityController, auth)
+	RegisterAuthRoutes(api, authController, auth, audit)
+	RegisterOidcRoutes(api, oidcController, audit)
+	RegisterUserRoutes(api, userController, inscriptionController, auth, audit)
+	RegisterSessionRoutes(api, sessionController, auth)
+	RegisterApiKeyRoutes(api, apiKeyController, auth, audit)
+	RegisterDriverRoutes(api, driverController, auth, audit)
+	RegisterBrandRoutes(api, brandController, auth, audit)
+	RegisterColorRoutes(api, colorController, auth, audit)
+	RegisterCityRoutes(api, cityController, auth, audit)
 	RegisterCarRoutes(api, carController, auth)
-	RegisterTripRoutes(api, tripController, inscriptionController, auth)
+	RegisterTripRoutes(api, tripController, inscriptionController, auth, audit)
+	RegisterTripSeriesRoutes(api, tripSeriesController, auth)
 	RegisterInscriptionRoutes(api, inscriptionController, auth)
+	RegisterAuditLogRoutes(api, auditLogController, auth)
 
 	return router
 }
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/presentation/controllers"
+	"github.com/lgxju/gogretago/internal/presentation/middleware"
+)
+
+// RegisterSessionRoutes registers the routes managing the current user's signed-in devices
+func RegisterSessionRoutes(router *gin.RouterGroup, sessionController *controllers.SessionController, auth gin.HandlerFunc) {
+	sessions := router.Group("/users/me/sessions")
+	sessions.Use(auth, middleware.DenyApiKeys())
+	sessions.GET("", middleware.RequirePermission(authorization.PermissionProfileRead), sessionController.ListMySessions)
+	sessions.DELETE("", middleware.RequirePermission(authorization.PermissionProfileWrite), sessionController.RevokeAllMySessions)
+	sessions.DELETE("/:sessionId", middleware.RequirePermission(authorization.PermissionProfileWrite), sessionController.RevokeMySession)
+}
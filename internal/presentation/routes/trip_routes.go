@@ -2,18 +2,27 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterTripRoutes registers all trip routes
-func RegisterTripRoutes(router *gin.RouterGroup, tripController *controllers.TripController, inscriptionController *controllers.InscriptionController, auth gin.HandlerFunc) {
+func RegisterTripRoutes(router *gin.RouterGroup, tripController *controllers.TripController, inscriptionController *controllers.InscriptionController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	trips := router.Group("/trips")
 	trips.Use(auth)
-	trips.GET("", middleware.RequireRole("USER"), tripController.ListTrips)
-	trips.GET("/search", middleware.RequireRole("USER"), tripController.FindTrip)
-	trips.GET("/:id", middleware.RequireRole("USER"), tripController.GetTrip)
-	trips.POST("", middleware.RequireRole("DRIVER"), tripController.CreateTrip)
-	trips.DELETE("/:id", middleware.RequireRole("DRIVER"), tripController.DeleteTrip)
-	trips.GET("/:id/passengers", middleware.RequireRole("USER"), inscriptionController.ListTripPassengers)
+	trips.GET("", middleware.RequirePermission(authorization.PermissionTripsRead), tripController.ListTrips)
+	trips.GET("/search", middleware.RequirePermission(authorization.PermissionTripsRead), tripController.FindTrip)
+	trips.GET("/price-quote", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.QuotePrice)
+	trips.GET("/:id", middleware.RequirePermission(authorization.PermissionTripsRead), tripController.GetTrip)
+	trips.POST("", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.CreateTrip)
+	trips.PUT("/:id", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.UpdateTrip)
+	trips.PATCH("/:id", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.PatchTrip)
+	trips.DELETE("/:id", audit.Record(entities.AuditActionTripDelete), middleware.RequirePermission(authorization.PermissionTripsDelete), tripController.DeleteTrip)
+	trips.POST("/:id/start", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.StartTrip)
+	trips.POST("/:id/complete", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.CompleteTrip)
+	trips.POST("/:id/cancel", middleware.RequirePermission(authorization.PermissionTripsWrite), tripController.CancelTrip)
+	trips.GET("/:id/changes", middleware.RequirePermission(authorization.PermissionTripsRead), tripController.ListTripChanges)
+	trips.GET("/:id/passengers", middleware.RequirePermission(authorization.PermissionTripsRead), inscriptionController.ListTripPassengers)
 }
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/presentation/controllers"
+	"github.com/lgxju/gogretago/internal/presentation/middleware"
+)
+
+// RegisterTripSeriesRoutes registers all recurring trip routes
+func RegisterTripSeriesRoutes(router *gin.RouterGroup, tripSeriesController *controllers.TripSeriesController, auth gin.HandlerFunc) {
+	series := router.Group("/trip-series")
+	series.Use(auth)
+	series.POST("", middleware.RequirePermission(authorization.PermissionTripsWrite), tripSeriesController.CreateTripSeries)
+	series.GET("/:id", middleware.RequirePermission(authorization.PermissionTripsRead), tripSeriesController.GetTripSeries)
+	series.PATCH("/:id", middleware.RequirePermission(authorization.PermissionTripsWrite), tripSeriesController.PatchTripSeries)
+	series.POST("/:id/cancel", middleware.RequirePermission(authorization.PermissionTripsWrite), tripSeriesController.CancelTripSeries)
+	series.PATCH("/:id/occurrences/:tripId", middleware.RequirePermission(authorization.PermissionTripsWrite), tripSeriesController.PatchOccurrence)
+	series.POST("/:id/subscription", middleware.RequirePermission(authorization.PermissionInscriptionsWrite), middleware.RequireVerifiedEmail(), tripSeriesController.Subscribe)
+	series.DELETE("/:id/subscription", middleware.RequirePermission(authorization.PermissionInscriptionsWrite), tripSeriesController.Unsubscribe)
+}
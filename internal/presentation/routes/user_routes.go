@@ -2,18 +2,27 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/lgxju/gogretago/internal/domain/authorization"
+	"github.com/lgxju/gogretago/internal/domain/entities"
 	"github.com/lgxju/gogretago/internal/presentation/controllers"
 	"github.com/lgxju/gogretago/internal/presentation/middleware"
 )
 
 // RegisterUserRoutes registers all user routes
-func RegisterUserRoutes(router *gin.RouterGroup, userController *controllers.UserController, inscriptionController *controllers.InscriptionController, auth gin.HandlerFunc) {
+func RegisterUserRoutes(router *gin.RouterGroup, userController *controllers.UserController, inscriptionController *controllers.InscriptionController, auth gin.HandlerFunc, audit *middleware.AuditTrail) {
 	users := router.Group("/users")
 	users.Use(auth)
-	users.GET("", middleware.RequireRole("ADMIN"), userController.ListUsers)
-	users.GET("/:id", middleware.RequireRole("USER"), userController.GetUser)
-	users.PATCH("/me", middleware.RequireRole("USER"), userController.UpdateProfile)
-	users.DELETE("/me", middleware.RequireRole("USER"), userController.AnonymizeMe)
-	users.DELETE("/:id", middleware.RequireRole("ADMIN"), userController.AnonymizeUser)
-	users.GET("/:id/inscriptions", middleware.RequireRole("USER"), inscriptionController.ListUserInscriptions)
+	users.GET("", middleware.RequirePermission(authorization.PermissionUsersRead), userController.ListUsers)
+	users.GET("/:id", middleware.RequirePermission(authorization.PermissionProfileRead), userController.GetUser)
+	users.PATCH("/me", middleware.RequirePermission(authorization.PermissionProfileWrite), userController.UpdateProfile)
+	// API keys cannot destroy or take over the account that owns them
+	users.DELETE("/me", middleware.DenyApiKeys(), audit.Record(entities.AuditActionAnonymize), middleware.RequirePermission(authorization.PermissionProfileWrite), userController.AnonymizeMe)
+	users.POST("/me/password", middleware.DenyApiKeys(), audit.Record(entities.AuditActionPasswordChange), middleware.RequirePermission(authorization.PermissionProfileWrite), middleware.RateLimiter(5), userController.ChangePassword) // 5 req/min
+	users.DELETE("/:id", audit.Record(entities.AuditActionAnonymize), middleware.RequirePermission(authorization.PermissionUsersAnonymize), userController.AnonymizeUser)
+	users.POST("/:id/unlock", audit.Record(entities.AuditActionUnlock), middleware.RequirePermission(authorization.PermissionUsersUnlock), userController.UnlockUser)
+	users.PUT("/:id/role", audit.Record(entities.AuditActionRoleChange), middleware.RequirePermission(authorization.PermissionUsersRoles), userController.UpdateUserRole)
+	users.POST("/:id/suspend", audit.Record(entities.AuditActionSuspend), middleware.RequirePermission(authorization.PermissionUsersSuspend), userController.SuspendUser)
+	users.POST("/:id/ban", audit.Record(entities.AuditActionBan), middleware.RequirePermission(authorization.PermissionUsersSuspend), userController.BanUser)
+	users.DELETE("/:id/suspension", audit.Record(entities.AuditActionReinstate), middleware.RequirePermission(authorization.PermissionUsersSuspend), userController.ReinstateUser)
+	users.GET("/:id/inscriptions", middleware.RequirePermission(authorization.PermissionInscriptionsRead), inscriptionController.ListUserInscriptions)
 }
@@ -57,6 +57,14 @@ func FormatValidationErrors(err error) map[string][]string {
 				message = "Password must contain at least one lowercase, one uppercase, and one number"
 			case "hexcolor":
 				message = "Must be a valid hex color (#RRGGBB)"
+			case "timezone":
+				message = "Must be an IANA time zone such as Europe/Paris"
+			case "latitude":
+				message = "Must be a latitude between -90 and 90"
+			case "longitude":
+				message = "Must be a longitude between -180 and 180"
+			case "required_with":
+				message = field + " is required with " + e.Param()
 			default:
 				message = field + " is invalid"
 			}
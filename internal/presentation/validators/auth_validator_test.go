@@ -124,6 +124,28 @@ func TestFormatValidationErrors_MinTag(t *testing.T) {
 	assert.Contains(t, formatted["Name"], "Name must be at least 3 characters")
 }
 
+func TestFormatValidationErrors_Coordinates(t *testing.T) {
+	v := GetValidator()
+
+	type coordinatesStruct struct {
+		Latitude  *float64 `validate:"required_with=Longitude,omitempty,latitude"`
+		Longitude *float64 `validate:"required_with=Latitude,omitempty,longitude"`
+	}
+
+	lat, lng := 91.0, 4.83
+	err := v.Struct(coordinatesStruct{Latitude: &lat, Longitude: &lng})
+	require.Error(t, err)
+	assert.Contains(t, FormatValidationErrors(err)["Latitude"], "Must be a latitude between -90 and 90")
+
+	err = v.Struct(coordinatesStruct{Longitude: &lng})
+	require.Error(t, err)
+	assert.Contains(t, FormatValidationErrors(err)["Latitude"], "Latitude is required with Longitude")
+
+	equator := 0.0
+	assert.NoError(t, v.Struct(coordinatesStruct{Latitude: &equator, Longitude: &lng}))
+	assert.NoError(t, v.Struct(coordinatesStruct{}))
+}
+
 func TestFormatValidationErrors_UnknownTag(t *testing.T) {
 	// For an unknown tag, FormatValidationErrors returns "<Field> is invalid"
 	// We can test this by using a non-standard validator error